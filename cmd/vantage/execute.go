@@ -1,9 +1,16 @@
 package main
 
 import (
+	"bufio"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"os"
+	"slices"
+	"sort"
+	"strconv"
 	"strings"
+	"text/tabwriter"
 	"time"
 
 	"vantage/core/executor"
@@ -11,6 +18,7 @@ import (
 	"vantage/core/intent"
 	"vantage/core/reasoning"
 	"vantage/core/state"
+	"vantage/techniques"
 
 	"github.com/spf13/cobra"
 )
@@ -18,9 +26,23 @@ import (
 type runtime struct {
 	reasoner *reasoning.Engine
 	state    *state.State
+	exposure *exposure.Tracker
 }
 
-func buildRuntime(campaignID, target string, techniques []string) (*runtime, error) {
+// defaultMaxExposure is the exposure budget shared by every cycle of a run when --max-exposure is not set.
+const defaultMaxExposure = intent.DefaultExposureBudget
+
+// maxExposureFlag returns --max-exposure. An explicit zero is rejected rather than silently replaced by the
+// contract's default budget.
+func maxExposureFlag(cmd *cobra.Command) (uint64, error) {
+	maxExposure, _ := cmd.Flags().GetUint64("max-exposure")
+	if maxExposure == 0 && cmd.Flags().Changed("max-exposure") {
+		return 0, errors.New("--max-exposure must be positive")
+	}
+	return maxExposure, nil
+}
+
+func buildRuntime(campaignID, target string, techniques []string, maxExposure uint64) (*runtime, error) {
 	if campaignID == "" || target == "" {
 		return nil, errors.New("campaign and target are required")
 	}
@@ -35,13 +57,14 @@ func buildRuntime(campaignID, target string, techniques []string) (*runtime, err
 		Targets:           []string{target},
 		NotBefore:         time.Now().UTC().Add(-1 * time.Minute),
 		NotAfter:          time.Now().UTC().Add(10 * time.Minute),
+		ExposureBudget:    maxExposure,
 	}
 
 	campaign, err := state.New(contract.CampaignID)
 	if err != nil {
 		return nil, err
 	}
-	exposureTracker, err := exposure.New(100)
+	exposureTracker, err := exposure.New(contract.EffectiveExposureBudget())
 	if err != nil {
 		return nil, err
 	}
@@ -53,25 +76,136 @@ func buildRuntime(campaignID, target string, techniques []string) (*runtime, err
 	reasoner := reasoning.NewEngine(nil)
 	reasoner.ConfigureCycle(reasoning.CycleConfig{
 		Target:            target,
-		AllowedTechniques: techniques,
+		AllowedTechniques: contract.AllowedTechniques,
 		Executor:          execEngine,
 		Timeout:           30 * time.Second,
 	})
+	reasoner.SetExposureGauge(exposureTracker)
+
+	return &runtime{reasoner: reasoner, state: campaign, exposure: exposureTracker}, nil
+}
 
-	return &runtime{reasoner: reasoner, state: campaign}, nil
+// defaultObjectiveEnv names the environment variable plan, explain and compare read the objective from when
+// --objective is not given.
+const defaultObjectiveEnv = "VANTAGE_OBJECTIVE"
+
+// objectiveFlag returns --objective when set, falling back to defaultObjectiveEnv.
+func objectiveFlag(cmd *cobra.Command) (string, error) {
+	raw, _ := cmd.Flags().GetString("objective")
+	if cmd.Flags().Changed("objective") || raw != "" {
+		return raw, nil
+	}
+	if raw = strings.TrimSpace(os.Getenv(defaultObjectiveEnv)); raw != "" {
+		return raw, nil
+	}
+	return "", fmt.Errorf("--objective is required when %s is not set", defaultObjectiveEnv)
 }
 
 func parseObjectiveNodeType(raw string) (reasoning.NodeType, error) {
-	switch strings.ToUpper(strings.TrimSpace(raw)) {
-	case string(reasoning.NodeTypeDataExposure):
-		return reasoning.NodeTypeDataExposure, nil
-	case string(reasoning.NodeTypePrivEsc):
-		return reasoning.NodeTypePrivEsc, nil
-	case string(reasoning.NodeTypeLateralReachability):
-		return reasoning.NodeTypeLateralReachability, nil
-	default:
-		return "", fmt.Errorf("unsupported objective %q", raw)
+	objective, err := reasoning.ParseObjective(raw)
+	if err != nil {
+		return "", err
+	}
+	if objective.IsEdge() {
+		return "", fmt.Errorf("objective %q targets edge type %s; campaign planning requires a node objective", raw, objective.EdgeType)
+	}
+	return objective.NodeType, nil
+}
+
+// weightedObjective is one entry of a weighted --objective list such as DATA_EXPOSURE=2,PRIV_ESC=1.
+type weightedObjective struct {
+	objective reasoning.NodeType
+	weight    float64
+}
+
+// parseWeightedObjectives parses a comma-separated objective list where each entry may carry
+// an =weight suffix; entries without a weight default to 1.
+func parseWeightedObjectives(raw string) ([]weightedObjective, error) {
+	parts := strings.Split(raw, ",")
+	out := make([]weightedObjective, 0, len(parts))
+	seen := map[reasoning.NodeType]struct{}{}
+	for _, part := range parts {
+		name, weightRaw, hasWeight := strings.Cut(part, "=")
+		objective, err := parseObjectiveNodeType(name)
+		if err != nil {
+			return nil, err
+		}
+		if _, dup := seen[objective]; dup {
+			return nil, fmt.Errorf("objective %s listed more than once", objective)
+		}
+		seen[objective] = struct{}{}
+		weight := 1.0
+		if hasWeight {
+			weight, err = strconv.ParseFloat(strings.TrimSpace(weightRaw), 64)
+			if err != nil || weight <= 0 {
+				return nil, fmt.Errorf("invalid weight %q for objective %s", weightRaw, objective)
+			}
+		}
+		out = append(out, weightedObjective{objective: objective, weight: weight})
+	}
+	sort.SliceStable(out, func(i, j int) bool { return out[i].weight > out[j].weight })
+	return out, nil
+}
+
+// planWeightedObjectives plans each objective separately and merges the results by weighted score.
+func planWeightedObjectives(reasoner *reasoning.Engine, objectives []weightedObjective, opts reasoning.CampaignOptions) ([]reasoning.Campaign, error) {
+	planned := make([][]reasoning.Campaign, 0, len(objectives))
+	for _, wo := range objectives {
+		campaigns, err := reasoner.PlanCampaign(wo.objective, opts)
+		if err != nil {
+			return nil, err
+		}
+		planned = append(planned, campaigns)
+	}
+	return mergeWeightedCampaigns(planned, objectives), nil
+}
+
+// mergeWeightedCampaigns merges planned[i], the campaigns for objectives[i], with every score multiplied by
+// its objective's weight. Scores are first shifted so the lowest is zero when any is negative; otherwise a
+// larger weight would push a negative score further down.
+func mergeWeightedCampaigns(planned [][]reasoning.Campaign, objectives []weightedObjective) []reasoning.Campaign {
+	floor := 0.0
+	for _, campaigns := range planned {
+		for _, c := range campaigns {
+			floor = min(floor, c.Score)
+		}
+	}
+	merged := make([]reasoning.Campaign, 0)
+	for i, campaigns := range planned {
+		for _, campaign := range campaigns {
+			campaign.Score = (campaign.Score - floor) * objectives[i].weight
+			merged = append(merged, campaign)
+		}
 	}
+	sort.SliceStable(merged, func(i, j int) bool { return merged[i].Score > merged[j].Score })
+	return merged
+}
+
+// objectiveAttained reports whether the campaign's last step produces its objective and that objective
+// was requested.
+func objectiveAttained(c reasoning.Campaign, classes map[string]reasoning.ActionClass, objectives []weightedObjective) bool {
+	if len(c.Steps) == 0 {
+		return false
+	}
+	last, ok := classes[c.Steps[len(c.Steps)-1].ActionClassID]
+	if !ok || !slices.Contains(last.ProducesNodes, c.Objective) {
+		return false
+	}
+	for _, wo := range objectives {
+		if c.Objective == wo.objective {
+			return true
+		}
+	}
+	return false
+}
+
+// actionClassesByID indexes action classes by ID.
+func actionClassesByID(classes []reasoning.ActionClass) map[string]reasoning.ActionClass {
+	out := make(map[string]reasoning.ActionClass, len(classes))
+	for _, ac := range classes {
+		out[ac.ID] = ac
+	}
+	return out
 }
 
 var runCmd = &cobra.Command{
@@ -81,19 +215,73 @@ var runCmd = &cobra.Command{
 		campaignID, _ := cmd.Flags().GetString("campaign")
 		target, _ := cmd.Flags().GetString("target")
 		techniques, _ := cmd.Flags().GetStringSlice("technique")
+		allowRedundant, _ := cmd.Flags().GetBool("allow-redundant")
+		maxExposure, err := maxExposureFlag(cmd)
+		if err != nil {
+			return err
+		}
 
-		rt, err := buildRuntime(campaignID, target, techniques)
+		rt, err := buildRuntime(campaignID, target, techniques, maxExposure)
 		if err != nil {
 			return err
 		}
+		rt.reasoner.SetAllowRedundantCycles(allowRedundant)
 		decision, err := rt.reasoner.RunCycle(rt.state)
 		if decision != nil {
 			fmt.Printf("[+] selected=%s score=%.2f\n", decision.Selected.TechniqueID, decision.Selected.Score)
 		}
+		if rt.exposure.Halted() {
+			fmt.Printf("[!] stopping: exposure budget exhausted (score=%d)\n", rt.exposure.Score())
+			return nil
+		}
+		if errors.Is(err, reasoning.ErrNoProgress) {
+			fmt.Printf("[!] skipped: %v\n", err)
+			return nil
+		}
 		return err
 	},
 }
 
+// loopOptions bounds a multi-cycle run.
+type loopOptions struct {
+	cycles            int
+	stagnationWindow  int
+	progressThreshold int
+}
+
+// runLoop runs up to opts.cycles reasoning cycles against one shared exposure tracker and returns how many
+// ran. It stops cleanly, without error, once the run's aggregate exposure budget is exhausted or a cycle is
+// skipped because its selection would make no progress.
+func runLoop(rt *runtime, opts loopOptions) (int, error) {
+	for i := 0; i < opts.cycles; i++ {
+		decision, runErr := rt.reasoner.RunCycle(rt.state)
+		if decision != nil {
+			fmt.Printf("[%d] selected=%s score=%.2f\n", i+1, decision.Selected.TechniqueID, decision.Selected.Score)
+		}
+		if rt.exposure.Halted() {
+			fmt.Printf("[!] stopping: exposure budget exhausted (score=%d)\n", rt.exposure.Score())
+			return i + 1, nil
+		}
+		if errors.Is(runErr, reasoning.ErrNoProgress) {
+			fmt.Printf("[!] stopping: %v\n", runErr)
+			return i + 1, nil
+		}
+		if runErr != nil {
+			return i + 1, runErr
+		}
+		since := rt.reasoner.CyclesSinceObjectiveProgress()
+		fmt.Printf("[%d] cycles since objective progress=%d\n", i+1, since)
+		if opts.progressThreshold > 0 && since > opts.progressThreshold {
+			fmt.Printf("[!] warning: no objective progress in the last %d cycles\n", since)
+		}
+		if opts.stagnationWindow > 0 && rt.reasoner.DetectStagnation(opts.stagnationWindow) {
+			fmt.Printf("[!] stopping: no progress in the last %d cycles\n", opts.stagnationWindow)
+			return i + 1, nil
+		}
+	}
+	return opts.cycles, nil
+}
+
 var loopCmd = &cobra.Command{
 	Use:   "loop",
 	Short: "Run multiple deterministic reasoning cycles",
@@ -102,22 +290,31 @@ var loopCmd = &cobra.Command{
 		target, _ := cmd.Flags().GetString("target")
 		techniques, _ := cmd.Flags().GetStringSlice("technique")
 		cycles, _ := cmd.Flags().GetInt("cycles")
-
-		rt, err := buildRuntime(campaignID, target, techniques)
+		stagnationWindow, _ := cmd.Flags().GetInt("stagnation-window")
+		progressThreshold, _ := cmd.Flags().GetInt("progress-threshold")
+		allowRedundant, _ := cmd.Flags().GetBool("allow-redundant")
+		explore, _ := cmd.Flags().GetFloat64("explore")
+		exploreTopK, _ := cmd.Flags().GetInt("explore-top-k")
+		exploreSeed, _ := cmd.Flags().GetInt64("explore-seed")
+		maxExposure, err := maxExposureFlag(cmd)
 		if err != nil {
 			return err
 		}
 
-		for i := 0; i < cycles; i++ {
-			decision, runErr := rt.reasoner.RunCycle(rt.state)
-			if decision != nil {
-				fmt.Printf("[%d] selected=%s score=%.2f\n", i+1, decision.Selected.TechniqueID, decision.Selected.Score)
-			}
-			if runErr != nil {
-				return runErr
+		rt, err := buildRuntime(campaignID, target, techniques, maxExposure)
+		if err != nil {
+			return err
+		}
+		rt.reasoner.SetAllowRedundantCycles(allowRedundant)
+		if explore > 0 {
+			policy, err := reasoning.NewEpsilonGreedyPolicy(explore, exploreTopK, exploreSeed)
+			if err != nil {
+				return err
 			}
+			rt.reasoner.SetSelectionPolicy(policy)
 		}
-		return nil
+		_, err = runLoop(rt, loopOptions{cycles: cycles, stagnationWindow: stagnationWindow, progressThreshold: progressThreshold})
+		return err
 	},
 }
 
@@ -128,14 +325,26 @@ var graphCmd = &cobra.Command{
 		campaignID, _ := cmd.Flags().GetString("campaign")
 		target, _ := cmd.Flags().GetString("target")
 		techniques, _ := cmd.Flags().GetStringSlice("technique")
+		format, _ := cmd.Flags().GetString("format")
+		if format != "dot" && format != "json" {
+			return fmt.Errorf("unsupported format %q", format)
+		}
 
-		rt, err := buildRuntime(campaignID, target, techniques)
+		rt, err := buildRuntime(campaignID, target, techniques, defaultMaxExposure)
 		if err != nil {
 			return err
 		}
 		if _, err := rt.reasoner.RunCycle(rt.state); err != nil {
 			return err
 		}
+		if format == "json" {
+			out, err := rt.reasoner.Graph().CanonicalJSON()
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(out))
+			return nil
+		}
 		fmt.Println(rt.reasoner.DOT())
 		return nil
 	},
@@ -145,8 +354,11 @@ var explainCmd = &cobra.Command{
 	Use:   "explain",
 	Short: "Explain planned campaigns and defensive implications",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		objectiveFlag, _ := cmd.Flags().GetString("objective")
-		objective, err := parseObjectiveNodeType(objectiveFlag)
+		rawObjective, err := objectiveFlag(cmd)
+		if err != nil {
+			return err
+		}
+		objective, err := parseObjectiveNodeType(rawObjective)
 		if err != nil {
 			return err
 		}
@@ -157,16 +369,68 @@ var explainCmd = &cobra.Command{
 			return err
 		}
 		fmt.Println(renderCampaignExplanation(objective, campaigns))
+
+		reaching, err := attackPathsReaching(reasoner, objective)
+		if err != nil {
+			return err
+		}
+		fmt.Print(renderPathExplanations(reaching))
 		return nil
 	},
 }
 
+// attackPathsReaching expands attack paths with objective as the only objective node type and returns those
+// that reach it.
+func attackPathsReaching(reasoner *reasoning.Engine, objective reasoning.NodeType) ([]reasoning.AttackPath, error) {
+	cfg := reasoning.DefaultAttackPathConfig()
+	cfg.ObjectiveNodeTypes = []reasoning.NodeType{objective}
+	reasoner.ConfigureAttackPathExpansion(cfg)
+	st, err := state.New("explain")
+	if err != nil {
+		return nil, err
+	}
+	paths, err := reasoner.ExpandAttackPaths(st)
+	if err != nil {
+		return nil, err
+	}
+	reaching := make([]reasoning.AttackPath, 0, len(paths))
+	for _, path := range paths {
+		if path.Objective == objective {
+			reaching = append(reaching, path)
+		}
+	}
+	return reaching, nil
+}
+
+// renderPathExplanations prints each path's score broken into the components the scorer recorded;
+// penalties are shown as the amounts subtracted from the score.
+func renderPathExplanations(paths []reasoning.AttackPath) string {
+	if len(paths) == 0 {
+		return "No attack paths reach the objective.\n"
+	}
+	var b strings.Builder
+	for i, path := range paths {
+		stepIDs := make([]string, 0, len(path.Steps))
+		for _, step := range path.Steps {
+			stepIDs = append(stepIDs, step.ActionClassID)
+		}
+		e := path.Explain()
+		fmt.Fprintf(&b, "%d. score=%.3f steps=%s\n", i+1, path.Score, strings.Join(stepIDs, " -> "))
+		fmt.Fprintf(&b, "   confidence=+%.3f feasibility=+%.3f unlock=+%.3f diversity=+%.3f objective_proximity=+%.3f\n", e.Confidence, e.Feasibility, e.UnlockBonus, e.DiversityBonus, e.ObjectiveProximity)
+		fmt.Fprintf(&b, "   risk=-%.3f depth=-%.3f stagnation=-%.3f\n", e.RiskPenalty, e.DepthPenalty, e.StagnationPenalty)
+	}
+	return b.String()
+}
+
 var compareCmd = &cobra.Command{
 	Use:   "compare",
 	Short: "Compare top 3 campaigns",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		objectiveFlag, _ := cmd.Flags().GetString("objective")
-		objective, err := parseObjectiveNodeType(objectiveFlag)
+		rawObjective, err := objectiveFlag(cmd)
+		if err != nil {
+			return err
+		}
+		objective, err := parseObjectiveNodeType(rawObjective)
 		if err != nil {
 			return err
 		}
@@ -186,8 +450,21 @@ var simulateCmd = &cobra.Command{
 	Short: "Simulate reasoning without executor side effects",
 	RunE: func(cmd *cobra.Command, args []string) error {
 		target, _ := cmd.Flags().GetString("target")
+		targetsFile, _ := cmd.Flags().GetString("targets-file")
 		techniques, _ := cmd.Flags().GetStringSlice("technique")
 
+		if targetsFile != "" {
+			targets, err := readTargetsFile(targetsFile)
+			if err != nil {
+				return err
+			}
+			results, err := simulateTargets(targets, techniques)
+			if err != nil {
+				return err
+			}
+			fmt.Print(renderSimulateTable(results))
+			return nil
+		}
 		reasoner := reasoning.NewEngine(nil)
 		decision, err := reasoner.PlanNextAction(reasoning.PlannerQuery{Target: target, AllowedTechniques: techniques, TopN: 3})
 		if err != nil {
@@ -198,24 +475,96 @@ var simulateCmd = &cobra.Command{
 	},
 }
 
+// simulateResult is the technique the planner would select for one target.
+type simulateResult struct {
+	target      string
+	techniqueID string
+	score       float64
+}
+
+// readTargetsFile reads one target per line, skipping blank lines and # comments.
+func readTargetsFile(path string) ([]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var targets []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		targets = append(targets, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(targets) == 0 {
+		return nil, fmt.Errorf("targets file %s lists no targets", path)
+	}
+	return targets, nil
+}
+
+// simulateTargets plans the next action for each target on a fresh engine, so one target's simulated
+// graph never influences another's, and returns the results highest score first.
+func simulateTargets(targets []string, techniques []string) ([]simulateResult, error) {
+	results := make([]simulateResult, 0, len(targets))
+	for _, target := range targets {
+		decision, err := reasoning.NewEngine(nil).PlanNextAction(reasoning.PlannerQuery{Target: target, AllowedTechniques: techniques, TopN: 3})
+		if err != nil {
+			return nil, fmt.Errorf("simulate %s: %w", target, err)
+		}
+		results = append(results, simulateResult{target: target, techniqueID: decision.Selected.TechniqueID, score: decision.Selected.Score})
+	}
+	sort.SliceStable(results, func(i, j int) bool {
+		if results[i].score != results[j].score {
+			return results[i].score > results[j].score
+		}
+		return results[i].target < results[j].target
+	})
+	return results, nil
+}
+
+func renderSimulateTable(results []simulateResult) string {
+	var b strings.Builder
+	w := tabwriter.NewWriter(&b, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "TARGET\tSELECTED\tSCORE")
+	for _, r := range results {
+		fmt.Fprintf(w, "%s\t%s\t%.2f\n", r.target, r.techniqueID, r.score)
+	}
+	_ = w.Flush()
+	return b.String()
+}
+
 var planCmd = &cobra.Command{
 	Use:   "plan",
 	Short: "Plan strategic attack campaigns for a requested objective",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		objectiveFlag, _ := cmd.Flags().GetString("objective")
 		maxDepth, _ := cmd.Flags().GetInt("max-depth")
 		riskTolerance, _ := cmd.Flags().GetFloat64("risk")
 		confidenceThreshold, _ := cmd.Flags().GetFloat64("confidence")
 		beamWidth, _ := cmd.Flags().GetInt("beam-width")
+		format, _ := cmd.Flags().GetString("format")
+		if format != "text" && format != "json" {
+			return fmt.Errorf("unsupported format %q", format)
+		}
 
-		objective, err := parseObjectiveNodeType(objectiveFlag)
+		rawObjective, err := objectiveFlag(cmd)
 		if err != nil {
 			return err
 		}
+		objectives, err := parseWeightedObjectives(rawObjective)
+		if err != nil {
+			return err
+		}
+		objective := objectives[0].objective
 
 		reasoner := reasoning.NewEngine(nil)
 		reasoner.Graph().UpsertNode(&reasoning.Node{ID: "plan-seed", Type: reasoning.NodeTypeEvidence, Label: "planner seed"})
-		campaigns, err := reasoner.PlanCampaign(objective, reasoning.CampaignOptions{
+		campaigns, err := planWeightedObjectives(reasoner, objectives, reasoning.CampaignOptions{
 			MaxDepth:            maxDepth,
 			RiskTolerance:       riskTolerance,
 			ConfidenceThreshold: confidenceThreshold,
@@ -224,15 +573,24 @@ var planCmd = &cobra.Command{
 		if err != nil {
 			return err
 		}
+		classes := actionClassesByID(reasoner.ActionClasses())
+		limit := 5
+		if len(campaigns) < limit {
+			limit = len(campaigns)
+		}
+		if format == "json" {
+			out, err := json.MarshalIndent(buildPlanEntries(campaigns[:limit], classes, objectives), "", "  ")
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(out))
+			return nil
+		}
 		if len(campaigns) == 0 {
 			fmt.Println("no campaigns found")
 			return nil
 		}
 
-		limit := 5
-		if len(campaigns) < limit {
-			limit = len(campaigns)
-		}
 		fmt.Println(renderCampaignExplanation(objective, campaigns[:limit]))
 		for i := 0; i < limit; i++ {
 			campaign := campaigns[i]
@@ -240,16 +598,217 @@ var planCmd = &cobra.Command{
 			for _, step := range campaign.Steps {
 				stepIDs = append(stepIDs, step.ActionClassID)
 			}
-			fmt.Printf("%d. score=%.3f objective=%s attained=%t risk=%.3f confidence=%.3f steps=%s\n", i+1, campaign.Score, campaign.Objective, campaign.Objective == objective, campaign.Risk, campaign.Confidence, strings.Join(stepIDs, " -> "))
+			attained := objectiveAttained(campaign, classes, objectives)
+			fmt.Printf("%d. score=%.3f objective=%s attained=%t risk=%.3f confidence=%.3f steps=%s\n", i+1, campaign.Score, campaign.Objective, attained, campaign.Risk, campaign.Confidence, strings.Join(stepIDs, " -> "))
 		}
 		return nil
 	},
 }
 
+// planEntry is the JSON form of one planned campaign printed by plan --format json.
+type planEntry struct {
+	Objective  string     `json:"objective"`
+	Attained   bool       `json:"attained"`
+	Score      float64    `json:"score"`
+	Risk       float64    `json:"risk"`
+	Confidence float64    `json:"confidence"`
+	Steps      []planStep `json:"steps"`
+}
+
+// planStep is the JSON form of one campaign step.
+type planStep struct {
+	ActionClassID string  `json:"action_class_id"`
+	Phase         string  `json:"phase"`
+	Statement     string  `json:"statement"`
+	Confidence    float64 `json:"confidence"`
+}
+
+func buildPlanEntries(campaigns []reasoning.Campaign, classes map[string]reasoning.ActionClass, objectives []weightedObjective) []planEntry {
+	entries := make([]planEntry, 0, len(campaigns))
+	for _, c := range campaigns {
+		entry := planEntry{Objective: string(c.Objective), Attained: objectiveAttained(c, classes, objectives), Score: c.Score, Risk: c.Risk, Confidence: c.Confidence, Steps: make([]planStep, 0, len(c.Steps))}
+		for _, step := range c.Steps {
+			entry.Steps = append(entry.Steps, planStep{ActionClassID: step.ActionClassID, Phase: string(step.Phase), Statement: step.Statement, Confidence: step.Confidence})
+		}
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+var pathsCmd = &cobra.Command{
+	Use:   "paths",
+	Short: "Expand attack paths from a seed evidence node",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		maxDepth, _ := cmd.Flags().GetInt("max-depth")
+		beamWidth, _ := cmd.Flags().GetInt("beam-width")
+		showStats, _ := cmd.Flags().GetBool("stats")
+
+		reasoner := reasoning.NewEngine(nil)
+		cfg := reasoning.DefaultAttackPathConfig()
+		cfg.MaxDepth, cfg.BeamWidth = maxDepth, beamWidth
+		reasoner.ConfigureAttackPathExpansion(cfg)
+		reasoner.Graph().UpsertNode(&reasoning.Node{ID: "paths-seed", Type: reasoning.NodeTypeEvidence, Label: "seed"})
+		st, err := state.New("paths")
+		if err != nil {
+			return err
+		}
+		paths, err := reasoner.ExpandAttackPaths(st)
+		if err != nil {
+			return err
+		}
+		for i, path := range paths {
+			stepIDs := make([]string, 0, len(path.Steps))
+			for _, step := range path.Steps {
+				stepIDs = append(stepIDs, step.ActionClassID)
+			}
+			fmt.Printf("%d. score=%.3f objective=%s risk=%.3f steps=%s\n", i+1, path.Score, path.Objective, path.Risk, strings.Join(stepIDs, " -> "))
+		}
+		if showStats {
+			fmt.Print(renderAttackPathStats(reasoning.ComputeAttackPathStats(paths)))
+		}
+		return nil
+	},
+}
+
+// renderAttackPathStats prints path length statistics followed by the length histogram in length order.
+func renderAttackPathStats(stats reasoning.AttackPathStats) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "paths=%d min_length=%d max_length=%d mean_length=%.2f score_length_correlation=%.3f\n", stats.Count, stats.MinLength, stats.MaxLength, stats.MeanLength, stats.ScoreLengthCorrelation)
+	lengths := make([]int, 0, len(stats.LengthHistogram))
+	for length := range stats.LengthHistogram {
+		lengths = append(lengths, length)
+	}
+	sort.Ints(lengths)
+	for _, length := range lengths {
+		fmt.Fprintf(&b, "length %d: %d\n", length, stats.LengthHistogram[length])
+	}
+	return b.String()
+}
+
+var diagnoseCmd = &cobra.Command{
+	Use:   "diagnose",
+	Short: "Report which lifecycle phases the loaded action-class corpus can reach",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		reasoner := reasoning.NewEngine(nil)
+		reasoner.Graph().UpsertNode(&reasoning.Node{ID: "diagnose-seed", Type: reasoning.NodeTypeEvidence, Label: "seed"})
+		reachability := reasoner.PhaseReachability()
+		for _, phase := range state.Phases() {
+			status := "unreachable"
+			if reachability[phase] {
+				status = "reachable"
+			}
+			fmt.Printf("%-18s %s\n", phase, status)
+		}
+		return nil
+	},
+}
+
+var lintCmd = &cobra.Command{
+	Use:   "lint",
+	Short: "Check the loaded action-class corpus for duplicate and dead-end classes",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		classes := reasoning.NewEngine(nil).ActionClasses()
+		for _, id := range reasoning.FindDeadEndActionClasses(classes) {
+			fmt.Printf("warning: action class %s produces no nodes or edges and is not objective-terminal\n", id)
+		}
+		groups := reasoning.FindDuplicateActionClasses(classes)
+		for _, ids := range groups {
+			fmt.Printf("duplicate action classes: %s\n", strings.Join(ids, ", "))
+		}
+		if len(groups) > 0 {
+			return fmt.Errorf("%d duplicate action-class group(s) found", len(groups))
+		}
+		fmt.Println("[+] no duplicate action classes")
+		return nil
+	},
+}
+
+var catalogCmd = &cobra.Command{
+	Use:   "catalog",
+	Short: "List bound action classes and the techniques registered under each",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		format, _ := cmd.Flags().GetString("format")
+		out, err := renderCatalog(buildCatalog(reasoning.NewEngine(nil).ActionClasses()), format)
+		if err != nil {
+			return err
+		}
+		fmt.Println(out)
+		return nil
+	},
+}
+
+// catalogEntry describes one action class and the techniques that implement it.
+type catalogEntry struct {
+	ID            string   `json:"id"`
+	Name          string   `json:"name"`
+	Phase         string   `json:"phase"`
+	Preconditions []string `json:"preconditions"`
+	ProducesNodes []string `json:"produces_nodes"`
+	ProducesEdges []string `json:"produces_edges"`
+	Techniques    []string `json:"techniques"`
+}
+
+func buildCatalog(classes []reasoning.ActionClass) []catalogEntry {
+	byClass := techniques.ByActionClass()
+	entries := make([]catalogEntry, 0, len(classes))
+	for _, ac := range classes {
+		entry := catalogEntry{ID: ac.ID, Name: ac.Name, Phase: string(ac.Phase), Preconditions: []string{}, ProducesNodes: []string{}, ProducesEdges: []string{}, Techniques: []string{}}
+		for _, pattern := range ac.Preconditions {
+			parts := make([]string, 0, len(pattern.RequiredNodeTypes)+len(pattern.RequiredEdges))
+			for _, n := range pattern.RequiredNodeTypes {
+				parts = append(parts, "node:"+string(n))
+			}
+			for _, e := range pattern.RequiredEdges {
+				parts = append(parts, "edge:"+string(e))
+			}
+			entry.Preconditions = append(entry.Preconditions, strings.Join(parts, "+"))
+		}
+		for _, n := range ac.ProducesNodes {
+			entry.ProducesNodes = append(entry.ProducesNodes, string(n))
+		}
+		for _, e := range ac.ProducesEdges {
+			entry.ProducesEdges = append(entry.ProducesEdges, string(e))
+		}
+		for _, t := range byClass[ac.ID] {
+			entry.Techniques = append(entry.Techniques, t.ID())
+		}
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+func renderCatalog(entries []catalogEntry, format string) (string, error) {
+	switch format {
+	case "json":
+		out, err := json.MarshalIndent(entries, "", "  ")
+		if err != nil {
+			return "", err
+		}
+		return string(out), nil
+	case "text":
+		var b strings.Builder
+		for _, entry := range entries {
+			fmt.Fprintf(&b, "%s %s phase=%s\n", entry.ID, entry.Name, entry.Phase)
+			preconditions := "none"
+			if len(entry.Preconditions) > 0 {
+				preconditions = strings.Join(entry.Preconditions, ", ")
+			}
+			fmt.Fprintf(&b, "  preconditions: %s\n", preconditions)
+			fmt.Fprintf(&b, "  produces: nodes=[%s] edges=[%s]\n", strings.Join(entry.ProducesNodes, ", "), strings.Join(entry.ProducesEdges, ", "))
+			fmt.Fprintf(&b, "  techniques (%d): %s\n", len(entry.Techniques), strings.Join(entry.Techniques, ", "))
+		}
+		return strings.TrimRight(b.String(), "\n"), nil
+	default:
+		return "", fmt.Errorf("unsupported format %q", format)
+	}
+}
+
 func init() {
 	runCmd.Flags().StringSlice("technique", nil, "Technique IDs (repeatable)")
 	runCmd.Flags().String("target", "", "Target identifier")
 	runCmd.Flags().String("campaign", "", "Campaign identifier")
+	runCmd.Flags().Uint64("max-exposure", defaultMaxExposure, "Exposure budget after which execution halts")
+	runCmd.Flags().Bool("allow-redundant", false, "Execute the selection even when it cannot add new node or edge types")
 	_ = runCmd.MarkFlagRequired("technique")
 	_ = runCmd.MarkFlagRequired("target")
 	_ = runCmd.MarkFlagRequired("campaign")
@@ -258,6 +817,13 @@ func init() {
 	loopCmd.Flags().String("target", "", "Target identifier")
 	loopCmd.Flags().String("campaign", "", "Campaign identifier")
 	loopCmd.Flags().Int("cycles", 3, "Number of cycles")
+	loopCmd.Flags().Int("stagnation-window", 0, "Stop early after this many cycles without progress (0 disables)")
+	loopCmd.Flags().Int("progress-threshold", 5, "Warn when more than this many cycles pass without objective progress (0 disables)")
+	loopCmd.Flags().Uint64("max-exposure", defaultMaxExposure, "Exposure budget shared by all cycles; the loop halts once it is reached")
+	loopCmd.Flags().Float64("explore", 0, "Fraction of cycles that explore a lower-ranked alternative instead of the best action")
+	loopCmd.Flags().Int("explore-top-k", 3, "Number of top-ranked actions exploration draws from, including the best")
+	loopCmd.Flags().Int64("explore-seed", 1, "Seed for exploration draws")
+	loopCmd.Flags().Bool("allow-redundant", false, "Execute selections even when they cannot add new node or edge types")
 	_ = loopCmd.MarkFlagRequired("technique")
 	_ = loopCmd.MarkFlagRequired("target")
 	_ = loopCmd.MarkFlagRequired("campaign")
@@ -265,27 +831,34 @@ func init() {
 	graphCmd.Flags().StringSlice("technique", nil, "Technique IDs (repeatable)")
 	graphCmd.Flags().String("target", "", "Target identifier")
 	graphCmd.Flags().String("campaign", "", "Campaign identifier")
+	graphCmd.Flags().String("format", "dot", "Output format (dot, json)")
 	_ = graphCmd.MarkFlagRequired("technique")
 	_ = graphCmd.MarkFlagRequired("target")
 	_ = graphCmd.MarkFlagRequired("campaign")
 
-	explainCmd.Flags().String("objective", "", "Objective node type")
-	_ = explainCmd.MarkFlagRequired("objective")
+	explainCmd.Flags().String("objective", "", "Objective node type (default $"+defaultObjectiveEnv+")")
 
-	compareCmd.Flags().String("objective", "", "Objective node type")
-	_ = compareCmd.MarkFlagRequired("objective")
+	compareCmd.Flags().String("objective", "", "Objective node type (default $"+defaultObjectiveEnv+")")
 
 	simulateCmd.Flags().StringSlice("technique", nil, "Technique IDs (repeatable)")
 	simulateCmd.Flags().String("target", "", "Target identifier")
+	simulateCmd.Flags().String("targets-file", "", "File of targets, one per line, to simulate and rank together")
 	_ = simulateCmd.MarkFlagRequired("technique")
-	_ = simulateCmd.MarkFlagRequired("target")
+	simulateCmd.MarkFlagsOneRequired("target", "targets-file")
+	simulateCmd.MarkFlagsMutuallyExclusive("target", "targets-file")
 
-	planCmd.Flags().String("objective", "", "Objective node type (DATA_EXPOSURE, PRIV_ESC, LATERAL_REACHABILITY)")
+	planCmd.Flags().String("objective", "", "Objective node types with optional weights (e.g. DATA_EXPOSURE=2,PRIV_ESC=1; default $"+defaultObjectiveEnv+")")
 	planCmd.Flags().Int("max-depth", reasoning.DefaultCampaignOptions().MaxDepth, "Maximum campaign depth")
 	planCmd.Flags().Float64("risk", reasoning.DefaultCampaignOptions().RiskTolerance, "Maximum cumulative risk tolerance")
 	planCmd.Flags().Float64("confidence", reasoning.DefaultCampaignOptions().ConfidenceThreshold, "Minimum average confidence threshold")
 	planCmd.Flags().Int("beam-width", reasoning.DefaultCampaignOptions().BeamWidth, "Beam width per depth")
-	_ = planCmd.MarkFlagRequired("objective")
+	planCmd.Flags().String("format", "text", "Output format (text, json)")
+
+	catalogCmd.Flags().String("format", "text", "Output format (text, json)")
+
+	pathsCmd.Flags().Int("max-depth", reasoning.DefaultAttackPathConfig().MaxDepth, "Maximum attack path depth")
+	pathsCmd.Flags().Int("beam-width", reasoning.DefaultAttackPathConfig().BeamWidth, "Beam width per depth")
+	pathsCmd.Flags().Bool("stats", false, "Print path length statistics after the paths")
 
-	rootCmd.AddCommand(runCmd, loopCmd, graphCmd, explainCmd, simulateCmd, planCmd, compareCmd)
+	rootCmd.AddCommand(runCmd, loopCmd, graphCmd, explainCmd, simulateCmd, planCmd, compareCmd, diagnoseCmd, catalogCmd, lintCmd, pathsCmd)
 }
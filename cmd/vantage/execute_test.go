@@ -0,0 +1,285 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+
+	"vantage/core/reasoning"
+	"vantage/core/state"
+)
+
+func TestParseWeightedObjectivesFavorsHigherWeight(t *testing.T) {
+	objectives, err := parseWeightedObjectives("priv_esc=1,DATA_EXPOSURE=2")
+	if err != nil {
+		t.Fatalf("parse weighted objectives: %v", err)
+	}
+	if len(objectives) != 2 || objectives[0].objective != reasoning.NodeTypeDataExposure || objectives[0].weight != 2 || objectives[1].objective != reasoning.NodeTypePrivEsc || objectives[1].weight != 1 {
+		t.Fatalf("unexpected parsed objectives: %+v", objectives)
+	}
+	for _, bad := range []string{"DATA_EXPOSURE=0", "DATA_EXPOSURE=x", "DATA_EXPOSURE,DATA_EXPOSURE=2", "UNKNOWN=1"} {
+		if _, err := parseWeightedObjectives(bad); err == nil {
+			t.Fatalf("expected %q to be rejected", bad)
+		}
+	}
+
+	evidence := []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{reasoning.NodeTypeEvidence}}}
+	reasoner := reasoning.NewEngine(nil)
+	reasoner.BindActionClasses([]reasoning.ActionClass{
+		{ID: "AC-DATA", Name: "data", Phase: state.PhaseRecon, Preconditions: evidence, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeDataExposure}, RiskWeight: 0.2, ConfidenceBoost: 0.2},
+		{ID: "AC-PRIV", Name: "priv", Phase: state.PhaseRecon, Preconditions: evidence, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypePrivEsc}, RiskWeight: 0.2, ConfidenceBoost: 0.2},
+	})
+	reasoner.Graph().UpsertNode(&reasoning.Node{ID: "seed", Type: reasoning.NodeTypeEvidence, Label: "seed"})
+
+	merged, err := planWeightedObjectives(reasoner, objectives, reasoning.CampaignOptions{MaxDepth: 2, RiskTolerance: 1, ConfidenceThreshold: 0.4, BeamWidth: 5, TopN: 5})
+	if err != nil {
+		t.Fatalf("plan weighted objectives: %v", err)
+	}
+	if len(merged) == 0 || merged[0].Objective != reasoning.NodeTypeDataExposure {
+		t.Fatalf("expected higher-weighted objective to lead merged output, got %+v", merged)
+	}
+	seenPriv := false
+	for _, c := range merged {
+		if c.Objective == reasoning.NodeTypePrivEsc {
+			seenPriv = true
+		} else if seenPriv {
+			t.Fatalf("expected every DATA_EXPOSURE campaign ahead of PRIV_ESC campaigns")
+		}
+	}
+	if !seenPriv {
+		t.Fatalf("expected lower-weighted objective campaigns in merged output")
+	}
+}
+
+func TestCatalogListsAllActionClassesWithTechniques(t *testing.T) {
+	classes, err := reasoning.LoadActionClassesFromDir("../../action-classes-normalized")
+	if err != nil {
+		t.Fatalf("load action classes: %v", err)
+	}
+	reasoner := reasoning.NewEngine(nil)
+	reasoner.BindActionClasses(classes)
+
+	entries := buildCatalog(reasoner.ActionClasses())
+	if len(entries) != 15 {
+		t.Fatalf("expected 15 action classes, got %d", len(entries))
+	}
+	for i, entry := range entries {
+		if want := fmt.Sprintf("AC-%02d", i+1); entry.ID != want {
+			t.Fatalf("expected %s at position %d, got %s", want, i, entry.ID)
+		}
+		if len(entry.Techniques) != 5 {
+			t.Fatalf("%s: expected 5 techniques, got %d", entry.ID, len(entry.Techniques))
+		}
+	}
+
+	out, err := renderCatalog(entries, "json")
+	if err != nil {
+		t.Fatalf("render json: %v", err)
+	}
+	var decoded []catalogEntry
+	if err := json.Unmarshal([]byte(out), &decoded); err != nil || len(decoded) != 15 {
+		t.Fatalf("expected 15 json catalog entries, got %d (%v)", len(decoded), err)
+	}
+	if _, err := renderCatalog(entries, "yaml"); err == nil {
+		t.Fatalf("expected unsupported format to be rejected")
+	}
+}
+
+func TestRunLoopHaltsAtMaxExposure(t *testing.T) {
+	rt, err := buildRuntime("loop-exposure", "host-1", []string{"T1595"}, 30)
+	if err != nil {
+		t.Fatalf("build runtime: %v", err)
+	}
+	rt.reasoner.RegisterTechniqueEffect(reasoning.TechniqueEffect{TechniqueID: "T1595", Impact: 0.5, Risk: 0.2, Stealth: 0.8})
+
+	ran, err := runLoop(rt, loopOptions{cycles: 10})
+	if err != nil {
+		t.Fatalf("expected loop to halt cleanly on exposure, got %v", err)
+	}
+	// Every execution costs 10 exposure, so a budget of 30 is spent by the third cycle.
+	if ran != 3 || !rt.exposure.Halted() || rt.exposure.Score() != 30 {
+		t.Fatalf("expected halt after 3 cycles at score 30, ran %d with score %d", ran, rt.exposure.Score())
+	}
+}
+
+func TestSimulateTargetsFileYieldsOneRowPerTarget(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "hosts.txt")
+	content := "# scoped assets\nweb-1\n\n  db-1  \n# decommissioned\nmail-1\n"
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatalf("write targets file: %v", err)
+	}
+	targets, err := readTargetsFile(path)
+	if err != nil {
+		t.Fatalf("read targets file: %v", err)
+	}
+	if strings.Join(targets, ",") != "web-1,db-1,mail-1" {
+		t.Fatalf("unexpected targets %v", targets)
+	}
+
+	results, err := simulateTargets(targets, []string{"AC01PassiveDNSCollection", "AC02SurfaceProbe"})
+	if err != nil {
+		t.Fatalf("simulate targets: %v", err)
+	}
+	if len(results) != len(targets) {
+		t.Fatalf("expected %d results, got %d", len(targets), len(results))
+	}
+	seen := map[string]bool{}
+	for i, r := range results {
+		seen[r.target] = true
+		if r.techniqueID == "" {
+			t.Fatalf("expected a selected technique for %s", r.target)
+		}
+		if i > 0 && r.score > results[i-1].score {
+			t.Fatalf("expected results sorted by score, got %+v", results)
+		}
+	}
+	if len(seen) != len(targets) {
+		t.Fatalf("expected one row per target, got %+v", results)
+	}
+	if rows := strings.Count(renderSimulateTable(results), "\n"); rows != len(targets)+1 {
+		t.Fatalf("expected header plus %d rows, got %d lines", len(targets), rows)
+	}
+}
+
+func TestPlanJSONCarriesStepSequence(t *testing.T) {
+	reasoner := reasoning.NewEngine(nil)
+	reasoner.BindActionClasses([]reasoning.ActionClass{
+		{ID: "AC-SCAN", Name: "scan", Phase: state.PhaseRecon, Preconditions: []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{reasoning.NodeTypeEvidence}}}, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeHypothesis}, RiskWeight: 0.1, ConfidenceBoost: 0.2},
+		{ID: "AC-DATA", Name: "data", Phase: state.PhaseRecon, Preconditions: []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{reasoning.NodeTypeHypothesis}}}, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeDataExposure}, RiskWeight: 0.2, ConfidenceBoost: 0.2},
+	})
+	reasoner.Graph().UpsertNode(&reasoning.Node{ID: "seed", Type: reasoning.NodeTypeEvidence, Label: "seed"})
+	objectives := []weightedObjective{{objective: reasoning.NodeTypeDataExposure, weight: 1}}
+	campaigns, err := planWeightedObjectives(reasoner, objectives, reasoning.CampaignOptions{MaxDepth: 3, RiskTolerance: 1, ConfidenceThreshold: 0.4, BeamWidth: 5, TopN: 5})
+	if err != nil || len(campaigns) == 0 {
+		t.Fatalf("expected campaigns, got %d (%v)", len(campaigns), err)
+	}
+
+	classes := actionClassesByID(reasoner.ActionClasses())
+	raw, err := json.Marshal(buildPlanEntries(campaigns, classes, objectives))
+	if err != nil {
+		t.Fatalf("marshal plan: %v", err)
+	}
+	var decoded []struct {
+		Objective string `json:"objective"`
+		Attained  bool   `json:"attained"`
+		Steps     []struct {
+			ActionClassID string `json:"action_class_id"`
+		} `json:"steps"`
+	}
+	if err := json.Unmarshal(raw, &decoded); err != nil {
+		t.Fatalf("unmarshal plan: %v", err)
+	}
+	if len(decoded) != len(campaigns) {
+		t.Fatalf("expected %d campaigns in JSON, got %d", len(campaigns), len(decoded))
+	}
+	top := decoded[0]
+	if top.Objective != string(reasoning.NodeTypeDataExposure) || !top.Attained {
+		t.Fatalf("expected attained DATA_EXPOSURE campaign, got %+v", top)
+	}
+	ids := make([]string, 0, len(top.Steps))
+	for _, step := range top.Steps {
+		ids = append(ids, step.ActionClassID)
+	}
+	if strings.Join(ids, ",") != "AC-SCAN,AC-DATA" {
+		t.Fatalf("expected AC-SCAN,AC-DATA step sequence, got %v", ids)
+	}
+
+	stalled := reasoning.Campaign{Objective: reasoning.NodeTypeDataExposure, Steps: []reasoning.AttackStep{{ActionClassID: "AC-DATA"}, {ActionClassID: "AC-SCAN"}}}
+	if entries := buildPlanEntries([]reasoning.Campaign{stalled}, classes, objectives); entries[0].Attained {
+		t.Fatalf("expected a campaign whose last step does not produce the objective to be unattained, got %+v", entries[0])
+	}
+}
+
+func TestMergeWeightedCampaignsKeepsWeightMonotonicForNegativeScores(t *testing.T) {
+	objectives := []weightedObjective{{objective: reasoning.NodeTypeDataExposure, weight: 3}, {objective: reasoning.NodeTypePrivEsc, weight: 1}}
+	planned := [][]reasoning.Campaign{
+		{{Objective: reasoning.NodeTypeDataExposure, Score: -0.2}},
+		{{Objective: reasoning.NodeTypePrivEsc, Score: -0.4}},
+	}
+	merged := mergeWeightedCampaigns(planned, objectives)
+	if len(merged) != 2 || merged[0].Objective != reasoning.NodeTypeDataExposure {
+		t.Fatalf("expected the heavier objective to lead despite negative scores, got %+v", merged)
+	}
+	for _, c := range merged {
+		if c.Score < 0 {
+			t.Fatalf("expected weighted scores to be non-negative, got %+v", merged)
+		}
+	}
+}
+
+func TestMaxExposureFlagRejectsExplicitZero(t *testing.T) {
+	newCmd := func() *cobra.Command {
+		cmd := &cobra.Command{Use: "run"}
+		cmd.Flags().Uint64("max-exposure", defaultMaxExposure, "")
+		return cmd
+	}
+	if got, err := maxExposureFlag(newCmd()); err != nil || got != defaultMaxExposure {
+		t.Fatalf("expected the default budget when unset, got %d (%v)", got, err)
+	}
+	cmd := newCmd()
+	if err := cmd.Flags().Set("max-exposure", "0"); err != nil {
+		t.Fatalf("set flag: %v", err)
+	}
+	if _, err := maxExposureFlag(cmd); err == nil {
+		t.Fatalf("expected an explicit --max-exposure 0 to be rejected")
+	}
+	if err := cmd.Flags().Set("max-exposure", "40"); err != nil {
+		t.Fatalf("set flag: %v", err)
+	}
+	if got, err := maxExposureFlag(cmd); err != nil || got != 40 {
+		t.Fatalf("expected the explicit budget, got %d (%v)", got, err)
+	}
+}
+
+func TestObjectiveFlagFallsBackToConfiguredDefault(t *testing.T) {
+	newCmd := func() *cobra.Command {
+		cmd := &cobra.Command{Use: "plan"}
+		cmd.Flags().String("objective", "", "")
+		return cmd
+	}
+	t.Setenv(defaultObjectiveEnv, "")
+	if _, err := objectiveFlag(newCmd()); err == nil {
+		t.Fatalf("expected an error without --objective or %s", defaultObjectiveEnv)
+	}
+
+	t.Setenv(defaultObjectiveEnv, "PRIV_ESC")
+	raw, err := objectiveFlag(newCmd())
+	if err != nil || raw != "PRIV_ESC" {
+		t.Fatalf("expected the configured default PRIV_ESC, got %q (%v)", raw, err)
+	}
+
+	cmd := newCmd()
+	if err := cmd.Flags().Set("objective", "DATA_EXPOSURE"); err != nil {
+		t.Fatalf("set objective: %v", err)
+	}
+	raw, err = objectiveFlag(cmd)
+	if err != nil || raw != "DATA_EXPOSURE" {
+		t.Fatalf("expected --objective to override the default, got %q (%v)", raw, err)
+	}
+}
+
+func TestExplainBreaksDownPathsReachingObjective(t *testing.T) {
+	reasoner := reasoning.NewEngine(nil)
+	reasoner.BindActionClasses([]reasoning.ActionClass{
+		{ID: "AC-SCAN", Name: "scan", Phase: state.PhaseRecon, Preconditions: []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{reasoning.NodeTypeEvidence}}}, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeHypothesis}, RiskWeight: 0.1, ConfidenceBoost: 0.2},
+		{ID: "AC-DATA", Name: "data", Phase: state.PhaseRecon, Preconditions: []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{reasoning.NodeTypeHypothesis}}}, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeDataExposure}, RiskWeight: 0.2, ConfidenceBoost: 0.2},
+	})
+	reasoner.Graph().UpsertNode(&reasoning.Node{ID: "explain-seed", Type: reasoning.NodeTypeEvidence, Label: "seed"})
+
+	paths, err := attackPathsReaching(reasoner, reasoning.NodeTypeDataExposure)
+	if err != nil {
+		t.Fatalf("attack paths reaching: %v", err)
+	}
+	if len(paths) == 0 {
+		t.Fatalf("expected paths reaching DATA_EXPOSURE")
+	}
+	out := renderPathExplanations(paths)
+	if strings.Contains(out, "No attack paths") || !strings.Contains(out, "AC-DATA") || !strings.Contains(out, "objective_proximity=") {
+		t.Fatalf("expected a non-empty breakdown, got:\n%s", out)
+	}
+}
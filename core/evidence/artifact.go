@@ -70,9 +70,29 @@ type Artifact struct {
 	// ExposureScore captures exposure at time of execution.
 	ExposureScore uint64
 
+	// ExposureLevel captures the qualitative exposure level
+	// at time of execution (low, medium, high, critical).
+	ExposureLevel string
+
+	// RemainingBudget captures how much exposure could still be
+	// accrued before the campaign halts.
+	RemainingBudget uint64
+
 	// Integrity contains the cryptographic signature
 	// over all other fields.
 	Integrity string
+
+	// Addendum holds derived metadata attached after signing
+	// (e.g. hypothesis IDs generated from this fact).
+	//
+	// It is NOT covered by Integrity; it is covered by
+	// AddendumIntegrity, so the original fact stays provable
+	// on its own.
+	Addendum map[string]string
+
+	// AddendumIntegrity seals Addendum together with the
+	// original Integrity signature.
+	AddendumIntegrity string
 }
 
 // Validate performs structural validation prior to signing.
@@ -68,30 +68,127 @@ func (a *Artifact) Verify() (bool, error) {
 	return a.Integrity == expected, nil
 }
 
+// Reseal appends derived metadata to a signed artifact
+// and seals it under a combined digest.
+//
+// The original Integrity signature is preserved untouched,
+// so the execution fact remains independently verifiable.
+// Existing addendum keys can never be overwritten.
+func (a *Artifact) Reseal(addendum map[string]string) error {
+
+	if len(addendum) == 0 {
+		return errors.New("empty addendum")
+	}
+
+	ok, err := a.Verify()
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errors.New("cannot reseal artifact with invalid integrity")
+	}
+
+	if a.AddendumIntegrity != "" {
+		valid, err := a.VerifyAddendum()
+		if err != nil {
+			return err
+		}
+		if !valid {
+			return errors.New("cannot reseal artifact with invalid addendum")
+		}
+	}
+
+	merged := make(map[string]string, len(a.Addendum)+len(addendum))
+	for k, v := range a.Addendum {
+		merged[k] = v
+	}
+	for k, v := range addendum {
+		if _, exists := merged[k]; exists {
+			return errors.New("addendum key already sealed: " + k)
+		}
+		merged[k] = v
+	}
+
+	digest, err := addendumDigest(a.Integrity, merged)
+	if err != nil {
+		return err
+	}
+
+	a.Addendum = merged
+	a.AddendumIntegrity = digest
+
+	return nil
+}
+
+// VerifyAddendum checks both the original signature
+// and the combined addendum seal.
+func (a *Artifact) VerifyAddendum() (bool, error) {
+
+	if a.AddendumIntegrity == "" {
+		return false, errors.New("artifact has no sealed addendum")
+	}
+
+	ok, err := a.Verify()
+	if err != nil || !ok {
+		return false, err
+	}
+
+	expected, err := addendumDigest(a.Integrity, a.Addendum)
+	if err != nil {
+		return false, err
+	}
+
+	return a.AddendumIntegrity == expected, nil
+}
+
+// addendumDigest chains the original signature with
+// the addendum. Map keys marshal in sorted order,
+// keeping the digest deterministic.
+func addendumDigest(integrity string, addendum map[string]string) (string, error) {
+
+	payload, err := json.Marshal(struct {
+		Integrity string
+		Addendum  map[string]string
+	}{
+		Integrity: integrity,
+		Addendum:  addendum,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.Sum256(payload)
+	return hex.EncodeToString(hash[:]), nil
+}
+
 // canonicalPayload produces a deterministic byte representation
 // of the artifact excluding the Integrity field.
 func canonicalPayload(a *Artifact) ([]byte, error) {
 
 	type signedView struct {
-		ArtifactID    string
-		CampaignID    string
-		TechniqueID   string
-		Target        string
-		ExecutedAt    time.Time
-		Success       bool
-		Output        string
-		ExposureScore uint64
+		ArtifactID      string
+		CampaignID      string
+		TechniqueID     string
+		Target          string
+		ExecutedAt      time.Time
+		Success         bool
+		Output          string
+		ExposureScore   uint64
+		ExposureLevel   string
+		RemainingBudget uint64
 	}
 
 	view := signedView{
-		ArtifactID:    a.ArtifactID,
-		CampaignID:    a.CampaignID,
-		TechniqueID:   a.TechniqueID,
-		Target:        a.Target,
-		ExecutedAt:    a.ExecutedAt,
-		Success:       a.Success,
-		Output:        a.Output,
-		ExposureScore: a.ExposureScore,
+		ArtifactID:      a.ArtifactID,
+		CampaignID:      a.CampaignID,
+		TechniqueID:     a.TechniqueID,
+		Target:          a.Target,
+		ExecutedAt:      a.ExecutedAt,
+		Success:         a.Success,
+		Output:          a.Output,
+		ExposureScore:   a.ExposureScore,
+		ExposureLevel:   a.ExposureLevel,
+		RemainingBudget: a.RemainingBudget,
 	}
 
 	return json.Marshal(view)
@@ -0,0 +1,62 @@
+package tests
+
+import (
+	"testing"
+	"time"
+
+	"vantage/core/evidence"
+)
+
+func newSignedArtifact(t *testing.T) *evidence.Artifact {
+	t.Helper()
+	artifact := &evidence.Artifact{
+		ArtifactID:  "artifact-1",
+		CampaignID:  "campaign-1",
+		TechniqueID: "T1595",
+		Target:      "host-1",
+		ExecutedAt:  time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+		Success:     true,
+	}
+	if err := artifact.Sign(); err != nil {
+		t.Fatalf("sign: %v", err)
+	}
+	return artifact
+}
+
+func TestResealPreservesOriginalSignatureAndSealsAddendum(t *testing.T) {
+	artifact := newSignedArtifact(t)
+	original := artifact.Integrity
+
+	if err := artifact.Reseal(map[string]string{"hypotheses": "hyp-1,hyp-2"}); err != nil {
+		t.Fatalf("reseal: %v", err)
+	}
+	if artifact.Integrity != original {
+		t.Fatalf("expected original signature to be preserved")
+	}
+	if ok, err := artifact.Verify(); err != nil || !ok {
+		t.Fatalf("expected original signature to verify: ok=%t err=%v", ok, err)
+	}
+	if ok, err := artifact.VerifyAddendum(); err != nil || !ok {
+		t.Fatalf("expected resealed signature to verify: ok=%t err=%v", ok, err)
+	}
+
+	if err := artifact.Reseal(map[string]string{"hypotheses": "hyp-3"}); err == nil {
+		t.Fatalf("expected overwrite of sealed addendum key to be rejected")
+	}
+
+	artifact.Addendum["hypotheses"] = "hyp-9"
+	if ok, _ := artifact.VerifyAddendum(); ok {
+		t.Fatalf("expected tampered addendum to fail verification")
+	}
+	if ok, err := artifact.Verify(); err != nil || !ok {
+		t.Fatalf("expected original fact to remain verifiable after addendum tampering")
+	}
+}
+
+func TestResealRejectsTamperedArtifact(t *testing.T) {
+	artifact := newSignedArtifact(t)
+	artifact.Target = "host-2"
+	if err := artifact.Reseal(map[string]string{"note": "x"}); err == nil {
+		t.Fatalf("expected reseal of tampered artifact to fail")
+	}
+}
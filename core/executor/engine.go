@@ -14,6 +14,8 @@ import (
 	"vantage/core/intent"
 	"vantage/core/roe"
 	"vantage/core/state"
+	"vantage/techniques"
+	"vantage/techniques/model"
 )
 
 // ============================================================================
@@ -53,6 +55,19 @@ type Engine struct {
 	// exposure tracks cumulative detection risk.
 	// Exposure is conservative and monotonic.
 	exposure *exposure.Tracker
+
+	// exposureModel prices each execution attempt.
+	exposureModel ExposureModel
+
+	// techniqueRisk holds registered technique risk modifiers by ID.
+	techniqueRisk map[string]float64
+
+	// techniqueNoise holds registered technique noise profiles by ID.
+	techniqueNoise map[string]model.NoiseProfile
+
+	// techniqueClass holds registered technique action classes by ID.
+	// It tags exposure so the tracker can attribute it per category.
+	techniqueClass map[string]string
 }
 
 // -----------------------------------------------------------------------------
@@ -71,6 +86,21 @@ func New(
 	campaign *state.Campaign,
 	exposureTracker *exposure.Tracker,
 ) (*Engine, error) {
+	return NewWithExposureModel(contract, campaign, exposureTracker, FlatExposure(DefaultExecutionExposure))
+}
+
+// -----------------------------------------------------------------------------
+// NewWithExposureModel constructs an engine that prices each execution
+// attempt with exposureModel instead of the flat default.
+//
+// The model is bound here and, like the rest of the engine, is immutable.
+// -----------------------------------------------------------------------------
+func NewWithExposureModel(
+	contract *intent.Contract,
+	campaign *state.Campaign,
+	exposureTracker *exposure.Tracker,
+	exposureModel ExposureModel,
+) (*Engine, error) {
 
 	// Defensive validation — programmer errors
 	if contract == nil {
@@ -82,19 +112,80 @@ func New(
 	if exposureTracker == nil {
 		return nil, errors.New("engine requires non-nil exposure tracker")
 	}
+	if exposureModel == nil {
+		return nil, errors.New("engine requires non-nil exposure model")
+	}
 
 	// Validate intent contract once and only once
 	if err := contract.Validate(); err != nil {
 		return nil, fmt.Errorf("invalid intent contract: %w", err)
 	}
 
+	risks := make(map[string]float64)
+	noise := make(map[string]model.NoiseProfile)
+	classes := make(map[string]string)
+	for id, t := range techniques.RegisterAll() {
+		risks[id] = t.RiskModifier()
+		noise[id] = t.NoiseProfile()
+		classes[id] = t.ActionClassID()
+	}
+
 	return &Engine{
-		contract: contract,
-		campaign: campaign,
-		exposure: exposureTracker,
+		contract:       contract,
+		campaign:       campaign,
+		exposure:       exposureTracker,
+		exposureModel:  exposureModel,
+		techniqueRisk:  risks,
+		techniqueNoise: noise,
+		techniqueClass: classes,
 	}, nil
 }
 
+// ExposureCost returns the exposure one attempt of techniqueID incurs.
+//
+// Costs are never zero: a zero price is raised to 1 so that every
+// attempt strictly increases exposure.
+func (e *Engine) ExposureCost(techniqueID string) uint64 {
+	risk := e.techniqueRisk[techniqueID]
+	noise, ok := e.techniqueNoise[techniqueID]
+	if !ok {
+		noise = model.DefaultNoiseProfile(risk)
+	}
+	cost := e.exposureModel.Cost(techniqueID, risk, noise)
+	if cost == 0 {
+		return 1
+	}
+	return cost
+}
+
+// TargetContext is the non-sensitive target context used during resolution.
+type TargetContext struct {
+	// HighLevelType is the declared target category, or "unknown".
+	HighLevelType string
+
+	// KnownAccess reports whether access to the target is declared to exist.
+	KnownAccess bool
+}
+
+// ResolveTargetContext returns the context declared for target in the
+// intent contract.
+//
+// Undeclared context is conservative:
+// - Type is "unknown"
+// - No prior access is assumed
+func (e *Engine) ResolveTargetContext(target string) TargetContext {
+	ctx := TargetContext{HighLevelType: "unknown"}
+	spec, ok := e.contract.TargetSpec(target)
+	if !ok {
+		return ctx
+	}
+	if spec.Type != "" {
+		ctx.HighLevelType = spec.Type
+	}
+	ctx.KnownAccess = spec.KnownAccess
+	return ctx
+}
+
 // -----------------------------------------------------------------------------
 // Run evaluates EXACTLY ONE technique against EXACTLY ONE target.
 //
@@ -177,10 +268,16 @@ func (e *Engine) Run(
 	// 4. TECHNIQUE RESOLUTION (DECISION ONLY)
 	// -----------------------------------------------------------------
 
+	// Target context comes from the contract's declared target specs.
+	// It informs resolution and advisory only — never authority.
+	targetContext := e.ResolveTargetContext(target)
+
 	resolution := struct {
 		AllowedActionClasses []string
+		Target               TargetContext
 	}{
-		AllowedActionClasses: []string{"policy_validated_attempt"},
+		AllowedActionClasses: resolveActionClasses(),
+		Target:               targetContext,
 	}
 
 	// -----------------------------------------------------------------
@@ -215,8 +312,8 @@ func (e *Engine) Run(
 			HighLevelType string `json:"high_level_type"`
 			KnownAccess   bool   `json:"known_access"`
 		}{
-			HighLevelType: "unknown",
-			KnownAccess:   false,
+			HighLevelType: resolution.Target.HighLevelType,
+			KnownAccess:   resolution.Target.KnownAccess,
 		},
 		CanonicalActionClasses: resolution.AllowedActionClasses,
 	})
@@ -228,7 +325,11 @@ func (e *Engine) Run(
 
 	// Record that an execution attempt occurred,
 	// regardless of outcome.
-	_ = e.campaign.RecordExecution()
+	// A refusal here means the campaign halted itself
+	// (e.g. max duration exceeded) and execution must not proceed.
+	if err := e.campaign.RecordExecution(); err != nil {
+		return nil, fmt.Errorf("execution denied: %w", err)
+	}
 
 	startedAt := time.Now().UTC()
 	var execErr error
@@ -242,11 +343,10 @@ func (e *Engine) Run(
 	// 7. EXPOSURE ACCOUNTING (CONSERVATIVE)
 	// -----------------------------------------------------------------
 
-	// v0.x policy:
-	// Every execution attempt incurs fixed exposure.
-	const executionExposure uint64 = 10
-
-	_ = e.exposure.Add(executionExposure)
+	// Every execution attempt incurs exposure priced by the bound model
+	// from the technique's risk (flat 10 units by default), attributed to
+	// its action class. Unregistered techniques are recorded untagged.
+	_ = e.exposure.AddTagged(e.ExposureCost(techniqueID), e.techniqueClass[techniqueID])
 
 	if e.exposure.Halted() {
 		_ = e.campaign.Halt("exposure limit exceeded")
@@ -257,14 +357,16 @@ func (e *Engine) Run(
 	// -----------------------------------------------------------------
 
 	artifact := &evidence.Artifact{
-		ArtifactID:    uuid.NewString(),
-		CampaignID:    e.contract.CampaignID,
-		TechniqueID:   techniqueID,
-		Target:        target,
-		ExecutedAt:    startedAt,
-		Success:       execErr == nil,
-		Output:        "",
-		ExposureScore: e.exposure.Score(),
+		ArtifactID:      uuid.NewString(),
+		CampaignID:      e.contract.CampaignID,
+		TechniqueID:     techniqueID,
+		Target:          target,
+		ExecutedAt:      startedAt,
+		Success:         execErr == nil,
+		Output:          "",
+		ExposureScore:   e.exposure.Score(),
+		ExposureLevel:   e.exposure.Level().String(),
+		RemainingBudget: e.exposure.Remaining(),
 	}
 
 	// Evidence MUST be signed exactly once
@@ -286,3 +388,102 @@ func (e *Engine) Run(
 
 	return artifact, nil
 }
+
+// resolveActionClasses returns the action classes an admitted attempt
+// may take. v0.x resolves every admitted attempt to a single class.
+func resolveActionClasses() []string {
+	return []string{"policy_validated_attempt"}
+}
+
+// DryRunReport describes whether an execution would be admitted,
+// without any execution having occurred.
+type DryRunReport struct {
+	TechniqueID string
+	Target      string
+
+	// Admissible reports whether Run would proceed past governance.
+	Admissible bool
+
+	// Reason explains why the attempt is not admissible; empty otherwise.
+	Reason string
+
+	// ActionClasses are the resolved action classes, when admissible.
+	ActionClasses []string
+
+	// TargetContext is the declared context resolution would use.
+	TargetContext TargetContext
+
+	// ExposureCost is the exposure the attempt would incur.
+	ExposureCost uint64
+
+	// WouldHalt reports whether that exposure would exhaust the budget.
+	WouldHalt bool
+}
+
+// -----------------------------------------------------------------------------
+// DryRun previews Run for EXACTLY ONE technique against EXACTLY ONE target.
+//
+// It performs the same checks as Run steps 1–4:
+// - Context validation
+// - Campaign lifecycle
+// - ROE + intent enforcement
+// - Technique resolution
+//
+// It NEVER:
+// - Starts the campaign or records an execution
+// - Adds exposure
+// - Produces evidence
+//
+// Governance denials are reported in the DryRunReport, not as errors.
+// Errors are reserved for invalid or cancelled contexts.
+// -----------------------------------------------------------------------------
+func (e *Engine) DryRun(
+	ctx context.Context,
+	techniqueID string,
+	target string,
+) (*DryRunReport, error) {
+
+	if ctx == nil {
+		return nil, errors.New("nil execution context")
+	}
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+	}
+
+	report := &DryRunReport{
+		TechniqueID:   techniqueID,
+		Target:        target,
+		TargetContext: e.ResolveTargetContext(target),
+		ExposureCost:  e.ExposureCost(techniqueID),
+	}
+
+	// Lifecycle is inspected, never transitioned.
+	switch status := e.campaign.Status(); status {
+	case state.StatusHalted, state.StatusCompleted:
+		report.Reason = fmt.Sprintf("execution denied: campaign is %s", status)
+		return report, nil
+	}
+
+	if e.exposure.Halted() {
+		report.Reason = "execution halted due to exposure"
+		return report, nil
+	}
+
+	if err := roe.Enforce(e.contract, techniqueID, target); err != nil {
+		report.Reason = err.Error()
+		return report, nil
+	}
+
+	report.ActionClasses = resolveActionClasses()
+	if len(report.ActionClasses) == 0 {
+		report.Reason = "no admissible action classes"
+		return report, nil
+	}
+
+	report.Admissible = true
+	report.WouldHalt = report.ExposureCost >= e.exposure.Remaining()
+	return report, nil
+}
@@ -0,0 +1,75 @@
+package executor
+
+import (
+	"math"
+
+	"vantage/techniques/model"
+)
+
+// -----------------------------------------------------------------------------
+// EXPOSURE MODEL — PER-TECHNIQUE EXPOSURE COST
+//
+// An ExposureModel decides how much exposure ONE execution attempt incurs.
+//
+// RULES:
+// - Costs are deterministic for a given technique and risk
+// - A zero cost is raised to 1 so exposure stays strictly monotonic
+// - The model is bound at construction and never changes afterwards
+// -----------------------------------------------------------------------------
+
+// DefaultExecutionExposure is the flat v0.x cost of one execution attempt.
+const DefaultExecutionExposure uint64 = 10
+
+// ExposureModel maps a resolved technique, its risk modifier, and its
+// noise profile to the exposure one execution attempt incurs.
+//
+// Techniques missing from the registry are costed with risk 0 and the
+// noise profile that risk implies.
+type ExposureModel interface {
+	Cost(techniqueID string, risk float64, noise model.NoiseProfile) uint64
+}
+
+// ExposureFunc adapts a plain function to ExposureModel.
+type ExposureFunc func(techniqueID string, risk float64, noise model.NoiseProfile) uint64
+
+// Cost calls f.
+func (f ExposureFunc) Cost(techniqueID string, risk float64, noise model.NoiseProfile) uint64 {
+	return f(techniqueID, risk, noise)
+}
+
+// FlatExposure charges every attempt the same number of units,
+// regardless of technique risk.
+func FlatExposure(units uint64) ExposureModel {
+	return ExposureFunc(func(string, float64, model.NoiseProfile) uint64 { return units })
+}
+
+// RiskScaledExposure charges round(baseUnit * (1 + risk)), so riskier
+// techniques consume proportionally more of the exposure budget.
+func RiskScaledExposure(baseUnit uint64) ExposureModel {
+	return ExposureFunc(func(_ string, risk float64, _ model.NoiseProfile) uint64 {
+		return uint64(math.Round(float64(baseUnit) * (1 + math.Max(risk, 0))))
+	})
+}
+
+// NoiseWeights scales exposure cost by interaction pattern.
+//
+// Passive observation is discounted and bursts are surcharged relative
+// to paced, low-rate interaction. Unlisted profiles weigh 1.
+var NoiseWeights = map[model.NoiseProfile]float64{
+	model.NoisePassive: 0.5,
+	model.NoiseLowRate: 1.0,
+	model.NoiseBurst:   1.5,
+}
+
+// NoiseWeightedExposure scales the cost of base by the technique's
+// NoiseWeights entry, so that of two equal-risk techniques the burstier
+// one costs more.
+func NoiseWeightedExposure(base ExposureModel) ExposureModel {
+	return ExposureFunc(func(techniqueID string, risk float64, noise model.NoiseProfile) uint64 {
+		weight, ok := NoiseWeights[noise]
+		if !ok {
+			weight = 1
+		}
+		return uint64(math.Round(float64(base.Cost(techniqueID, risk, noise)) * weight))
+	})
+}
@@ -0,0 +1,196 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"vantage/core/executor"
+	"vantage/core/exposure"
+	"vantage/core/intent"
+	"vantage/core/state"
+	"vantage/techniques/model"
+)
+
+func newContract() *intent.Contract {
+	return &intent.Contract{
+		CampaignID:        "executor-test",
+		Objective:         "executor test",
+		AllowedTechniques: []string{"T1595"},
+		Targets:           []string{"host-1"},
+		NotBefore:         time.Now().UTC().Add(-1 * time.Minute),
+		NotAfter:          time.Now().UTC().Add(10 * time.Minute),
+	}
+}
+
+func newEngine(t *testing.T, budget uint64) (*executor.Engine, *state.Campaign, *exposure.Tracker) {
+	t.Helper()
+	contract := newContract()
+	campaign, err := state.New(contract.CampaignID)
+	if err != nil {
+		t.Fatalf("state new: %v", err)
+	}
+	tracker, err := exposure.New(budget)
+	if err != nil {
+		t.Fatalf("exposure new: %v", err)
+	}
+	eng, err := executor.New(contract, campaign, tracker)
+	if err != nil {
+		t.Fatalf("executor new: %v", err)
+	}
+	return eng, campaign, tracker
+}
+
+func TestRunRecordsExposureBudgetInArtifact(t *testing.T) {
+	eng, _, tracker := newEngine(t, 40)
+
+	artifact, err := eng.Run(context.Background(), "T1595", "host-1")
+	if err != nil {
+		t.Fatalf("run: %v", err)
+	}
+	if artifact.ExposureLevel != tracker.Level().String() {
+		t.Fatalf("expected level %s, got %s", tracker.Level(), artifact.ExposureLevel)
+	}
+	if artifact.RemainingBudget != tracker.Remaining() || artifact.RemainingBudget != 30 {
+		t.Fatalf("expected remaining budget 30, got %d", artifact.RemainingBudget)
+	}
+
+	artifact, err = eng.Run(context.Background(), "T1595", "host-1")
+	if err != nil {
+		t.Fatalf("second run: %v", err)
+	}
+	if artifact.ExposureLevel != "medium" || artifact.RemainingBudget != 20 {
+		t.Fatalf("expected medium/20 after second run, got %s/%d", artifact.ExposureLevel, artifact.RemainingBudget)
+	}
+
+	ok, err := artifact.Verify()
+	if err != nil || !ok {
+		t.Fatalf("expected artifact to verify: ok=%v err=%v", ok, err)
+	}
+	artifact.RemainingBudget++
+	if ok, _ := artifact.Verify(); ok {
+		t.Fatalf("expected signature to cover remaining budget")
+	}
+	artifact.RemainingBudget--
+	artifact.ExposureLevel = "low"
+	if ok, _ := artifact.Verify(); ok {
+		t.Fatalf("expected signature to cover exposure level")
+	}
+}
+
+func TestResolveTargetContextReflectsDeclaredSpec(t *testing.T) {
+	contract := newContract()
+	contract.Targets = append(contract.Targets, "host-2")
+	contract.TargetSpecs = []intent.TargetSpec{{ID: "host-1", Type: "web_application", KnownAccess: true}}
+	campaign, _ := state.New(contract.CampaignID)
+	tracker, _ := exposure.New(100)
+	eng, err := executor.New(contract, campaign, tracker)
+	if err != nil {
+		t.Fatalf("executor new: %v", err)
+	}
+
+	if got := eng.ResolveTargetContext("host-1"); got.HighLevelType != "web_application" || !got.KnownAccess {
+		t.Fatalf("expected declared context for host-1, got %+v", got)
+	}
+	if got := eng.ResolveTargetContext("host-2"); got.HighLevelType != "unknown" || got.KnownAccess {
+		t.Fatalf("expected conservative context for undeclared spec, got %+v", got)
+	}
+
+	contract = newContract()
+	contract.TargetSpecs = []intent.TargetSpec{{ID: "host-9", Type: "network_service"}}
+	if _, err := executor.New(contract, campaign, tracker); err == nil {
+		t.Fatalf("expected a spec for an undeclared target to be rejected")
+	}
+}
+
+func TestRiskScaledExposureChargesRiskierTechniquesMore(t *testing.T) {
+	contract := newContract()
+	contract.AllowedTechniques = []string{"T1595", "AC01PassiveDNSCollection", "AC08FederatedCredentialPivot"}
+	campaign, _ := state.New(contract.CampaignID)
+	tracker, _ := exposure.New(100)
+	eng, err := executor.NewWithExposureModel(contract, campaign, tracker, executor.RiskScaledExposure(10))
+	if err != nil {
+		t.Fatalf("executor new: %v", err)
+	}
+	passive, pivot := eng.ExposureCost("AC01PassiveDNSCollection"), eng.ExposureCost("AC08FederatedCredentialPivot")
+	if passive != 12 || pivot != 18 {
+		t.Fatalf("expected risk-scaled costs 12 and 18, got %d and %d", passive, pivot)
+	}
+
+	var charged uint64
+	eng, err = executor.NewWithExposureModel(newContract(), campaign, tracker, executor.ExposureFunc(func(id string, risk float64, noise model.NoiseProfile) uint64 {
+		charged = 7
+		return charged
+	}))
+	if err != nil {
+		t.Fatalf("executor new: %v", err)
+	}
+	before := tracker.Score()
+	if _, err := eng.Run(context.Background(), "T1595", "host-1"); err != nil {
+		t.Fatalf("run: %v", err)
+	}
+	if tracker.Score() != before+charged {
+		t.Fatalf("expected run to charge the model's %d units, score went %d -> %d", charged, before, tracker.Score())
+	}
+
+	zero, _ := executor.NewWithExposureModel(newContract(), campaign, tracker, executor.FlatExposure(0))
+	if zero.ExposureCost("T1595") != 1 {
+		t.Fatalf("expected a zero-priced attempt to still cost 1 unit")
+	}
+}
+
+func TestDryRunLeavesCampaignAndExposureUntouched(t *testing.T) {
+	eng, campaign, tracker := newEngine(t, 40)
+
+	report, err := eng.DryRun(context.Background(), "T1595", "host-1")
+	if err != nil {
+		t.Fatalf("dry run: %v", err)
+	}
+	if !report.Admissible || len(report.ActionClasses) == 0 || report.ExposureCost != 10 || report.WouldHalt {
+		t.Fatalf("expected an admissible preview costing 10 units, got %+v", report)
+	}
+	if campaign.Status() != state.StatusInitialized || tracker.Score() != 0 {
+		t.Fatalf("expected dry run to leave state untouched, got status %s score %d", campaign.Status(), tracker.Score())
+	}
+
+	report, err = eng.DryRun(context.Background(), "T1595", "host-2")
+	if err != nil {
+		t.Fatalf("dry run: %v", err)
+	}
+	if report.Admissible || report.Reason == "" {
+		t.Fatalf("expected an out-of-scope target to be inadmissible with a reason, got %+v", report)
+	}
+
+	for i := 0; i < 3; i++ {
+		if _, err := eng.Run(context.Background(), "T1595", "host-1"); err != nil {
+			t.Fatalf("run %d: %v", i, err)
+		}
+	}
+	report, _ = eng.DryRun(context.Background(), "T1595", "host-1")
+	if !report.Admissible || !report.WouldHalt {
+		t.Fatalf("expected the attempt exhausting the budget to be flagged, got %+v", report)
+	}
+	if tracker.Score() != 30 {
+		t.Fatalf("expected dry runs to add no exposure, got score %d", tracker.Score())
+	}
+}
+
+func TestNoiseWeightedExposureChargesBurstsMore(t *testing.T) {
+	contract := newContract()
+	campaign, _ := state.New(contract.CampaignID)
+	tracker, _ := exposure.New(100)
+	eng, err := executor.NewWithExposureModel(contract, campaign, tracker, executor.NoiseWeightedExposure(executor.RiskScaledExposure(10)))
+	if err != nil {
+		t.Fatalf("executor new: %v", err)
+	}
+	passive, probe := eng.ExposureCost("AC01PassiveDNSCollection"), eng.ExposureCost("AC02SurfaceProbe")
+	if passive != 6 || probe != 12 {
+		t.Fatalf("expected equal-risk passive and low-rate techniques to cost 6 and 12, got %d and %d", passive, probe)
+	}
+
+	weighted := executor.NoiseWeightedExposure(executor.RiskScaledExposure(10))
+	lowRate, burst := weighted.Cost("low", 0.5, model.NoiseLowRate), weighted.Cost("burst", 0.5, model.NoiseBurst)
+	if burst <= lowRate {
+		t.Fatalf("expected a burst technique to cost more than a low-rate one of equal risk, got %d vs %d", burst, lowRate)
+	}
+}
@@ -90,6 +90,10 @@ type Tracker struct {
 	// halted indicates whether exposure has breached limits.
 	halted bool
 
+	// breakdown attributes score to the category each delta was tagged with.
+	// Its values always sum to score.
+	breakdown map[string]uint64
+
 	// mu protects all mutable fields.
 	mu sync.RWMutex
 }
@@ -107,11 +111,15 @@ func New(maxScore uint64) (*Tracker, error) {
 	}
 
 	return &Tracker{
-		maxScore: maxScore,
-		score:    0,
+		maxScore:  maxScore,
+		score:     0,
+		breakdown: make(map[string]uint64),
 	}, nil
 }
 
+// UntaggedCategory is the breakdown category for exposure added without one.
+const UntaggedCategory = "untagged"
+
 // Add increments exposure by the provided delta.
 //
 // RULES:
@@ -119,6 +127,12 @@ func New(maxScore uint64) (*Tracker, error) {
 // - exposure is monotonic
 // - once halted, further updates are rejected
 func (t *Tracker) Add(delta uint64) error {
+	return t.AddTagged(delta, UntaggedCategory)
+}
+
+// AddTagged increments exposure like Add and attributes the delta to
+// category in the breakdown. An empty category counts as untagged.
+func (t *Tracker) AddTagged(delta uint64, category string) error {
 	t.mu.Lock()
 	defer t.mu.Unlock()
 
@@ -130,7 +144,15 @@ func (t *Tracker) Add(delta uint64) error {
 		return errors.New("exposure already exceeded; execution halted")
 	}
 
+	if category == "" {
+		category = UntaggedCategory
+	}
+	if t.breakdown == nil {
+		t.breakdown = make(map[string]uint64)
+	}
+
 	t.score += delta
+	t.breakdown[category] += delta
 	t.lastUpdated = time.Now().UTC()
 
 	if t.score >= t.maxScore {
@@ -147,6 +169,33 @@ func (t *Tracker) Score() uint64 {
 	return t.score
 }
 
+// Breakdown returns a copy of the exposure attributed to each category.
+//
+// The values always sum to Score.
+func (t *Tracker) Breakdown() map[string]uint64 {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	out := make(map[string]uint64, len(t.breakdown))
+	for category, score := range t.breakdown {
+		out[category] = score
+	}
+	return out
+}
+
+// Remaining returns the exposure budget left before a halt is mandated.
+//
+// Returns zero once the limit has been reached or exceeded.
+func (t *Tracker) Remaining() uint64 {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	if t.score >= t.maxScore {
+		return 0
+	}
+	return t.maxScore - t.score
+}
+
 // Level returns the qualitative exposure level.
 //
 // Thresholds are intentionally coarse and conservative.
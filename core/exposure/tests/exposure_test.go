@@ -0,0 +1,43 @@
+package tests
+
+import (
+	"testing"
+
+	"vantage/core/exposure"
+)
+
+func TestBreakdownSumsToScore(t *testing.T) {
+	tracker, err := exposure.New(100)
+	if err != nil {
+		t.Fatalf("exposure new: %v", err)
+	}
+	if err := tracker.AddTagged(12, "AC-01"); err != nil {
+		t.Fatalf("add tagged: %v", err)
+	}
+	if err := tracker.AddTagged(18, "AC-08"); err != nil {
+		t.Fatalf("add tagged: %v", err)
+	}
+	if err := tracker.AddTagged(8, "AC-01"); err != nil {
+		t.Fatalf("add tagged: %v", err)
+	}
+	if err := tracker.Add(5); err != nil {
+		t.Fatalf("add: %v", err)
+	}
+
+	breakdown := tracker.Breakdown()
+	if breakdown["AC-01"] != 20 || breakdown["AC-08"] != 18 || breakdown[exposure.UntaggedCategory] != 5 {
+		t.Fatalf("unexpected breakdown %v", breakdown)
+	}
+	var sum uint64
+	for _, v := range breakdown {
+		sum += v
+	}
+	if sum != tracker.Score() || tracker.Score() != 43 {
+		t.Fatalf("expected breakdown sum %d to equal score %d", sum, tracker.Score())
+	}
+
+	breakdown["AC-01"] = 0
+	if tracker.Breakdown()["AC-01"] != 20 {
+		t.Fatalf("expected Breakdown to return a copy")
+	}
+}
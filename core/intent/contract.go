@@ -3,7 +3,11 @@ package intent
 import (
 	"errors"
 	"fmt"
+	"path"
+	"strings"
 	"time"
+
+	"vantage/techniques"
 )
 
 // -----------------------------------------------------------------------------
@@ -81,6 +85,11 @@ type Contract struct {
 	// - Techniques NOT listed here are forbidden.
 	//
 	// This list is intersected with ROE at runtime.
+	//
+	// Entries may be glob patterns (e.g. "AC01*"). Validate expands
+	// each pattern against the technique registry and replaces this
+	// list with the concrete IDs, so ROE only ever sees exact IDs.
+	// A pattern matching no registered technique fails validation.
 	AllowedTechniques []string
 
 	// Targets defines the explicit scope of execution.
@@ -95,6 +104,15 @@ type Contract struct {
 	// Wildcards and ranges are intentionally NOT supported in v0.x.
 	Targets []string
 
+	// TargetSpecs optionally describes declared targets in structured form.
+	//
+	// Each spec:
+	// - MUST name a target already listed in Targets
+	// - Adds context only; it NEVER widens scope
+	//
+	// Targets without a spec are treated as unknown with no prior access.
+	TargetSpecs []TargetSpec
+
 	// NotBefore defines the earliest time execution is permitted.
 	//
 	// Evaluated in UTC.
@@ -104,6 +122,64 @@ type Contract struct {
 	//
 	// Evaluated in UTC.
 	NotAfter time.Time
+
+	// ExposureBudget is the exposure score at which execution halts.
+	//
+	// Engagements declare their own allowance, e.g. a production
+	// target gets a much smaller budget than a lab.
+	//
+	// Contracts predating this field leave it zero; they keep
+	// DefaultExposureBudget so existing callers do not break.
+	ExposureBudget uint64
+}
+
+// DefaultExposureBudget is the exposure budget of contracts that
+// declare none.
+const DefaultExposureBudget uint64 = 100
+
+// EffectiveExposureBudget returns the declared exposure budget, or
+// DefaultExposureBudget when the contract declares none.
+func (c *Contract) EffectiveExposureBudget() uint64 {
+	if c == nil || c.ExposureBudget == 0 {
+		return DefaultExposureBudget
+	}
+	return c.ExposureBudget
+}
+
+// TargetSpec declares non-sensitive context about one in-scope target.
+//
+// This context is used for:
+// - Technique resolution
+// - AI advisory input
+//
+// It is NEVER used to authorize execution.
+type TargetSpec struct {
+
+	// ID is the target identifier exactly as listed in Contract.Targets.
+	ID string
+
+	// Type is the high-level target category.
+	//
+	// Examples:
+	// - "network_service"
+	// - "web_application"
+	Type string
+
+	// KnownAccess declares that access to the target already exists.
+	KnownAccess bool
+}
+
+// TargetSpec returns the declared spec for target, if any.
+func (c *Contract) TargetSpec(target string) (TargetSpec, bool) {
+	if c == nil {
+		return TargetSpec{}, false
+	}
+	for _, spec := range c.TargetSpecs {
+		if spec.ID == target {
+			return spec, true
+		}
+	}
+	return TargetSpec{}, false
 }
 
 // Validate performs strict validation of the intent contract.
@@ -149,6 +225,12 @@ func (c *Contract) Validate() error {
 		}
 	}
 
+	expanded, err := expandTechniquePatterns(c.AllowedTechniques)
+	if err != nil {
+		return err
+	}
+	c.AllowedTechniques = expanded
+
 	// -----------------------------------------------------------------
 	// 3. Target Scope Validation
 	// -----------------------------------------------------------------
@@ -157,10 +239,24 @@ func (c *Contract) Validate() error {
 		return errors.New("intent contract defines no targets")
 	}
 
+	declared := make(map[string]struct{}, len(c.Targets))
 	for _, target := range c.Targets {
 		if target == "" {
 			return errors.New("intent contract contains empty target")
 		}
+		declared[target] = struct{}{}
+	}
+
+	// Specs describe declared targets; they must never introduce new scope.
+	specified := make(map[string]struct{}, len(c.TargetSpecs))
+	for _, spec := range c.TargetSpecs {
+		if _, ok := declared[spec.ID]; !ok {
+			return fmt.Errorf("intent contract target spec %q is not a declared target", spec.ID)
+		}
+		if _, dup := specified[spec.ID]; dup {
+			return fmt.Errorf("intent contract declares target spec %q more than once", spec.ID)
+		}
+		specified[spec.ID] = struct{}{}
 	}
 
 	// -----------------------------------------------------------------
@@ -189,8 +285,49 @@ func (c *Contract) Validate() error {
 	}
 
 	// -----------------------------------------------------------------
-	// 5. PASS — Contract is valid
+	// 5. Exposure Budget Validation
+	// -----------------------------------------------------------------
+
+	// A validated contract always carries a budget > 0.
+	c.ExposureBudget = c.EffectiveExposureBudget()
+
+	// -----------------------------------------------------------------
+	// 6. PASS — Contract is valid
 	// -----------------------------------------------------------------
 
 	return nil
 }
+
+// expandTechniquePatterns resolves glob entries against the technique
+// registry. Exact IDs pass through unchanged; the result keeps the first
+// occurrence of each ID in declaration order.
+func expandTechniquePatterns(allowed []string) ([]string, error) {
+	out := make([]string, 0, len(allowed))
+	seen := make(map[string]struct{}, len(allowed))
+	add := func(id string) {
+		if _, dup := seen[id]; !dup {
+			seen[id] = struct{}{}
+			out = append(out, id)
+		}
+	}
+	for _, entry := range allowed {
+		if !strings.ContainsAny(entry, "*?[") {
+			add(entry)
+			continue
+		}
+		if _, err := path.Match(entry, ""); err != nil {
+			return nil, fmt.Errorf("intent contract technique pattern %q is malformed: %w", entry, err)
+		}
+		matched := false
+		for _, t := range techniques.List() {
+			if ok, _ := path.Match(entry, t.ID()); ok {
+				add(t.ID())
+				matched = true
+			}
+		}
+		if !matched {
+			return nil, fmt.Errorf("intent contract technique pattern %q matches no registered technique", entry)
+		}
+	}
+	return out, nil
+}
@@ -0,0 +1,65 @@
+package tests
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"vantage/core/intent"
+)
+
+func TestValidateExpandsTechniqueGlobs(t *testing.T) {
+	contract := &intent.Contract{
+		CampaignID:        "glob-test",
+		Objective:         "glob test",
+		AllowedTechniques: []string{"AC01*", "T1595"},
+		Targets:           []string{"host-1"},
+		NotBefore:         time.Now().UTC().Add(-1 * time.Minute),
+		NotAfter:          time.Now().UTC().Add(10 * time.Minute),
+	}
+	if err := contract.Validate(); err != nil {
+		t.Fatalf("validate: %v", err)
+	}
+	ac01 := 0
+	for _, id := range contract.AllowedTechniques {
+		if strings.ContainsAny(id, "*?[") {
+			t.Fatalf("expected only concrete IDs after validation, got %q", id)
+		}
+		if strings.HasPrefix(id, "AC01") {
+			ac01++
+		}
+	}
+	if ac01 != 5 || len(contract.AllowedTechniques) != 6 || contract.AllowedTechniques[5] != "T1595" {
+		t.Fatalf("expected five AC-01 techniques plus T1595, got %v", contract.AllowedTechniques)
+	}
+
+	contract.AllowedTechniques = []string{"AC99*"}
+	if err := contract.Validate(); err == nil {
+		t.Fatalf("expected a pattern matching nothing to fail validation")
+	}
+}
+
+func TestValidateDefaultsMissingExposureBudget(t *testing.T) {
+	contract := &intent.Contract{
+		CampaignID:        "budget-test",
+		Objective:         "budget test",
+		AllowedTechniques: []string{"T1595"},
+		Targets:           []string{"host-1"},
+		NotBefore:         time.Now().UTC().Add(-1 * time.Minute),
+		NotAfter:          time.Now().UTC().Add(10 * time.Minute),
+	}
+	if err := contract.Validate(); err != nil {
+		t.Fatalf("validate: %v", err)
+	}
+	if contract.ExposureBudget != intent.DefaultExposureBudget {
+		t.Fatalf("expected legacy contract to get the default budget, got %d", contract.ExposureBudget)
+	}
+
+	contract.ExposureBudget = 25
+	if err := contract.Validate(); err != nil {
+		t.Fatalf("validate: %v", err)
+	}
+	if contract.ExposureBudget != 25 {
+		t.Fatalf("expected declared budget to be kept, got %d", contract.ExposureBudget)
+	}
+}
@@ -42,6 +42,19 @@ func (b *DefaultActionBinder) BindActionClasses(classes []ActionClass) {
 	}
 }
 
+// UpsertActionClass replaces or adds a single action class by ID, leaving the others bound.
+func (b *DefaultActionBinder) UpsertActionClass(class ActionClass) {
+	if class.ID == "" {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.classes == nil {
+		b.classes = map[string]ActionClass{}
+	}
+	b.classes[class.ID] = class
+}
+
 // MatchAndGenerate creates deterministic hypotheses when action-class preconditions match graph state.
 func (b *DefaultActionBinder) MatchAndGenerate(graph *Graph, st *state.State) ([]Hypothesis, error) {
 	if graph == nil || st == nil {
@@ -61,11 +74,12 @@ func (b *DefaultActionBinder) MatchAndGenerate(graph *Graph, st *state.State) ([
 			continue
 		}
 		out = append(out, Hypothesis{
-			ID:            fmt.Sprintf("hyp-ac-%s", ac.ID),
-			ActionClassID: ac.ID,
-			Statement:     fmt.Sprintf("Action class %s is feasible in %s", ac.Name, ac.Phase),
-			Confidence:    0.5 + ac.ConfidenceBoost,
-			DerivedFrom:   []string{},
+			ID:                hypothesisNodeID(ac.ID),
+			ActionClassID:     ac.ID,
+			Statement:         fmt.Sprintf("Action class %s is feasible in %s", ac.Name, ac.Phase),
+			SupportingNodeIDs: supportingEvidenceIDs(graph, hypothesisNodeID(ac.ID), ac.Preconditions),
+			Confidence:        0.5 + ac.ConfidenceBoost,
+			DerivedFrom:       []string{},
 		})
 	}
 
@@ -94,12 +108,12 @@ func (b *DefaultActionBinder) ApplyAction(graph *Graph, ac ActionClass, evidence
 
 	now := time.Now().UTC()
 	evidenceNodeID := fmt.Sprintf("ev-%d-%s", now.UnixNano(), evidence.TechniqueID)
-	graph.UpsertNode(&Node{ID: evidenceNodeID, Type: NodeTypeEvidence, Label: fmt.Sprintf("%s@%s", evidence.TechniqueID, evidence.Target)})
+	graph.UpsertNode(&Node{ID: evidenceNodeID, Type: NodeTypeEvidence, Label: fmt.Sprintf("%s@%s", evidence.TechniqueID, evidence.Target), Metadata: map[string]string{"technique": evidence.TechniqueID, "target": evidence.Target, "success": fmt.Sprintf("%t", evidence.Success)}})
 
 	producedIDs := make([]string, 0, len(ac.ProducesNodes))
 	for idx, nodeType := range ac.ProducesNodes {
 		nodeID := fmt.Sprintf("ac-%s-%d-%d", ac.ID, idx, now.UnixNano())
-		graph.UpsertNode(&Node{ID: nodeID, Type: nodeType, Label: fmt.Sprintf("%s produced %s", ac.ID, nodeType)})
+		graph.UpsertNode(&Node{ID: nodeID, Type: nodeType, Label: fmt.Sprintf("%s produced %s", ac.ID, nodeType), Metadata: map[string]string{"action_class": ac.ID, "target": evidence.Target}})
 		producedIDs = append(producedIDs, nodeID)
 	}
 
@@ -6,6 +6,7 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"vantage/core/state"
 )
@@ -24,12 +25,16 @@ type ActionClass struct {
 	RiskWeight      float64
 	ImpactWeight    float64
 	ConfidenceBoost float64
+	// Duration estimates the wall-clock time of one execution; zero means unestimated.
+	Duration time.Duration
 }
 
 // GraphPattern defines structural graph preconditions for an action class.
 type GraphPattern struct {
 	RequiredNodeTypes []NodeType
 	RequiredEdges     []EdgeType
+	// Weight is the pattern's relative importance in feasibility scoring; zero means the default of 1.
+	Weight float64
 }
 
 type actionClassYAML struct {
@@ -37,6 +42,9 @@ type actionClassYAML struct {
 	Name          string
 	IntentDomains []string
 	Preconditions []string
+	// Phase, when set, overrides the phase inferred from IntentDomains.
+	Phase    string
+	Duration string
 }
 
 // LoadActionClassesFromDir loads all YAML action class definitions from a directory.
@@ -77,6 +85,20 @@ func loadActionClassFile(path string) (ActionClass, error) {
 	}
 
 	phase := inferPhase(raw.IntentDomains)
+	if raw.Phase != "" {
+		override, err := parsePhaseOverride(raw.Phase)
+		if err != nil {
+			return ActionClass{}, fmt.Errorf("action class %s: %w", path, err)
+		}
+		phase = override
+	}
+	var duration time.Duration
+	if raw.Duration != "" {
+		duration, err = time.ParseDuration(raw.Duration)
+		if err != nil || duration < 0 {
+			return ActionClass{}, fmt.Errorf("action class %s: invalid duration %q", path, raw.Duration)
+		}
+	}
 	patterns := make([]GraphPattern, 0, len(raw.Preconditions))
 	for _, pre := range raw.Preconditions {
 		if pattern, ok := preconditionPattern(pre); ok {
@@ -94,6 +116,7 @@ func loadActionClassFile(path string) (ActionClass, error) {
 		RiskWeight:      0.4,
 		ImpactWeight:    0.6,
 		ConfidenceBoost: 0.1,
+		Duration:        duration,
 	}, nil
 }
 
@@ -126,6 +149,10 @@ func parseActionClassYAML(path string) (actionClassYAML, error) {
 			out.IntentDomains = parseInlineList(value)
 		case "preconditions":
 			out.Preconditions = parseInlineList(value)
+		case "phase":
+			out.Phase = trimScalar(value)
+		case "duration":
+			out.Duration = trimScalar(value)
 		}
 	}
 	if err := scanner.Err(); err != nil {
@@ -156,22 +183,36 @@ func parseInlineList(v string) []string {
 	return out
 }
 
+// domainPhases maps intent domains to the lifecycle phase they imply.
+var domainPhases = map[string]OperationPhase{
+	"discovery":   state.PhaseRecon,
+	"enumeration": state.PhaseRecon,
+	"access":      state.PhaseInitialAccess,
+	"validation":  state.PhaseLateralMovement,
+	"impact":      state.PhaseObjective,
+}
+
 func inferPhase(domains []string) OperationPhase {
 	for _, domain := range domains {
-		switch strings.ToLower(domain) {
-		case "discovery", "enumeration":
-			return state.PhaseRecon
-		case "access":
-			return state.PhaseInitialAccess
-		case "validation":
-			return state.PhaseLateralMovement
-		case "impact":
-			return state.PhaseObjective
+		if phase, ok := domainPhases[strings.ToLower(domain)]; ok {
+			return phase
 		}
 	}
 	return state.PhaseRecon
 }
 
+// parsePhaseOverride resolves an explicit phase key, accepting a lifecycle phase name or an intent domain.
+func parsePhaseOverride(value string) (OperationPhase, error) {
+	phase := OperationPhase(strings.ToUpper(value))
+	if err := phase.Validate(); err == nil {
+		return phase, nil
+	}
+	if phase, ok := domainPhases[strings.ToLower(value)]; ok {
+		return phase, nil
+	}
+	return "", fmt.Errorf("unknown phase %q", value)
+}
+
 func preconditionPattern(precondition string) (GraphPattern, bool) {
 	switch strings.ToLower(precondition) {
 	case "network_reachability":
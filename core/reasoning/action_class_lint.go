@@ -0,0 +1,79 @@
+package reasoning
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"vantage/core/state"
+)
+
+// FindDuplicateActionClasses groups the IDs of action classes that behave identically: same phase,
+// weighted preconditions, produced nodes and edges, weights and duration. Names are ignored. Only groups with more than
+// one member are returned, each sorted by ID and ordered by their first ID.
+func FindDuplicateActionClasses(classes []ActionClass) [][]string {
+	bySignature := map[string][]string{}
+	for _, ac := range classes {
+		sig := actionClassSignature(ac)
+		bySignature[sig] = append(bySignature[sig], ac.ID)
+	}
+	groups := make([][]string, 0)
+	for _, ids := range bySignature {
+		if len(ids) < 2 {
+			continue
+		}
+		sort.Strings(ids)
+		groups = append(groups, ids)
+	}
+	sort.Slice(groups, func(i, j int) bool { return groups[i][0] < groups[j][0] })
+	return groups
+}
+
+// actionClassSignature canonicalizes the behavior of an action class. Preconditions are matched as
+// type sets with their feasibility weight, an unset weight counting as 1, so each pattern and the
+// pattern list are deduplicated and sorted; produced types keep
+// their multiplicity because projections count them.
+func actionClassSignature(ac ActionClass) string {
+	patterns := make([]string, 0, len(ac.Preconditions))
+	seen := map[string]struct{}{}
+	for _, p := range ac.Preconditions {
+		key := fmt.Sprintf("%v/%v@%g", requiredNodes([]GraphPattern{p}), requiredEdges([]GraphPattern{p}), patternWeight(p, nil))
+		if _, dup := seen[key]; dup {
+			continue
+		}
+		seen[key] = struct{}{}
+		patterns = append(patterns, key)
+	}
+	sort.Strings(patterns)
+
+	nodes := make([]string, 0, len(ac.ProducesNodes))
+	for _, n := range ac.ProducesNodes {
+		nodes = append(nodes, string(n))
+	}
+	sort.Strings(nodes)
+	edges := make([]string, 0, len(ac.ProducesEdges))
+	for _, t := range ac.ProducesEdges {
+		edges = append(edges, string(t))
+	}
+	sort.Strings(edges)
+
+	return fmt.Sprintf("%s|%s|%s|%s|%g|%g|%g|%s", ac.Phase, strings.Join(patterns, ";"), strings.Join(nodes, ","), strings.Join(edges, ","), ac.RiskWeight, ac.ImpactWeight, ac.ConfidenceBoost, ac.Duration)
+}
+
+// FindDeadEndActionClasses returns, sorted, the IDs of action classes that produce no nodes or edges
+// yet are not objective-terminal. Such classes can never advance graph state, so they only consume
+// beam slots during planning. Classes in the Objective or Exfil phase may legitimately produce nothing.
+func FindDeadEndActionClasses(classes []ActionClass) []string {
+	ids := make([]string, 0)
+	for _, ac := range classes {
+		if len(ac.ProducesNodes) > 0 || len(ac.ProducesEdges) > 0 {
+			continue
+		}
+		if ac.Phase == state.PhaseObjective || ac.Phase == state.PhaseExfil {
+			continue
+		}
+		ids = append(ids, ac.ID)
+	}
+	sort.Strings(ids)
+	return ids
+}
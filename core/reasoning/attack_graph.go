@@ -0,0 +1,202 @@
+package reasoning
+
+import (
+	"fmt"
+	"sort"
+
+	"vantage/core/state"
+)
+
+// AttackGraphStep is one action class in an attack graph together with the steps that supply its preconditions.
+type AttackGraphStep struct {
+	ActionClassID string
+	Phase         OperationPhase
+	Produces      []NodeType
+	// DependsOn lists the action classes whose output this step consumes; more than one marks a convergence point.
+	DependsOn []string
+}
+
+// AttackGraph represents independent sub-paths converging on an objective. Steps are topologically ordered
+// and the final step produces the objective.
+type AttackGraph struct {
+	Objective NodeType
+	Steps     []AttackGraphStep
+	Risk      float64
+}
+
+// ExpandAttackGraph regresses from the objective through producing action classes, satisfying every
+// precondition of each step either from the current graph or from another step. Unlike ExpandAttackPaths
+// it can express AND-chains where several branches must complete before a step becomes feasible.
+func (e *Engine) ExpandAttackGraph(st *state.State, objective NodeType) ([]AttackGraph, error) {
+	if e == nil || e.graph == nil {
+		return nil, fmt.Errorf("engine or graph is nil")
+	}
+	if objective == "" {
+		return nil, fmt.Errorf("objective is required")
+	}
+
+	e.mu.RLock()
+	cfg := e.attackPathConfig
+	e.mu.RUnlock()
+	if cfg.MaxDepth <= 0 {
+		cfg.MaxDepth = DefaultAttackPathConfig().MaxDepth
+	}
+	if cfg.ROEPolicy == nil {
+		cfg.ROEPolicy = func(ActionClass, *Graph, *state.State) bool { return true }
+	}
+
+	classes := e.boundActionClasses()
+	sort.Slice(classes, func(i, j int) bool {
+		if classes[i].RiskWeight == classes[j].RiskWeight {
+			return classes[i].ID < classes[j].ID
+		}
+		return classes[i].RiskWeight < classes[j].RiskWeight
+	})
+	currentPhase := phaseForState(st)
+	allowed := func(ac ActionClass) bool {
+		if !phaseAllowed(currentPhase, ac.Phase) {
+			return false
+		}
+		ok, _ := e.roeAllows(cfg.ROEPolicy, ac, st)
+		return ok
+	}
+	base := e.snapshots.get(e.graph)
+
+	graphs := make([]AttackGraph, 0)
+	seen := map[string]struct{}{}
+	for _, root := range classes {
+		if !producesNode(root.ProducesNodes, objective) || !allowed(root) {
+			continue
+		}
+		b := &attackGraphBuilder{base: base, classes: classes, allowed: allowed, maxDepth: cfg.MaxDepth, steps: map[string]AttackGraphStep{}}
+		if !b.add(root, map[string]bool{}, 1) {
+			continue
+		}
+		ag := AttackGraph{Objective: objective}
+		for _, id := range b.order {
+			ag.Steps = append(ag.Steps, b.steps[id])
+			ac, _ := b.class(id)
+			ag.Risk += ac.RiskWeight
+		}
+		key := fmt.Sprintf("%v", b.order)
+		if _, dup := seen[key]; dup {
+			continue
+		}
+		seen[key] = struct{}{}
+		graphs = append(graphs, ag)
+	}
+	sort.SliceStable(graphs, func(i, j int) bool { return graphs[i].Risk < graphs[j].Risk })
+	return graphs, nil
+}
+
+// attackGraphBuilder accumulates the steps of one attack graph, reusing a step wherever its output is needed.
+type attackGraphBuilder struct {
+	base     *graphSnapshot
+	classes  []ActionClass
+	allowed  func(ActionClass) bool
+	maxDepth int
+	steps    map[string]AttackGraphStep
+	order    []string
+}
+
+func (b *attackGraphBuilder) class(id string) (ActionClass, bool) {
+	for _, ac := range b.classes {
+		if ac.ID == id {
+			return ac, true
+		}
+	}
+	return ActionClass{}, false
+}
+
+// add places ac and, recursively, providers for its unmet preconditions. A failed attempt rolls back
+// every step it introduced.
+func (b *attackGraphBuilder) add(ac ActionClass, stack map[string]bool, depth int) bool {
+	if _, ok := b.steps[ac.ID]; ok {
+		return true
+	}
+	if depth > b.maxDepth || stack[ac.ID] {
+		return false
+	}
+	stack[ac.ID] = true
+	defer delete(stack, ac.ID)
+
+	mark := len(b.order)
+	deps := map[string]struct{}{}
+	for _, n := range requiredNodes(ac.Preconditions) {
+		if b.base.hasNodeType(n) {
+			continue
+		}
+		provider, ok := b.provide(func(c ActionClass) bool { return producesNode(c.ProducesNodes, n) }, stack, depth)
+		if !ok {
+			b.rollback(mark)
+			return false
+		}
+		deps[provider] = struct{}{}
+	}
+	for _, t := range requiredEdges(ac.Preconditions) {
+		if b.base.hasEdgeType(t) {
+			continue
+		}
+		provider, ok := b.provide(func(c ActionClass) bool { return producesEdge(c.ProducesEdges, t) }, stack, depth)
+		if !ok {
+			b.rollback(mark)
+			return false
+		}
+		deps[provider] = struct{}{}
+	}
+
+	dependsOn := make([]string, 0, len(deps))
+	for id := range deps {
+		dependsOn = append(dependsOn, id)
+	}
+	sort.Strings(dependsOn)
+	b.steps[ac.ID] = AttackGraphStep{ActionClassID: ac.ID, Phase: ac.Phase, Produces: append([]NodeType(nil), ac.ProducesNodes...), DependsOn: dependsOn}
+	b.order = append(b.order, ac.ID)
+	return true
+}
+
+// provide returns a step producing what match requires, preferring steps already in the graph.
+func (b *attackGraphBuilder) provide(match func(ActionClass) bool, stack map[string]bool, depth int) (string, bool) {
+	for _, id := range b.order {
+		if ac, ok := b.class(id); ok && match(ac) {
+			return id, true
+		}
+	}
+	for _, c := range b.classes {
+		if match(c) && b.allowed(c) && b.add(c, stack, depth+1) {
+			return c.ID, true
+		}
+	}
+	return "", false
+}
+
+func (b *attackGraphBuilder) rollback(mark int) {
+	for _, id := range b.order[mark:] {
+		delete(b.steps, id)
+	}
+	b.order = b.order[:mark]
+}
+
+func requiredEdges(patterns []GraphPattern) []EdgeType {
+	set := map[EdgeType]struct{}{}
+	for _, p := range patterns {
+		for _, t := range p.RequiredEdges {
+			set[t] = struct{}{}
+		}
+	}
+	out := make([]EdgeType, 0, len(set))
+	for t := range set {
+		out = append(out, t)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i] < out[j] })
+	return out
+}
+
+func producesEdge(edges []EdgeType, want EdgeType) bool {
+	for _, e := range edges {
+		if e == want {
+			return true
+		}
+	}
+	return false
+}
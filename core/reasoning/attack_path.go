@@ -1,9 +1,13 @@
 package reasoning
 
 import (
+	"context"
 	"fmt"
+	"math"
+	"math/rand"
 	"sort"
 	"strings"
+	"sync"
 
 	"vantage/core/state"
 )
@@ -16,6 +20,45 @@ type AttackPath struct {
 	Objective               NodeType
 	ObjectiveProximityScore float64
 	Valid                   bool
+	// explanation holds the score components computed when the path was scored.
+	explanation PathExplanation
+}
+
+// PathExplanation breaks an attack path score into the additive terms the scorer computed; penalties are
+// reported as positive amounts subtracted from the score. When the path reaches an objective every term
+// already includes the ObjectiveProximityFactor multiplier, so Total always equals the path's Score.
+type PathExplanation struct {
+	Confidence         float64
+	Feasibility        float64
+	UnlockBonus        float64
+	RiskPenalty        float64
+	DepthPenalty       float64
+	StagnationPenalty  float64
+	DiversityBonus     float64
+	ObjectiveProximity float64
+}
+
+// Total sums the components into the path score.
+func (p PathExplanation) Total() float64 {
+	return p.Confidence + p.Feasibility + p.UnlockBonus - p.RiskPenalty - p.DepthPenalty - p.StagnationPenalty + p.DiversityBonus + p.ObjectiveProximity
+}
+
+func (p PathExplanation) scaled(factor float64) PathExplanation {
+	return PathExplanation{
+		Confidence:         p.Confidence * factor,
+		Feasibility:        p.Feasibility * factor,
+		UnlockBonus:        p.UnlockBonus * factor,
+		RiskPenalty:        p.RiskPenalty * factor,
+		DepthPenalty:       p.DepthPenalty * factor,
+		StagnationPenalty:  p.StagnationPenalty * factor,
+		DiversityBonus:     p.DiversityBonus * factor,
+		ObjectiveProximity: p.ObjectiveProximity * factor,
+	}
+}
+
+// Explain returns the score components recorded when the path was scored.
+func (p AttackPath) Explain() PathExplanation {
+	return p.explanation
 }
 
 // AttackPathConfig controls search depth, pruning, scoring, and objective detection.
@@ -28,6 +71,95 @@ type AttackPathConfig struct {
 	StartNodeTypes     []NodeType
 	ObjectiveNodeTypes []NodeType
 	ROEPolicy          func(ac ActionClass, graph *Graph, st *state.State) bool
+	// ROEPreset names the built-in policy ROEPolicy was resolved from, if any.
+	ROEPreset     string
+	BeamObjective BeamObjective
+	// StagnationPenalty is subtracted from a path score for every step that stays in the previous step's phase.
+	StagnationPenalty float64
+	// DiversityFactor is added to a path score for every distinct node type the path produces; the default of
+	// zero leaves scores unchanged.
+	DiversityFactor float64
+	// EdgeImportance weights preconditions requiring an edge type in the feasibility score; unlisted types weigh 1.
+	EdgeImportance map[EdgeType]float64
+	// RandomTieBreak resolves equal-score beam ties by a feasibility-weighted draw seeded with TieBreakSeed
+	// instead of by candidate key. Off by default so planning stays deterministic across seeds.
+	RandomTieBreak bool
+	TieBreakSeed   int64
+	// MinObjectiveConfidence is the confidence the step producing an objective node type must reach for the
+	// path to count as objective-reaching; 0 accepts any confidence.
+	MinObjectiveConfidence float64
+}
+
+// BeamObjective selects which candidates survive beam pruning.
+type BeamObjective string
+
+const (
+	// BeamObjectiveMaxScore keeps the highest-scoring candidates (default).
+	BeamObjectiveMaxScore BeamObjective = "max_score"
+	// BeamObjectiveMinRisk keeps the lowest cumulative-risk candidates.
+	BeamObjectiveMinRisk BeamObjective = "min_risk"
+	// BeamObjectiveMaxConfidence keeps the highest average-confidence candidates.
+	BeamObjectiveMaxConfidence BeamObjective = "max_confidence"
+)
+
+// beamRank carries the metrics used to order candidates during beam pruning.
+type beamRank struct {
+	score      float64
+	risk       float64
+	confidence float64
+	key        string
+	// tieBreak orders equal-score candidates ahead of the key when random tie-breaking is enabled.
+	tieBreak float64
+}
+
+// beamBefore reports whether a should be kept ahead of b under the beam objective.
+// Ties always fall back to score, then to the tie-break draw, then to the deterministic candidate key.
+func beamBefore(objective BeamObjective, a, b beamRank) bool {
+	switch objective {
+	case BeamObjectiveMinRisk:
+		if a.risk != b.risk {
+			return a.risk < b.risk
+		}
+	case BeamObjectiveMaxConfidence:
+		if a.confidence != b.confidence {
+			return a.confidence > b.confidence
+		}
+	}
+	if a.score == b.score {
+		if a.tieBreak != b.tieBreak {
+			return a.tieBreak > b.tieBreak
+		}
+		return a.key < b.key
+	}
+	return a.score > b.score
+}
+
+// newTieBreaker returns the seeded source for random beam tie-breaks, or nil when they are disabled.
+func newTieBreaker(enabled bool, seed int64) *rand.Rand {
+	if !enabled {
+		return nil
+	}
+	return rand.New(rand.NewSource(seed))
+}
+
+// weightedTieBreaks draws one tie-break per candidate as u^(1/w), so ordering by draw is a weighted random
+// permutation favoring heavier candidates. Draws are taken in key order to stay reproducible for a seed.
+// It returns nil when rng is nil.
+func weightedTieBreaks(rng *rand.Rand, keys []string, weights []float64) []float64 {
+	if rng == nil {
+		return nil
+	}
+	order := make([]int, len(keys))
+	for i := range order {
+		order[i] = i
+	}
+	sort.Slice(order, func(i, j int) bool { return keys[order[i]] < keys[order[j]] })
+	draws := make([]float64, len(keys))
+	for _, i := range order {
+		w := math.Max(weights[i], 1e-6)
+		draws[i] = math.Pow(rng.Float64(), 1/w)
+	}
+	return draws
 }
 
 // DefaultAttackPathConfig returns conservative attack-path search defaults.
@@ -40,7 +172,9 @@ func DefaultAttackPathConfig() AttackPathConfig {
 		ConfidenceWeight:   0.25,
 		StartNodeTypes:     []NodeType{NodeTypeEvidence, NodeTypeHypothesis, NodeTypeTechnique},
 		ObjectiveNodeTypes: []NodeType{NodeTypeAttackPath, NodeTypeTechnique},
-		ROEPolicy:          func(ActionClass, *Graph, *state.State) bool { return true },
+		ROEPolicy:          roePresets[ROEPresetAllowAll],
+		ROEPreset:          ROEPresetAllowAll,
+		BeamObjective:      BeamObjectiveMaxScore,
 	}
 }
 
@@ -51,10 +185,13 @@ type CampaignProjectionState struct {
 }
 
 type attackCandidate struct {
-	graph *graphSnapshot
-	stack []ActionClass
-	score float64
-	key   string
+	graph      *graphSnapshot
+	stack      []ActionClass
+	score      float64
+	risk       float64
+	confidence float64
+	key        string
+	tieBreak   float64
 }
 
 type graphSnapshot struct {
@@ -68,9 +205,15 @@ type actionClassIndex struct {
 }
 
 func snapshotFromGraph(g *Graph) *graphSnapshot {
+	s, _ := versionedSnapshot(g)
+	return s
+}
+
+// versionedSnapshot walks g and returns its type counts with the graph version they reflect.
+func versionedSnapshot(g *Graph) (*graphSnapshot, uint64) {
 	s := &graphSnapshot{nodeCounts: map[NodeType]int{}, edgeCounts: map[EdgeType]int{}}
 	if g == nil {
-		return s
+		return s, 0
 	}
 	g.mu.RLock()
 	defer g.mu.RUnlock()
@@ -80,7 +223,42 @@ func snapshotFromGraph(g *Graph) *graphSnapshot {
 	for _, e := range g.edges {
 		s.edgeCounts[e.Type]++
 	}
-	return s
+	return s, g.version
+}
+
+// snapshotCache memoizes the snapshot of one graph until that graph is mutated.
+type snapshotCache struct {
+	mu       sync.Mutex
+	graph    *Graph
+	version  uint64
+	snapshot *graphSnapshot
+	builds   int
+}
+
+// get returns a private copy of g's snapshot, rebuilding it only when g changed since the last call.
+func (c *snapshotCache) get(g *Graph) *graphSnapshot {
+	if g == nil {
+		return snapshotFromGraph(nil)
+	}
+	g.mu.RLock()
+	version := g.version
+	g.mu.RUnlock()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.snapshot == nil || c.graph != g || c.version != version {
+		c.snapshot, c.version = versionedSnapshot(g)
+		c.graph = g
+		c.builds++
+	}
+	return c.snapshot.clone()
+}
+
+// SnapshotBuilds reports how many times planning has rebuilt its graph snapshot by walking the graph.
+func (e *Engine) SnapshotBuilds() int {
+	e.snapshots.mu.Lock()
+	defer e.snapshots.mu.Unlock()
+	return e.snapshots.builds
 }
 
 func (s *graphSnapshot) clone() *graphSnapshot {
@@ -99,6 +277,12 @@ func (s *graphSnapshot) clone() *graphSnapshot {
 }
 
 func (s *graphSnapshot) hasNodeType(t NodeType) bool { return s != nil && s.nodeCounts[t] > 0 }
+func (s *graphSnapshot) nodeCount(t NodeType) int {
+	if s == nil {
+		return 0
+	}
+	return s.nodeCounts[t]
+}
 func (s *graphSnapshot) hasEdgeType(t EdgeType) bool { return s != nil && s.edgeCounts[t] > 0 }
 
 func (s *graphSnapshot) applyAction(ac ActionClass) {
@@ -201,19 +385,69 @@ func (idx actionClassIndex) eligible(snapshot *graphSnapshot) []ActionClass {
 }
 
 func projectCampaignState(base CampaignProjectionState, ac ActionClass) (CampaignProjectionState, error) {
+	next, gaps := projectCampaignStateWithGaps(base, ac)
+	if len(gaps) > 0 {
+		return CampaignProjectionState{}, fmt.Errorf("preconditions do not match")
+	}
+	return next, nil
+}
+
+// projectCampaignStateWithGaps applies an action regardless of precondition fit and reports
+// every unmet precondition as a gap so what-if planning can continue past it.
+func projectCampaignStateWithGaps(base CampaignProjectionState, ac ActionClass) (CampaignProjectionState, []string) {
 	next := CampaignProjectionState{Graph: base.Graph.clone(), PhaseProgress: append(append([]state.OperationPhase(nil), base.PhaseProgress...), ac.Phase)}
 	if next.Graph == nil {
 		next.Graph = &graphSnapshot{nodeCounts: map[NodeType]int{}, edgeCounts: map[EdgeType]int{}}
 	}
-	if !matchSnapshotPatterns(next.Graph, ac.Preconditions) {
-		return CampaignProjectionState{}, fmt.Errorf("preconditions do not match")
-	}
+	gaps := missingPreconditions(next.Graph, ac)
 	next.Graph.applyAction(ac)
-	return next, nil
+	return next, gaps
+}
+
+func missingPreconditions(snapshot *graphSnapshot, ac ActionClass) []string {
+	seen := map[string]struct{}{}
+	out := make([]string, 0)
+	for _, pattern := range ac.Preconditions {
+		for _, nodeType := range pattern.RequiredNodeTypes {
+			gap := fmt.Sprintf("%s requires node %s", ac.ID, nodeType)
+			if _, dup := seen[gap]; !dup && !snapshot.hasNodeType(nodeType) {
+				seen[gap] = struct{}{}
+				out = append(out, gap)
+			}
+		}
+		for _, edgeType := range pattern.RequiredEdges {
+			gap := fmt.Sprintf("%s requires edge %s", ac.ID, edgeType)
+			if _, dup := seen[gap]; !dup && !snapshot.hasEdgeType(edgeType) {
+				seen[gap] = struct{}{}
+				out = append(out, gap)
+			}
+		}
+	}
+	return out
 }
 
 // ExpandAttackPaths computes feasible, scored attack paths from the current graph using virtual graph simulation.
 func (e *Engine) ExpandAttackPaths(st *state.State) ([]AttackPath, error) {
+	return e.ExpandAttackPathsContext(context.Background(), st)
+}
+
+// ExpandAttackPathsContext expands like ExpandAttackPaths but aborts once ctx is done, checking before each
+// depth and each beam candidate. A cancelled search returns only ctx.Err(), never a partial set of paths.
+func (e *Engine) ExpandAttackPathsContext(ctx context.Context, st *state.State) ([]AttackPath, error) {
+	return e.expandAttackPaths(ctx, st, nil)
+}
+
+// ExpandAttackPathsWithReport expands like ExpandAttackPaths and also reports why each rejected action class was discarded.
+func (e *Engine) ExpandAttackPathsWithReport(st *state.State) ([]AttackPath, *PlanningReport, error) {
+	report := newPlanningReport()
+	paths, err := e.expandAttackPaths(context.Background(), st, report)
+	if err != nil {
+		return nil, nil, err
+	}
+	return paths, report.finalize(), nil
+}
+
+func (e *Engine) expandAttackPaths(ctx context.Context, st *state.State, report *PlanningReport) ([]AttackPath, error) {
 	if e == nil || e.graph == nil {
 		return nil, fmt.Errorf("engine or graph is nil")
 	}
@@ -235,7 +469,8 @@ func (e *Engine) ExpandAttackPaths(st *state.State) ([]AttackPath, error) {
 	}
 
 	idx := buildActionClassIndex(classes)
-	unlockCache := map[string]float64{}
+	unlockCache := e.newPlanningUnlockCache()
+	tieBreaker := newTieBreaker(cfg.RandomTieBreak, cfg.TieBreakSeed)
 
 	seeded := e.seedNodeCount(cfg.StartNodeTypes)
 	if seeded == 0 {
@@ -243,27 +478,51 @@ func (e *Engine) ExpandAttackPaths(st *state.State) ([]AttackPath, error) {
 	}
 
 	currentPhase := phaseForState(st)
-	baseSnapshot := snapshotFromGraph(e.graph)
+	baseSnapshot := e.snapshots.get(e.graph)
 	beam := make([]attackCandidate, 0, len(classes))
 	paths := make([]AttackPath, 0)
 	seen := make(map[string]struct{})
 
-	for _, root := range idx.eligible(baseSnapshot) {
-		if !phaseAllowed(currentPhase, root.Phase) || !cfg.ROEPolicy(root, e.graph, st) || !matchSnapshotPatterns(baseSnapshot, root.Preconditions) {
+	roots := classes
+	if report == nil {
+		roots = idx.eligible(baseSnapshot)
+	}
+	for _, root := range roots {
+		if reason := sequenceStepRejection(currentPhase, nil, root); reason != "" {
+			report.reject(root.ID, reason)
+			continue
+		}
+		if allowed, panicked := e.roeAllows(cfg.ROEPolicy, root, st); !allowed {
+			report.reject(root.ID, roeRejection(panicked))
+			continue
+		}
+		if !matchSnapshotPatterns(baseSnapshot, root.Preconditions) {
+			report.reject(root.ID, RejectionPreconditionUnmet)
 			continue
 		}
 		stack := []ActionClass{root}
 		scored := scorePathWithCache(buildHypotheses(stack), stack, classes, "", cfg, unlockCache, baseSnapshot.hash())
-		beam = append(beam, attackCandidate{graph: baseSnapshot.clone(), stack: stack, score: scored.Score, key: actionStackKey(stack)})
+		beam = append(beam, newAttackCandidate(baseSnapshot.clone(), stack, scored))
 	}
-	beam = pruneAttackBeam(beam, cfg.BeamWidth)
+	beam = pruneAttackBeam(beam, cfg.BeamWidth, cfg.BeamObjective, tieBreaker)
 
 	for depth := 1; depth <= cfg.MaxDepth && len(beam) > 0; depth++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
 		nextBeam := make([]attackCandidate, 0, len(beam)*len(classes))
 		for _, cand := range beam {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
 			gCopy := cand.graph.clone()
 			latest := cand.stack[len(cand.stack)-1]
-			if !matchSnapshotPatterns(gCopy, latest.Preconditions) || !cfg.ROEPolicy(latest, e.graph, st) {
+			if !matchSnapshotPatterns(gCopy, latest.Preconditions) {
+				report.reject(latest.ID, RejectionPreconditionUnmet)
+				continue
+			}
+			if allowed, panicked := e.roeAllows(cfg.ROEPolicy, latest, st); !allowed {
+				report.reject(latest.ID, roeRejection(panicked))
 				continue
 			}
 			gCopy.applyAction(latest)
@@ -274,9 +533,13 @@ func (e *Engine) ExpandAttackPaths(st *state.State) ([]AttackPath, error) {
 				riskLimit *= 0.9
 			}
 			if riskLimit > 0 && risk > riskLimit {
+				report.reject(latest.ID, RejectionRiskOverTolerance)
 				continue
 			}
 			objective, reached := findObjective(cfg.ObjectiveNodeTypes, latest.ProducesNodes)
+			if reached && hypothesisForAction(latest, len(cand.stack)).Confidence < cfg.MinObjectiveConfidence {
+				objective, reached = "", false
+			}
 			path := scorePathWithCache(buildHypotheses(cand.stack), cand.stack, classes, objective, cfg, unlockCache, gCopy.hash())
 			if reached {
 				key := pathKey(path)
@@ -289,19 +552,25 @@ func (e *Engine) ExpandAttackPaths(st *state.State) ([]AttackPath, error) {
 			if depth == cfg.MaxDepth {
 				continue
 			}
-			for _, next := range idx.eligible(gCopy) {
-				if !phaseAllowed(currentPhase, next.Phase) || actionInStack(cand.stack, next.ID) {
+			successors := classes
+			if report == nil {
+				successors = idx.eligible(gCopy)
+			}
+			for _, next := range successors {
+				if reason := sequenceStepRejection(currentPhase, cand.stack, next); reason != "" {
+					report.reject(next.ID, reason)
 					continue
 				}
 				if !matchSnapshotPatterns(gCopy, next.Preconditions) {
+					report.reject(next.ID, RejectionPreconditionUnmet)
 					continue
 				}
 				nextStack := append(append([]ActionClass(nil), cand.stack...), next)
 				nextScored := scorePathWithCache(buildHypotheses(nextStack), nextStack, classes, "", cfg, unlockCache, gCopy.hash())
-				nextBeam = append(nextBeam, attackCandidate{graph: gCopy, stack: nextStack, score: nextScored.Score, key: actionStackKey(nextStack)})
+				nextBeam = append(nextBeam, newAttackCandidate(gCopy, nextStack, nextScored))
 			}
 		}
-		beam = pruneAttackBeam(nextBeam, cfg.BeamWidth)
+		beam = pruneAttackBeam(nextBeam, cfg.BeamWidth, cfg.BeamObjective, tieBreaker)
 	}
 
 	sort.Slice(paths, func(i, j int) bool {
@@ -313,12 +582,31 @@ func (e *Engine) ExpandAttackPaths(st *state.State) ([]AttackPath, error) {
 	return paths, nil
 }
 
-func pruneAttackBeam(beam []attackCandidate, width int) []attackCandidate {
-	sort.Slice(beam, func(i, j int) bool {
-		if beam[i].score == beam[j].score {
-			return beam[i].key < beam[j].key
+func newAttackCandidate(graph *graphSnapshot, stack []ActionClass, scored AttackPath) attackCandidate {
+	confidence := 0.0
+	for _, step := range scored.Steps {
+		confidence += step.Confidence
+	}
+	if len(scored.Steps) > 0 {
+		confidence /= float64(len(scored.Steps))
+	}
+	return attackCandidate{graph: graph, stack: stack, score: scored.Score, risk: scored.Risk, confidence: confidence, key: actionStackKey(stack)}
+}
+
+func pruneAttackBeam(beam []attackCandidate, width int, objective BeamObjective, tieBreaker *rand.Rand) []attackCandidate {
+	if tieBreaker != nil {
+		keys, weights := make([]string, len(beam)), make([]float64, len(beam))
+		for i, c := range beam {
+			keys[i], weights[i] = c.key, averageFeasibility(c.stack, nil)
+		}
+		for i, draw := range weightedTieBreaks(tieBreaker, keys, weights) {
+			beam[i].tieBreak = draw
 		}
-		return beam[i].score > beam[j].score
+	}
+	sort.Slice(beam, func(i, j int) bool {
+		return beamBefore(objective,
+			beamRank{score: beam[i].score, risk: beam[i].risk, confidence: beam[i].confidence, key: beam[i].key, tieBreak: beam[i].tieBreak},
+			beamRank{score: beam[j].score, risk: beam[j].risk, confidence: beam[j].confidence, key: beam[j].key, tieBreak: beam[j].tieBreak})
 	})
 	if len(beam) > width {
 		return beam[:width]
@@ -377,6 +665,35 @@ func hypothesisForAction(ac ActionClass, idx int) Hypothesis {
 	}
 }
 
+// roeAllows evaluates policy for ac, failing closed: a panicking policy denies the action and is
+// counted as an incident instead of crashing the planner. The second result reports the panic.
+func (e *Engine) roeAllows(policy func(ActionClass, *Graph, *state.State) bool, ac ActionClass, st *state.State) (allowed, panicked bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			allowed, panicked = false, true
+			e.mu.Lock()
+			e.roePolicyPanics++
+			e.mu.Unlock()
+		}
+	}()
+	return policy(ac, e.graph, st), false
+}
+
+// roeRejection maps an ROE denial to its rejection reason.
+func roeRejection(panicked bool) RejectionReason {
+	if panicked {
+		return RejectionROEPolicyPanic
+	}
+	return RejectionROEDenied
+}
+
+// ROEPolicyPanics returns how many ROE policy invocations have panicked and been treated as denials.
+func (e *Engine) ROEPolicyPanics() int {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return e.roePolicyPanics
+}
+
 func phaseAllowed(current, candidate state.OperationPhase) bool {
 	if current == candidate {
 		return true
@@ -429,12 +746,20 @@ func enrichRankedActionsWithPaths(ranked []RankedAction, paths []AttackPath) {
 			ranked[i].Reason = fmt.Sprintf("%s path_bonus=%.2f", ranked[i].Reason, bonus*0.1)
 		}
 	}
-	sort.Slice(ranked, func(i, j int) bool {
-		if ranked[i].Score == ranked[j].Score {
-			return ranked[i].TechniqueID < ranked[j].TechniqueID
+	sortRanked(ranked)
+}
+
+// pathEnablingBonus is added to techniques of the action class that opens the best objective campaign.
+const pathEnablingBonus = 0.5
+
+func boostPathEnablingActions(ranked []RankedAction, actionClassID string) {
+	for i := range ranked {
+		if ranked[i].ActionClassID == actionClassID {
+			ranked[i].Score += pathEnablingBonus
+			ranked[i].Reason = fmt.Sprintf("%s path_enabling=%.2f", ranked[i].Reason, pathEnablingBonus)
 		}
-		return ranked[i].Score > ranked[j].Score
-	})
+	}
+	sortRanked(ranked)
 }
 
 func actionInStack(stack []ActionClass, id string) bool {
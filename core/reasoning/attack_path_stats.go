@@ -0,0 +1,48 @@
+package reasoning
+
+import "math"
+
+// AttackPathStats summarizes the length distribution of a set of attack paths, where a path's length is
+// its step count.
+type AttackPathStats struct {
+	Count      int
+	MinLength  int
+	MaxLength  int
+	MeanLength float64
+	// LengthHistogram maps each observed length to how many paths have it.
+	LengthHistogram map[int]int
+	// ScoreLengthCorrelation is the Pearson correlation of score against length; 0 when either is constant.
+	ScoreLengthCorrelation float64
+}
+
+// ComputeAttackPathStats computes length statistics over paths, such as those ExpandAttackPaths returns.
+func ComputeAttackPathStats(paths []AttackPath) AttackPathStats {
+	stats := AttackPathStats{Count: len(paths), LengthHistogram: map[int]int{}}
+	if len(paths) == 0 {
+		return stats
+	}
+	stats.MinLength = len(paths[0].Steps)
+	var sumLen, sumScore float64
+	for _, p := range paths {
+		n := len(p.Steps)
+		stats.MinLength = min(stats.MinLength, n)
+		stats.MaxLength = max(stats.MaxLength, n)
+		stats.LengthHistogram[n]++
+		sumLen += float64(n)
+		sumScore += p.Score
+	}
+	count := float64(len(paths))
+	stats.MeanLength = sumLen / count
+	meanScore := sumScore / count
+	var cov, varLen, varScore float64
+	for _, p := range paths {
+		dl, ds := float64(len(p.Steps))-stats.MeanLength, p.Score-meanScore
+		cov += dl * ds
+		varLen += dl * dl
+		varScore += ds * ds
+	}
+	if varLen > 0 && varScore > 0 {
+		stats.ScoreLengthCorrelation = cov / math.Sqrt(varLen*varScore)
+	}
+	return stats
+}
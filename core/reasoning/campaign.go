@@ -1,8 +1,15 @@
 package reasoning
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"math"
+	"math/rand"
+	"slices"
 	"sort"
+	"strings"
+	"time"
 
 	"vantage/core/state"
 )
@@ -22,6 +29,41 @@ type Campaign struct {
 	Risk       float64
 	Objective  NodeType
 	Confidence float64
+	// Impact is the cumulative impact weight of the campaign's action classes.
+	Impact float64
+	// Gaps lists preconditions the campaign assumes will be obtained when planned with AllowGaps.
+	Gaps []string
+	// Meta records the provenance of the plan that produced the campaign.
+	Meta CampaignMeta
+	// DeadlineViolations lists, in lifecycle order, phases whose estimated step durations exceed their deadline.
+	DeadlineViolations []OperationPhase
+	// Redundant marks campaigns with a step that re-produces an earlier step's nodes and edges before any
+	// step consumes them; PlanCampaign ranks such campaigns after non-redundant ones.
+	Redundant bool
+}
+
+// CampaignMeta makes a planned campaign self-describing for reports: when it was planned, against which
+// action-class corpus, and with which normalized options.
+type CampaignMeta struct {
+	PlannedAt  time.Time
+	CorpusHash string
+	Options    CampaignOptions
+}
+
+// newCampaignMeta stamps the provenance shared by every campaign of one plan.
+func newCampaignMeta(classes []ActionClass, opts CampaignOptions) CampaignMeta {
+	return CampaignMeta{PlannedAt: time.Now().UTC(), CorpusHash: CorpusHash(classes), Options: opts}
+}
+
+// CorpusHash fingerprints an action-class corpus independent of load order.
+func CorpusHash(classes []ActionClass) string {
+	entries := make([]string, 0, len(classes))
+	for _, ac := range classes {
+		entries = append(entries, fmt.Sprintf("%s|%s|%s", ac.ID, ac.Name, actionClassSignature(ac)))
+	}
+	sort.Strings(entries)
+	sum := sha256.Sum256([]byte(strings.Join(entries, "\n")))
+	return hex.EncodeToString(sum[:])
 }
 
 // CampaignOptions controls campaign search bounds and pruning behavior.
@@ -33,11 +75,62 @@ type CampaignOptions struct {
 	TopN                    int
 	ObjectiveBiasWeight     float64
 	ObjectiveProximityScore float64
+	// ExcludeExecuted skips action classes already recorded in the campaign's execution history.
+	ExcludeExecuted bool
+	// BeamObjective selects which candidates survive pruning; defaults to max score.
+	BeamObjective BeamObjective
+	// AllowGaps records unmet preconditions as campaign gaps instead of discarding the candidate.
+	AllowGaps bool
+	// ConfidenceAggregation combines step confidences for the threshold check and Campaign.Confidence; defaults to mean.
+	ConfidenceAggregation ConfidenceAggregation
+	// ObjectiveBiasDecay scales the objective-bias contribution by campaign depth; defaults to uniform.
+	ObjectiveBiasDecay ObjectiveBiasDecay
+	// MaxStepRisk rejects any single action whose RiskWeight exceeds it, regardless of the cumulative budget; 0 disables it.
+	MaxStepRisk float64
+	// RandomTieBreak resolves equal-score beam ties by a feasibility-weighted draw seeded with TieBreakSeed.
+	RandomTieBreak bool
+	TieBreakSeed   int64
+	// PhaseDeadlines caps the cumulative estimated Duration of each listed phase's steps, one entry per
+	// phase; campaigns that overrun are flagged through DeadlineViolations rather than discarded.
+	PhaseDeadlines []PhaseDeadline
 }
 
+// PhaseDeadline caps the cumulative estimated duration of one phase's campaign steps.
+type PhaseDeadline struct {
+	Phase    OperationPhase
+	Deadline time.Duration
+}
+
+// ConfidenceAggregation selects how per-step confidences combine into a campaign confidence.
+type ConfidenceAggregation string
+
+const (
+	// ConfidenceAggregationMean averages step confidences (default).
+	ConfidenceAggregationMean ConfidenceAggregation = "mean"
+	// ConfidenceAggregationMin takes the weakest step's confidence.
+	ConfidenceAggregationMin ConfidenceAggregation = "min"
+	// ConfidenceAggregationGeometric takes the geometric mean, penalizing compounding weak steps.
+	ConfidenceAggregationGeometric ConfidenceAggregation = "geometric"
+)
+
+// ObjectiveBiasDecay selects how the objective-bias contribution varies with campaign depth.
+type ObjectiveBiasDecay string
+
+const (
+	// ObjectiveBiasDecayUniform applies the full bias at every depth (default).
+	ObjectiveBiasDecayUniform ObjectiveBiasDecay = "uniform"
+	// ObjectiveBiasDecayRamp scales the bias by depth/MaxDepth so proximity matters most near the end.
+	ObjectiveBiasDecayRamp ObjectiveBiasDecay = "ramp"
+	// ObjectiveBiasDecayGeometric multiplies the bias by ObjectiveBiasDecayRate for every step past the first.
+	ObjectiveBiasDecayGeometric ObjectiveBiasDecay = "geometric"
+)
+
+// ObjectiveBiasDecayRate is the per-step factor applied by ObjectiveBiasDecayGeometric.
+const ObjectiveBiasDecayRate = 0.5
+
 // DefaultCampaignOptions returns conservative deterministic planning defaults.
 func DefaultCampaignOptions() CampaignOptions {
-	return CampaignOptions{MaxDepth: 5, RiskTolerance: 2.0, ConfidenceThreshold: 0.55, BeamWidth: 25, TopN: 10, ObjectiveBiasWeight: 0.35}
+	return CampaignOptions{MaxDepth: 5, RiskTolerance: 2.0, ConfidenceThreshold: 0.55, BeamWidth: 25, TopN: 10, ObjectiveBiasWeight: 0.35, BeamObjective: BeamObjectiveMaxScore, ConfidenceAggregation: ConfidenceAggregationMean, ObjectiveBiasDecay: ObjectiveBiasDecayUniform}
 }
 
 type campaignCandidate struct {
@@ -48,18 +141,91 @@ type campaignCandidate struct {
 	risk             float64
 	confidence       float64
 	objectiveReached bool
+	reachedObjective NodeType
 	phaseProgress    []state.OperationPhase
 	feasibility      float64
+	gaps             []string
+	tieBreak         float64
 }
 
 // PlanCampaign computes prioritized strategic campaigns for a requested objective node type.
 func (e *Engine) PlanCampaign(objective NodeType, opts CampaignOptions) ([]Campaign, error) {
+	return e.planCampaign(objective, opts, nil, nil, true)
+}
+
+// PlanCampaignWithReport plans like PlanCampaign and also reports why each rejected action class was discarded.
+func (e *Engine) PlanCampaignWithReport(objective NodeType, opts CampaignOptions) ([]Campaign, *PlanningReport, error) {
+	report := newPlanningReport()
+	campaigns, err := e.planCampaign(objective, opts, report, nil, true)
+	if err != nil {
+		return nil, nil, err
+	}
+	return campaigns, report.finalize(), nil
+}
+
+// PlanCampaignMetrics plans like PlanCampaign and also reports how much work the search did.
+func (e *Engine) PlanCampaignMetrics(objective NodeType, opts CampaignOptions) ([]Campaign, PlanMetrics, error) {
+	metrics := &PlanMetrics{}
+	start := time.Now()
+	campaigns, err := e.planCampaign(objective, opts, nil, metrics, true)
+	if err != nil {
+		return nil, PlanMetrics{}, err
+	}
+	metrics.WallTime = time.Since(start)
+	return campaigns, *metrics, nil
+}
+
+// PlanCampaignMulti plans toward several objectives in one shared beam search. Each campaign is recorded
+// against the requested objective its final step attains, and proximity scoring steers toward whichever
+// requested objective is closest. The result holds, per attained objective, up to opts.TopN campaigns
+// ranked as PlanCampaign ranks them; objectives no campaign attains are absent.
+func (e *Engine) PlanCampaignMulti(objectives []NodeType, opts CampaignOptions) (map[NodeType][]Campaign, error) {
+	if e == nil {
+		return nil, fmt.Errorf("engine is nil")
+	}
+	if len(objectives) == 0 {
+		return nil, fmt.Errorf("at least one objective is required")
+	}
+	unique := make([]NodeType, 0, len(objectives))
+	for _, objective := range objectives {
+		if objective == "" {
+			return nil, fmt.Errorf("objective is required")
+		}
+		if !producesNode(unique, objective) {
+			unique = append(unique, objective)
+		}
+	}
+	groups, err := e.planCampaignGroups(unique, opts, nil, nil, true)
+	if err != nil {
+		return nil, err
+	}
+	if groups == nil {
+		groups = map[NodeType][]Campaign{}
+	}
+	return groups, nil
+}
+
+// planCampaign plans toward one objective; recordSignature marks the plan as the one ReplanIncremental
+// compares against, which internal planning must not do.
+func (e *Engine) planCampaign(objective NodeType, opts CampaignOptions, report *PlanningReport, metrics *PlanMetrics, recordSignature bool) ([]Campaign, error) {
 	if e == nil {
 		return nil, fmt.Errorf("engine is nil")
 	}
 	if objective == "" {
 		return nil, fmt.Errorf("objective is required")
 	}
+	groups, err := e.planCampaignGroups([]NodeType{objective}, opts, report, metrics, recordSignature)
+	if err != nil || groups == nil {
+		return nil, err
+	}
+	return append(make([]Campaign, 0, len(groups[objective])), groups[objective]...), nil
+}
+
+// planCampaignGroups runs one beam search toward every objective and groups the ranked campaigns by the
+// objective each attains. It returns nil when no action classes are bound.
+func (e *Engine) planCampaignGroups(objectives []NodeType, opts CampaignOptions, report *PlanningReport, metrics *PlanMetrics, recordSignature bool) (map[NodeType][]Campaign, error) {
+	inputs := e.capturePlanInputs(opts)
+	baseSnapshot, currentPhase, executed := inputs.snapshot, inputs.phase, inputs.executed
 
 	cfg := normalizeCampaignOptions(opts)
 	classes := e.boundActionClasses()
@@ -67,32 +233,58 @@ func (e *Engine) PlanCampaign(objective NodeType, opts CampaignOptions) ([]Campa
 		return nil, nil
 	}
 	sort.Slice(classes, func(i, j int) bool { return classes[i].ID < classes[j].ID })
-	if e.graph == nil {
+	if baseSnapshot == nil {
 		return nil, fmt.Errorf("start graph is nil")
 	}
+	if recordSignature {
+		e.mu.Lock()
+		e.lastPlanSignature = planSignature(inputs, classes, joinedObjective(objectives), cfg)
+		e.mu.Unlock()
+	}
 
 	index := buildActionClassIndex(classes)
-	unlockCache := map[string]float64{}
-	beam := []campaignCandidate{{graph: snapshotFromGraph(e.graph)}}
-	currentPhase := phaseForState(e.state)
+	unlockCache := e.newPlanningUnlockCache()
+	tieBreaker := newTieBreaker(cfg.RandomTieBreak, cfg.TieBreakSeed)
+	beam := []campaignCandidate{{graph: baseSnapshot}}
 	seen := map[string]struct{}{}
 	campaigns := make([]Campaign, 0)
 
 	for depth := 1; depth <= cfg.MaxDepth; depth++ {
-		beam = pruneCampaignBeam(beam, cfg.BeamWidth)
+		beam = pruneCampaignBeam(beam, cfg.BeamWidth, cfg.BeamObjective, tieBreaker)
 		nextBeam := make([]campaignCandidate, 0, len(beam)*len(classes))
 		for _, candidate := range beam {
-			for _, action := range index.eligible(candidate.graph) {
-				if !campaignPhaseAllowed(currentPhase, candidate.phaseProgress, action.Phase) || !matchSnapshotPatterns(candidate.graph, action.Preconditions) {
+			// Diagnostics walk every class so precondition rejections are attributed instead of
+			// being filtered out silently by the eligibility index.
+			candidates := classes
+			if !cfg.AllowGaps && report == nil {
+				candidates = index.eligible(candidate.graph)
+			}
+			for _, action := range candidates {
+				if _, done := executed[action.ID]; done {
+					report.reject(action.ID, RejectionAlreadyExecuted)
+					continue
+				}
+				if inputs.impactGated && isImpactPhase(action.Phase) {
+					report.reject(action.ID, RejectionInsufficientEvidence)
+					continue
+				}
+				if reason := sequenceStepRejection(currentPhase, candidate.actions, action); reason != "" {
+					report.reject(action.ID, reason)
+					continue
+				}
+				if !cfg.AllowGaps && !matchSnapshotPatterns(candidate.graph, action.Preconditions) {
+					report.reject(action.ID, RejectionPreconditionUnmet)
 					continue
 				}
-				projected, ok := projectCampaignCandidate(candidate, action, classes, objective, cfg, unlockCache)
-				if !ok {
+				metrics.projected()
+				projected, reason := projectCampaignCandidate(candidate, action, classes, objectives, cfg, unlockCache)
+				if reason != "" {
+					report.reject(action.ID, reason)
 					continue
 				}
 				nextBeam = append(nextBeam, projected)
 				if projected.objectiveReached {
-					campaign := Campaign{Steps: append([]AttackStep(nil), projected.steps...), Score: projected.score, Risk: projected.risk, Objective: objective, Confidence: projected.confidence}
+					campaign := Campaign{Steps: append([]AttackStep(nil), projected.steps...), Score: projected.score, Risk: projected.risk, Objective: projected.reachedObjective, Confidence: projected.confidence, Impact: cumulativeImpact(projected.actions), Gaps: append([]string(nil), projected.gaps...), DeadlineViolations: phaseDeadlineViolations(projected.actions, cfg.PhaseDeadlines)}
 					key := campaignKey(campaign)
 					if _, exists := seen[key]; !exists {
 						seen[key] = struct{}{}
@@ -101,31 +293,211 @@ func (e *Engine) PlanCampaign(objective NodeType, opts CampaignOptions) ([]Campa
 				}
 			}
 		}
-		nextBeam = pruneCampaignBeam(nextBeam, cfg.BeamWidth)
+		examined := len(nextBeam)
+		nextBeam = pruneCampaignBeam(nextBeam, cfg.BeamWidth, cfg.BeamObjective, tieBreaker)
+		metrics.pruned(examined - len(nextBeam))
 		if len(nextBeam) == 0 {
 			break
 		}
 		beam = nextBeam
 	}
 
+	byID := make(map[string]ActionClass, len(classes))
+	for _, ac := range classes {
+		byID[ac.ID] = ac
+	}
+	for i := range campaigns {
+		campaigns[i].Redundant = hasRedundantStep(campaigns[i].Steps, byID)
+	}
 	sort.Slice(campaigns, func(i, j int) bool {
+		if campaigns[i].Redundant != campaigns[j].Redundant {
+			return !campaigns[i].Redundant
+		}
 		if campaigns[i].Score == campaigns[j].Score {
 			return campaignKey(campaigns[i]) < campaignKey(campaigns[j])
 		}
 		return campaigns[i].Score > campaigns[j].Score
 	})
-	if len(campaigns) > cfg.TopN {
-		campaigns = campaigns[:cfg.TopN]
+	metrics.recordUnlockCache(unlockCache)
+	meta := newCampaignMeta(classes, cfg)
+	groups := make(map[NodeType][]Campaign, len(objectives))
+	for _, objective := range objectives {
+		groups[objective] = make([]Campaign, 0)
+	}
+	for _, c := range campaigns {
+		if len(groups[c.Objective]) >= cfg.TopN {
+			continue
+		}
+		c.Meta = meta
+		c.Score *= inputs.objectiveScale(c.Objective)
+		groups[c.Objective] = append(groups[c.Objective], c)
+	}
+	if len(objectives) > 1 {
+		for objective, group := range groups {
+			if len(group) == 0 {
+				delete(groups, objective)
+			}
+		}
 	}
-	return campaigns, nil
+	return groups, nil
 }
 
-func pruneCampaignBeam(beam []campaignCandidate, width int) []campaignCandidate {
-	sort.Slice(beam, func(i, j int) bool {
-		if beam[i].score == beam[j].score {
-			return candidatePathKey(beam[i]) < candidatePathKey(beam[j])
+// hasRedundantStep reports whether a later step re-produces exactly the nodes and edges of an earlier
+// step while neither it nor any step in between has a precondition consuming them, making the later step a
+// no-op expansion.
+func hasRedundantStep(steps []AttackStep, byID map[string]ActionClass) bool {
+	for i := range steps {
+		produced := byID[steps[i].ActionClassID]
+		if len(produced.ProducesNodes) == 0 {
+			continue
+		}
+		key := productionKey(produced)
+		for j := i + 1; j < len(steps); j++ {
+			next := byID[steps[j].ActionClassID]
+			if consumesProduction(next, produced) {
+				break
+			}
+			if productionKey(next) == key {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// productionKey identifies the nodes and edges one execution of ac adds to the graph, counting repeated
+// node types separately.
+func productionKey(ac ActionClass) string {
+	nodes := make([]string, 0, len(ac.ProducesNodes))
+	for _, t := range ac.ProducesNodes {
+		nodes = append(nodes, string(t))
+	}
+	edges := make([]string, 0, len(ac.ProducesEdges))
+	for _, t := range ac.ProducesEdges {
+		edges = append(edges, string(t))
+	}
+	sort.Strings(nodes)
+	sort.Strings(edges)
+	return strings.Join(nodes, ",") + "|" + strings.Join(edges, ",")
+}
+
+// consumesProduction reports whether any of ac's preconditions requires a node or edge type producer adds.
+func consumesProduction(ac, producer ActionClass) bool {
+	for _, pattern := range ac.Preconditions {
+		for _, t := range pattern.RequiredNodeTypes {
+			if producesNode(producer.ProducesNodes, t) {
+				return true
+			}
+		}
+		for _, t := range pattern.RequiredEdges {
+			if slices.Contains(producer.ProducesEdges, t) {
+				return true
+			}
 		}
-		return beam[i].score > beam[j].score
+	}
+	return false
+}
+
+// planInputs is the engine state a campaign plan is computed from.
+type planInputs struct {
+	snapshot *graphSnapshot
+	phase    OperationPhase
+	executed map[string]struct{}
+	// impactGated withholds impact-phase action classes until enough evidence corroborates the campaign.
+	impactGated bool
+	// scoreScale holds the engine's per-objective campaign score factors.
+	scoreScale map[NodeType]float64
+}
+
+// objectiveScale returns the factor campaign scores for objective are multiplied by.
+func (in planInputs) objectiveScale(objective NodeType) float64 {
+	if factor, ok := in.scoreScale[objective]; ok {
+		return factor
+	}
+	return 1
+}
+
+// capturePlanInputs snapshots graph and phase once under the engine read lock so planning
+// never touches shared engine state while RunCycle may be mutating it.
+func (e *Engine) capturePlanInputs(opts CampaignOptions) planInputs {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	in := planInputs{phase: phaseForState(e.state), executed: map[string]struct{}{}, impactGated: e.impactGated(), scoreScale: e.objectiveScoreScale}
+	if opts.ExcludeExecuted && e.state != nil {
+		for _, id := range e.state.PreviousActions() {
+			in.executed[id] = struct{}{}
+		}
+	}
+	if e.graph != nil {
+		in.snapshot = e.snapshots.get(e.graph)
+	}
+	return in
+}
+
+// planSignature identifies everything a campaign plan depends on. Precondition matching only
+// observes whether a node or edge type is present, so counts are deliberately excluded.
+func planSignature(in planInputs, classes []ActionClass, objective NodeType, cfg CampaignOptions) string {
+	nodes := map[NodeType]struct{}{}
+	edges := map[EdgeType]struct{}{}
+	if in.snapshot != nil {
+		for n, c := range in.snapshot.nodeCounts {
+			if c > 0 {
+				nodes[n] = struct{}{}
+			}
+		}
+		for t, c := range in.snapshot.edgeCounts {
+			if c > 0 {
+				edges[t] = struct{}{}
+			}
+		}
+	}
+	executed := make([]string, 0, len(in.executed))
+	for id := range in.executed {
+		executed = append(executed, id)
+	}
+	sort.Strings(executed)
+	return fmt.Sprintf("%s|%s|%s|%v|%t|%v|%+v|%+v", availabilityHash(nodes, edges), in.phase, objective, executed, in.impactGated, in.objectiveScale(objective), cfg, classes)
+}
+
+// ReplanIncremental reuses prev when nothing that campaign planning observes has changed since
+// the last plan, and otherwise falls back to a full re-plan. A change in type presence can open
+// branches that shift beam pruning, so any affected plan is recomputed in full to stay identical
+// to PlanCampaign.
+func (e *Engine) ReplanIncremental(prev []Campaign, objective NodeType, opts CampaignOptions) ([]Campaign, error) {
+	if e == nil {
+		return nil, fmt.Errorf("engine is nil")
+	}
+	if prev != nil && objective != "" {
+		inputs := e.capturePlanInputs(opts)
+		classes := e.boundActionClasses()
+		sort.Slice(classes, func(i, j int) bool { return classes[i].ID < classes[j].ID })
+		sig := planSignature(inputs, classes, objective, normalizeCampaignOptions(opts))
+		e.mu.RLock()
+		unchanged := sig == e.lastPlanSignature
+		e.mu.RUnlock()
+		if unchanged {
+			out := make([]Campaign, len(prev))
+			copy(out, prev)
+			return out, nil
+		}
+	}
+	return e.PlanCampaign(objective, opts)
+}
+
+func pruneCampaignBeam(beam []campaignCandidate, width int, objective BeamObjective, tieBreaker *rand.Rand) []campaignCandidate {
+	if tieBreaker != nil {
+		keys, weights := make([]string, len(beam)), make([]float64, len(beam))
+		for i, c := range beam {
+			keys[i], weights[i] = candidatePathKey(c), c.feasibility
+		}
+		for i, draw := range weightedTieBreaks(tieBreaker, keys, weights) {
+			beam[i].tieBreak = draw
+		}
+	}
+	sort.Slice(beam, func(i, j int) bool {
+		return beamBefore(objective,
+			beamRank{score: beam[i].score, risk: beam[i].risk, confidence: beam[i].confidence, key: candidatePathKey(beam[i]), tieBreak: beam[i].tieBreak},
+			beamRank{score: beam[j].score, risk: beam[j].risk, confidence: beam[j].confidence, key: candidatePathKey(beam[j]), tieBreak: beam[j].tieBreak})
 	})
 	if len(beam) > width {
 		return beam[:width]
@@ -157,35 +529,484 @@ func normalizeCampaignOptions(opts CampaignOptions) CampaignOptions {
 	return cfg
 }
 
-func projectCampaignCandidate(candidate campaignCandidate, action ActionClass, classes []ActionClass, objective NodeType, cfg CampaignOptions, unlockCache map[string]float64) (campaignCandidate, bool) {
-	proj, err := projectCampaignState(CampaignProjectionState{Graph: candidate.graph, PhaseProgress: candidate.phaseProgress}, action)
-	if err != nil {
-		return campaignCandidate{}, false
+// projectCampaignCandidate extends candidate with action, returning a non-empty rejection reason when the step is discarded.
+func projectCampaignCandidate(candidate campaignCandidate, action ActionClass, classes []ActionClass, objectives []NodeType, cfg CampaignOptions, unlockCache *unlockCache) (campaignCandidate, RejectionReason) {
+	proj, stepGaps := projectCampaignStateWithGaps(CampaignProjectionState{Graph: candidate.graph, PhaseProgress: candidate.phaseProgress}, action)
+	if len(stepGaps) > 0 && !cfg.AllowGaps {
+		return campaignCandidate{}, RejectionPreconditionUnmet
+	}
+	if cfg.MaxStepRisk > 0 && action.RiskWeight > cfg.MaxStepRisk {
+		return campaignCandidate{}, RejectionStepRiskOverLimit
 	}
 	actions := append(append([]ActionClass(nil), candidate.actions...), action)
 	risk := cumulativeRisk(actions)
 	if risk > cfg.RiskTolerance {
-		return campaignCandidate{}, false
+		return campaignCandidate{}, RejectionRiskOverTolerance
 	}
 
 	steps := append(append([]AttackStep(nil), candidate.steps...), attackStepForAction(action, len(actions)))
-	confidence := averageCampaignConfidence(steps)
+	confidence := aggregateCampaignConfidence(steps, cfg.ConfidenceAggregation)
 	if confidence < cfg.ConfidenceThreshold {
-		return campaignCandidate{}, false
+		return campaignCandidate{}, RejectionConfidenceBelowThreshold
 	}
-	feasibility := averageFeasibility(actions)
-	if len(candidate.steps) > 0 && feasibility+1e-9 < candidate.feasibility {
-		return campaignCandidate{}, false
+	feasibility := averageFeasibility(actions, nil)
+	if len(stepGaps) == 0 && len(candidate.steps) > 0 && feasibility+1e-9 < candidate.feasibility {
+		return campaignCandidate{}, RejectionFeasibilityRegressed
 	}
 
+	objective, proximity := closestObjective(actions, action, objectives)
 	reached := producesNode(action.ProducesNodes, objective)
-	distance := objectiveDistance(actions, objective)
-	proximity := objectiveProximityScore(distance, action, objective)
 	hypSteps := hypothesesFromAttackSteps(steps)
 	scored := scorePathWithCache(hypSteps, actions, classes, nodeTypeIf(reached, objective), DefaultAttackPathConfig(), unlockCache, proj.Graph.hash())
-	scored.Score += proximity * cfg.ObjectiveBiasWeight
+	scored.Score += proximity * cfg.ObjectiveBiasWeight * objectiveBiasFactor(cfg.ObjectiveBiasDecay, len(actions), cfg.MaxDepth)
 
-	return campaignCandidate{graph: proj.Graph, actions: actions, steps: steps, score: scored.Score, risk: risk, confidence: confidence, objectiveReached: reached, phaseProgress: proj.PhaseProgress, feasibility: feasibility}, true
+	gaps := append(append([]string(nil), candidate.gaps...), stepGaps...)
+	return campaignCandidate{graph: proj.Graph, actions: actions, steps: steps, score: scored.Score, risk: risk, confidence: confidence, objectiveReached: reached, reachedObjective: nodeTypeIf(reached, objective), phaseProgress: proj.PhaseProgress, feasibility: feasibility, gaps: gaps}, ""
+}
+
+// closestObjective picks the requested objective a campaign ending in action is nearest to: one the action
+// produces if any, in request order, and otherwise the one with the highest proximity score.
+func closestObjective(actions []ActionClass, action ActionClass, objectives []NodeType) (NodeType, float64) {
+	best, bestProximity := NodeType(""), math.Inf(-1)
+	for _, objective := range objectives {
+		proximity := objectiveProximityScore(objectiveDistance(actions, objective), action, objective)
+		if producesNode(action.ProducesNodes, objective) {
+			return objective, proximity
+		}
+		if proximity > bestProximity {
+			best, bestProximity = objective, proximity
+		}
+	}
+	return best, bestProximity
+}
+
+// joinedObjective names a set of objectives for plan signatures; a single objective names itself.
+func joinedObjective(objectives []NodeType) NodeType {
+	names := make([]string, 0, len(objectives))
+	for _, objective := range objectives {
+		names = append(names, string(objective))
+	}
+	return NodeType(strings.Join(names, "+"))
+}
+
+// ParetoCampaigns plans campaigns and returns only those not dominated on lower risk,
+// higher impact, and higher confidence, leaving the tradeoff choice to the operator.
+func (e *Engine) ParetoCampaigns(objective NodeType, opts CampaignOptions) ([]Campaign, error) {
+	campaigns, err := e.PlanCampaign(objective, opts)
+	if err != nil {
+		return nil, err
+	}
+	return ParetoFrontier(campaigns), nil
+}
+
+// CampaignThresholdSweep plans once at the lowest requested confidence threshold and reports, for each
+// threshold, how many objective-reaching campaigns from that plan meet it. Counts can differ from planning
+// at each threshold separately: low-confidence prefixes admitted by the lowest threshold take beam slots a
+// stricter plan would give to other branches. Counts are also capped by opts.TopN.
+func (e *Engine) CampaignThresholdSweep(objective NodeType, opts CampaignOptions, thresholds []float64) map[float64]int {
+	out := make(map[float64]int, len(thresholds))
+	if len(thresholds) == 0 {
+		return out
+	}
+	low := thresholds[0]
+	for _, t := range thresholds[1:] {
+		low = math.Min(low, t)
+	}
+	if low <= 0 {
+		// Non-positive thresholds normalize to the default, so sweep from the smallest positive one instead.
+		low = math.SmallestNonzeroFloat64
+	}
+	opts.ConfidenceThreshold = low
+	campaigns, err := e.PlanCampaign(objective, opts)
+	for _, t := range thresholds {
+		out[t] = 0
+		if err != nil {
+			continue
+		}
+		for _, c := range campaigns {
+			if c.Objective == objective && c.Confidence >= t {
+				out[t]++
+			}
+		}
+	}
+	return out
+}
+
+// ShortestCampaign returns the objective-reaching campaign with the fewest steps that satisfies the risk
+// and confidence thresholds, breaking ties by lowest risk. Unlike PlanCampaign it ignores score and expands
+// every depth level in full rather than pruning to a beam; per level, a path to a projected graph state is
+// dropped only when another path to that state has no more risk and no less confidence, so a riskier but
+// more confident prefix survives to meet the confidence threshold later. It returns nil when no campaign
+// reaches the objective within MaxDepth.
+func (e *Engine) ShortestCampaign(objective NodeType, opts CampaignOptions) (*Campaign, error) {
+	if e == nil {
+		return nil, fmt.Errorf("engine is nil")
+	}
+	if objective == "" {
+		return nil, fmt.Errorf("objective is required")
+	}
+
+	inputs := e.capturePlanInputs(opts)
+	if inputs.snapshot == nil {
+		return nil, fmt.Errorf("start graph is nil")
+	}
+	cfg := normalizeCampaignOptions(opts)
+	classes := e.boundActionClasses()
+	sort.Slice(classes, func(i, j int) bool { return classes[i].ID < classes[j].ID })
+
+	index := buildActionClassIndex(classes)
+	unlockCache := e.newPlanningUnlockCache()
+	frontier := []campaignCandidate{{graph: inputs.snapshot}}
+	for depth := 1; depth <= cfg.MaxDepth && len(frontier) > 0; depth++ {
+		next := map[string][]campaignCandidate{}
+		var best *campaignCandidate
+		for _, candidate := range frontier {
+			candidates := classes
+			if !cfg.AllowGaps {
+				candidates = index.eligible(candidate.graph)
+			}
+			for _, action := range candidates {
+				if _, done := inputs.executed[action.ID]; done {
+					continue
+				}
+				if inputs.impactGated && isImpactPhase(action.Phase) {
+					continue
+				}
+				if sequenceStepRejection(inputs.phase, candidate.actions, action) != "" {
+					continue
+				}
+				if !cfg.AllowGaps && !matchSnapshotPatterns(candidate.graph, action.Preconditions) {
+					continue
+				}
+				projected, reason := projectCampaignCandidate(candidate, action, classes, []NodeType{objective}, cfg, unlockCache)
+				if reason != "" {
+					continue
+				}
+				if projected.objectiveReached {
+					if best == nil || shorterCampaignBefore(projected, *best) {
+						p := projected
+						best = &p
+					}
+					continue
+				}
+				key := fmt.Sprintf("%s|%v", projected.graph.hash(), projected.phaseProgress)
+				next[key] = addNonDominatedPrefix(next[key], projected)
+			}
+		}
+		if best != nil {
+			return &Campaign{Steps: append([]AttackStep(nil), best.steps...), Score: best.score * inputs.objectiveScale(objective), Risk: best.risk, Objective: objective, Confidence: best.confidence, Impact: cumulativeImpact(best.actions), Gaps: append([]string(nil), best.gaps...), Meta: newCampaignMeta(classes, cfg), DeadlineViolations: phaseDeadlineViolations(best.actions, cfg.PhaseDeadlines)}, nil
+		}
+		frontier = make([]campaignCandidate, 0, len(next))
+		for _, candidates := range next {
+			frontier = append(frontier, candidates...)
+		}
+		sort.Slice(frontier, func(i, j int) bool { return candidatePathKey(frontier[i]) < candidatePathKey(frontier[j]) })
+	}
+	return nil, nil
+}
+
+// ReachableObjectives returns, sorted, the objective node types some bound action class produces and that a
+// depth-bounded campaign under opts can still attain from the current graph. Each candidate is checked with
+// ShortestCampaign, so the same phase, risk, and confidence rules as planning apply.
+func (e *Engine) ReachableObjectives(opts CampaignOptions) []NodeType {
+	if e == nil {
+		return nil
+	}
+	produced := map[NodeType]struct{}{}
+	for _, ac := range e.boundActionClasses() {
+		for _, n := range ac.ProducesNodes {
+			produced[n] = struct{}{}
+		}
+	}
+	out := make([]NodeType, 0)
+	for _, objective := range objectiveNodeTypes() {
+		if _, ok := produced[objective]; !ok {
+			continue
+		}
+		if c, err := e.ShortestCampaign(objective, opts); err == nil && c != nil {
+			out = append(out, objective)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i] < out[j] })
+	return out
+}
+
+// CampaignInfeasibleError identifies the first step of an explicit campaign that planning would reject.
+type CampaignInfeasibleError struct {
+	// Step is the 1-based position of the broken step.
+	Step          int
+	ActionClassID string
+	Reason        RejectionReason
+}
+
+func (e *CampaignInfeasibleError) Error() string {
+	return fmt.Sprintf("campaign step %d (%s) is infeasible: %s", e.Step, e.ActionClassID, e.Reason)
+}
+
+// EvaluateCampaign re-validates and re-scores an explicit, ordered action-class sequence, such as a planned
+// campaign an operator has edited. Each step is held to the same phase, precondition, risk, and confidence
+// rules as PlanCampaign, and the final step must reach the objective. The first violation is returned as a
+// *CampaignInfeasibleError.
+func (e *Engine) EvaluateCampaign(steps []string, objective NodeType, opts CampaignOptions) (*Campaign, error) {
+	if e == nil {
+		return nil, fmt.Errorf("engine is nil")
+	}
+	if objective == "" {
+		return nil, fmt.Errorf("objective is required")
+	}
+	if len(steps) == 0 {
+		return nil, fmt.Errorf("campaign has no steps")
+	}
+
+	inputs := e.capturePlanInputs(opts)
+	if inputs.snapshot == nil {
+		return nil, fmt.Errorf("start graph is nil")
+	}
+	cfg := normalizeCampaignOptions(opts)
+	classes := e.boundActionClasses()
+	sort.Slice(classes, func(i, j int) bool { return classes[i].ID < classes[j].ID })
+	byID := make(map[string]ActionClass, len(classes))
+	for _, ac := range classes {
+		byID[ac.ID] = ac
+	}
+
+	unlockCache := e.newPlanningUnlockCache()
+	candidate := campaignCandidate{graph: inputs.snapshot}
+	for i, id := range steps {
+		action, ok := byID[id]
+		if !ok {
+			return nil, fmt.Errorf("campaign step %d: unknown action class %q", i+1, id)
+		}
+		_, done := inputs.executed[id]
+		reason := sequenceStepRejection(inputs.phase, candidate.actions, action)
+		switch {
+		case done:
+			reason = RejectionAlreadyExecuted
+		case inputs.impactGated && isImpactPhase(action.Phase):
+			reason = RejectionInsufficientEvidence
+		case reason != "":
+		case !cfg.AllowGaps && !matchSnapshotPatterns(candidate.graph, action.Preconditions):
+			reason = RejectionPreconditionUnmet
+		default:
+			candidate, reason = projectCampaignCandidate(candidate, action, classes, []NodeType{objective}, cfg, unlockCache)
+		}
+		if reason != "" {
+			return nil, &CampaignInfeasibleError{Step: i + 1, ActionClassID: id, Reason: reason}
+		}
+	}
+	if !candidate.objectiveReached {
+		return nil, &CampaignInfeasibleError{Step: len(steps), ActionClassID: steps[len(steps)-1], Reason: RejectionObjectiveUnreached}
+	}
+	return &Campaign{Steps: candidate.steps, Score: candidate.score * inputs.objectiveScale(objective), Risk: candidate.risk, Objective: objective, Confidence: candidate.confidence, Impact: cumulativeImpact(candidate.actions), Gaps: candidate.gaps, Meta: newCampaignMeta(classes, cfg), DeadlineViolations: phaseDeadlineViolations(candidate.actions, cfg.PhaseDeadlines)}, nil
+}
+
+// RecheckCampaign replays a previously planned campaign against the current graph and reports whether every
+// step's preconditions still hold, along with the action class IDs of the steps that no longer do. Each step
+// is assumed to produce its outputs even when broken, so a failure is attributed only to the step whose own
+// preconditions lapsed. Steps whose action class is no longer bound are reported as broken.
+func (e *Engine) RecheckCampaign(c Campaign) (bool, []string) {
+	if e == nil {
+		return false, nil
+	}
+	snapshot := e.capturePlanInputs(CampaignOptions{}).snapshot
+	if snapshot == nil {
+		snapshot = snapshotFromGraph(nil)
+	}
+	byID := map[string]ActionClass{}
+	for _, ac := range e.boundActionClasses() {
+		byID[ac.ID] = ac
+	}
+	var broken []string
+	for _, step := range c.Steps {
+		ac, ok := byID[step.ActionClassID]
+		if !ok || !matchSnapshotPatterns(snapshot, ac.Preconditions) {
+			broken = append(broken, step.ActionClassID)
+		}
+		snapshot.applyAction(ac)
+	}
+	return len(broken) == 0, broken
+}
+
+// DeRiskSuggestion proposes replacing one campaign step with a lower-risk action class that produces the
+// same node types.
+type DeRiskSuggestion struct {
+	// Step is the 1-based position of the replaced step.
+	Step          int
+	ActionClassID string
+	Replacement   string
+	// RiskReduction is how much the campaign's cumulative risk drops with the replacement.
+	RiskReduction float64
+	// Campaign is the re-evaluated campaign with the replacement applied.
+	Campaign Campaign
+}
+
+// SuggestDeRisk lists single-step swaps that lower c's cumulative risk: each candidate replacement produces
+// every node type the replaced step's class produces, carries a lower risk weight, and keeps the edited
+// campaign feasible under EvaluateCampaign with c's planning options, so the objective stays reachable.
+// Suggestions are ordered by largest risk reduction first.
+func (e *Engine) SuggestDeRisk(c Campaign) []DeRiskSuggestion {
+	if e == nil || len(c.Steps) == 0 {
+		return nil
+	}
+	classes := e.boundActionClasses()
+	sort.Slice(classes, func(i, j int) bool { return classes[i].ID < classes[j].ID })
+	byID := make(map[string]ActionClass, len(classes))
+	for _, ac := range classes {
+		byID[ac.ID] = ac
+	}
+	steps := make([]string, len(c.Steps))
+	for i, step := range c.Steps {
+		steps[i] = step.ActionClassID
+	}
+	var out []DeRiskSuggestion
+	for i, id := range steps {
+		current, ok := byID[id]
+		if !ok {
+			continue
+		}
+		for _, alt := range classes {
+			if alt.ID == id || alt.RiskWeight >= current.RiskWeight || !producesAllNodes(alt, current.ProducesNodes) {
+				continue
+			}
+			edited := append([]string(nil), steps...)
+			edited[i] = alt.ID
+			replaced, err := e.EvaluateCampaign(edited, c.Objective, c.Meta.Options)
+			if err != nil || replaced.Risk >= c.Risk {
+				continue
+			}
+			out = append(out, DeRiskSuggestion{Step: i + 1, ActionClassID: id, Replacement: alt.ID, RiskReduction: c.Risk - replaced.Risk, Campaign: *replaced})
+		}
+	}
+	sort.SliceStable(out, func(i, j int) bool { return out[i].RiskReduction > out[j].RiskReduction })
+	return out
+}
+
+// producesAllNodes reports whether ac produces every node type in want.
+func producesAllNodes(ac ActionClass, want []NodeType) bool {
+	for _, n := range want {
+		found := false
+		for _, p := range ac.ProducesNodes {
+			found = found || p == n
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// shorterCampaignBefore orders equal-length candidates by lowest risk, then by path key for determinism.
+// addNonDominatedPrefix adds candidate to the paths kept for one projected state unless a kept path has no
+// more risk and no less confidence; kept paths the candidate dominates are dropped. Paths equal on both keep
+// the one shorterCampaignBefore prefers.
+func addNonDominatedPrefix(kept []campaignCandidate, candidate campaignCandidate) []campaignCandidate {
+	for _, prev := range kept {
+		if prefixDominates(prev, candidate) {
+			return kept
+		}
+	}
+	out := make([]campaignCandidate, 0, len(kept)+1)
+	for _, prev := range kept {
+		if !prefixDominates(candidate, prev) {
+			out = append(out, prev)
+		}
+	}
+	return append(out, candidate)
+}
+
+// prefixDominates reports whether a is at least as good as b on risk and confidence and better on one, or
+// ties on both and is preferred by shorterCampaignBefore.
+func prefixDominates(a, b campaignCandidate) bool {
+	if a.risk > b.risk || a.confidence < b.confidence {
+		return false
+	}
+	return a.risk < b.risk || a.confidence > b.confidence || shorterCampaignBefore(a, b)
+}
+
+func shorterCampaignBefore(a, b campaignCandidate) bool {
+	if a.risk != b.risk {
+		return a.risk < b.risk
+	}
+	return candidatePathKey(a) < candidatePathKey(b)
+}
+
+// ParetoFrontier filters campaigns to the non-dominated set, preserving input order.
+func ParetoFrontier(campaigns []Campaign) []Campaign {
+	out := make([]Campaign, 0, len(campaigns))
+	for i := range campaigns {
+		dominated := false
+		for j := range campaigns {
+			if i != j && campaignDominates(campaigns[j], campaigns[i]) {
+				dominated = true
+				break
+			}
+		}
+		if !dominated {
+			out = append(out, campaigns[i])
+		}
+	}
+	return out
+}
+
+// NormalizeCampaignScores returns a copy of campaigns with scores min-max rescaled to [0,1] within the set,
+// so campaigns planned for different objectives can be compared. Relative order is preserved; when every
+// score is equal, all normalized scores are 1.
+func NormalizeCampaignScores(campaigns []Campaign) []Campaign {
+	out := make([]Campaign, len(campaigns))
+	copy(out, campaigns)
+	if len(out) == 0 {
+		return out
+	}
+	lo, hi := out[0].Score, out[0].Score
+	for _, c := range out[1:] {
+		lo = math.Min(lo, c.Score)
+		hi = math.Max(hi, c.Score)
+	}
+	for i := range out {
+		if hi == lo {
+			out[i].Score = 1
+			continue
+		}
+		out[i].Score = (out[i].Score - lo) / (hi - lo)
+	}
+	return out
+}
+
+func campaignDominates(a, b Campaign) bool {
+	if a.Risk > b.Risk || a.Impact < b.Impact || a.Confidence < b.Confidence {
+		return false
+	}
+	return a.Risk < b.Risk || a.Impact > b.Impact || a.Confidence > b.Confidence
+}
+
+func cumulativeImpact(classes []ActionClass) float64 {
+	total := 0.0
+	for _, ac := range classes {
+		total += ac.ImpactWeight
+	}
+	return total
+}
+
+// phaseDeadlineViolations sums step durations per phase and returns, in lifecycle order, each phase whose
+// total exceeds its deadline.
+func phaseDeadlineViolations(actions []ActionClass, deadlines []PhaseDeadline) []OperationPhase {
+	if len(deadlines) == 0 {
+		return nil
+	}
+	spent := map[OperationPhase]time.Duration{}
+	for _, ac := range actions {
+		spent[ac.Phase] += ac.Duration
+	}
+	var out []OperationPhase
+	for _, phase := range state.Phases() {
+		for _, d := range deadlines {
+			if d.Phase == phase && spent[phase] > d.Deadline {
+				out = append(out, phase)
+				break
+			}
+		}
+	}
+	return out
 }
 
 func objectiveDistance(actions []ActionClass, objective NodeType) int {
@@ -215,6 +1036,21 @@ func objectiveProximityScore(distance int, action ActionClass, objective NodeTyp
 	return (1 / float64(distance+1)) + supporting
 }
 
+// objectiveBiasFactor scales the objective bias of a campaign that is depth steps long.
+func objectiveBiasFactor(decay ObjectiveBiasDecay, depth, maxDepth int) float64 {
+	switch decay {
+	case ObjectiveBiasDecayRamp:
+		if maxDepth <= 0 {
+			return 1
+		}
+		return math.Min(float64(depth)/float64(maxDepth), 1)
+	case ObjectiveBiasDecayGeometric:
+		return math.Pow(ObjectiveBiasDecayRate, float64(depth-1))
+	default:
+		return 1
+	}
+}
+
 func attackStepForAction(ac ActionClass, idx int) AttackStep {
 	return AttackStep{ActionClassID: ac.ID, Statement: fmt.Sprintf("Action class %s is feasible", ac.Name), Confidence: 0.5 + ac.ConfidenceBoost, Phase: ac.Phase}
 }
@@ -227,6 +1063,31 @@ func hypothesesFromAttackSteps(steps []AttackStep) []Hypothesis {
 	return out
 }
 
+// aggregateCampaignConfidence combines step confidences; non-positive steps zero the geometric mean.
+func aggregateCampaignConfidence(steps []AttackStep, aggregation ConfidenceAggregation) float64 {
+	if len(steps) == 0 {
+		return 0
+	}
+	switch aggregation {
+	case ConfidenceAggregationMin:
+		lowest := steps[0].Confidence
+		for _, step := range steps[1:] {
+			lowest = math.Min(lowest, step.Confidence)
+		}
+		return lowest
+	case ConfidenceAggregationGeometric:
+		logSum := 0.0
+		for _, step := range steps {
+			if step.Confidence <= 0 {
+				return 0
+			}
+			logSum += math.Log(step.Confidence)
+		}
+		return math.Exp(logSum / float64(len(steps)))
+	}
+	return averageCampaignConfidence(steps)
+}
+
 func averageCampaignConfidence(steps []AttackStep) float64 {
 	if len(steps) == 0 {
 		return 0
@@ -247,13 +1108,6 @@ func producesNode(nodes []NodeType, objective NodeType) bool {
 	return false
 }
 
-func campaignPhaseAllowed(root state.OperationPhase, progress []state.OperationPhase, candidate state.OperationPhase) bool {
-	if len(progress) == 0 {
-		return phaseAllowed(root, candidate)
-	}
-	return phaseAllowed(progress[len(progress)-1], candidate)
-}
-
 func nodeTypeIf(ok bool, objective NodeType) NodeType {
 	if ok {
 		return objective
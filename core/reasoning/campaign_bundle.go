@@ -0,0 +1,92 @@
+package reasoning
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"vantage/techniques"
+)
+
+// indicatorBundle is a STIX-like bundle of campaign step indicators and the relationships ordering them.
+type indicatorBundle struct {
+	Type    string            `json:"type"`
+	ID      string            `json:"id"`
+	Objects []json.RawMessage `json:"objects"`
+}
+
+// stepIndicator describes one campaign step as a detection hypothesis.
+type stepIndicator struct {
+	Type            string           `json:"type"`
+	ID              string           `json:"id"`
+	Name            string           `json:"name"`
+	Description     string           `json:"description"`
+	Confidence      int              `json:"confidence"`
+	KillChainPhases []killChainPhase `json:"kill_chain_phases"`
+	ActionClassID   string           `json:"x_vantage_action_class"`
+	Techniques      []string         `json:"x_vantage_techniques"`
+}
+
+type killChainPhase struct {
+	KillChainName string `json:"kill_chain_name"`
+	PhaseName     string `json:"phase_name"`
+}
+
+// stepRelationship links consecutive step indicators in campaign order.
+type stepRelationship struct {
+	Type             string `json:"type"`
+	ID               string `json:"id"`
+	RelationshipType string `json:"relationship_type"`
+	SourceRef        string `json:"source_ref"`
+	TargetRef        string `json:"target_ref"`
+}
+
+// ToIndicatorBundle renders the campaign as a STIX-like JSON bundle: one indicator per step listing the
+// techniques registered for its action class, followed by "precedes" relationships in step order. Indicator
+// IDs hash the campaign, step position, action class and technique IDs, and relationship IDs hash the
+// indicators they join, so the same campaign always yields the same bundle and bundles of different
+// campaigns can be merged without ID collisions.
+func (c Campaign) ToIndicatorBundle() ([]byte, error) {
+	byClass := techniques.ByActionClass()
+	key := campaignKey(c)
+	bundle := indicatorBundle{Type: "bundle", ID: "bundle--" + bundleObjectHash(key), Objects: make([]json.RawMessage, 0, 2*len(c.Steps))}
+	ids := make([]string, len(c.Steps))
+	for i, step := range c.Steps {
+		techniqueIDs := make([]string, 0, len(byClass[step.ActionClassID]))
+		for _, t := range byClass[step.ActionClassID] {
+			techniqueIDs = append(techniqueIDs, t.ID())
+		}
+		ids[i] = "indicator--" + bundleObjectHash(fmt.Sprintf("%s|%d|%s|%v", key, i+1, step.ActionClassID, techniqueIDs))
+		indicator := stepIndicator{
+			Type:            "indicator",
+			ID:              ids[i],
+			Name:            fmt.Sprintf("Step %d: %s", i+1, step.ActionClassID),
+			Description:     step.Statement,
+			Confidence:      int(clamp01(step.Confidence)*100 + 0.5),
+			KillChainPhases: []killChainPhase{{KillChainName: "vantage", PhaseName: strings.ToLower(string(step.Phase))}},
+			ActionClassID:   step.ActionClassID,
+			Techniques:      techniqueIDs,
+		}
+		raw, err := json.Marshal(indicator)
+		if err != nil {
+			return nil, err
+		}
+		bundle.Objects = append(bundle.Objects, raw)
+	}
+	for i := 1; i < len(ids); i++ {
+		raw, err := json.Marshal(stepRelationship{Type: "relationship", ID: "relationship--" + bundleObjectHash(ids[i-1]+">"+ids[i]), RelationshipType: "precedes", SourceRef: ids[i-1], TargetRef: ids[i]})
+		if err != nil {
+			return nil, err
+		}
+		bundle.Objects = append(bundle.Objects, raw)
+	}
+	return json.MarshalIndent(bundle, "", "  ")
+}
+
+// bundleObjectHash returns a stable hex digest of key for use in bundle object IDs.
+func bundleObjectHash(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:8])
+}
@@ -0,0 +1,83 @@
+package reasoning
+
+import (
+	"vantage/core/evidence"
+	"vantage/core/state"
+)
+
+// GraphDelta lists the nodes and edges a cycle added to the graph.
+type GraphDelta struct {
+	AddedNodes []NodeExport
+	AddedEdges []EdgeExport
+}
+
+// CycleTrace is the audit record of one RunCycle. Exposure is the campaign's total exposure knowledge.
+type CycleTrace struct {
+	Decision       *Decision
+	Delta          GraphDelta
+	Artifact       *evidence.Artifact
+	ExecutionError string
+	ExposureBefore float64
+	ExposureAfter  float64
+	CampaignStatus state.Status
+	Executions     uint64
+	before         GraphExport
+}
+
+// begin captures pre-cycle graph contents and exposure; it is a no-op on a nil trace so tracing stays opt-in.
+func (t *CycleTrace) begin(g *Graph, st *state.State) {
+	if t == nil {
+		return
+	}
+	if g != nil {
+		t.before = g.export()
+	}
+	t.ExposureBefore = totalExposure(st)
+}
+
+func (t *CycleTrace) finish(g *Graph, st *state.State, decision *Decision, artifact *evidence.Artifact, execErr error) {
+	if t == nil {
+		return
+	}
+	t.Decision, t.Artifact = decision, artifact
+	if execErr != nil {
+		t.ExecutionError = execErr.Error()
+	}
+	if g != nil {
+		t.Delta = diffGraphExports(t.before, g.export())
+	}
+	t.before = GraphExport{}
+	t.ExposureAfter = totalExposure(st)
+	if st != nil {
+		t.CampaignStatus, t.Executions = st.Status(), st.Executions()
+	}
+}
+
+func diffGraphExports(before, after GraphExport) GraphDelta {
+	known := make(map[string]struct{}, len(before.Nodes))
+	for _, n := range before.Nodes {
+		known[n.ID] = struct{}{}
+	}
+	delta := GraphDelta{AddedNodes: make([]NodeExport, 0), AddedEdges: make([]EdgeExport, 0)}
+	for _, n := range after.Nodes {
+		if _, ok := known[n.ID]; !ok {
+			delta.AddedNodes = append(delta.AddedNodes, n)
+		}
+	}
+	// Edges are append-only within a cycle, so everything past the previous length is new.
+	if len(after.Edges) > len(before.Edges) {
+		delta.AddedEdges = append(delta.AddedEdges, after.Edges[len(before.Edges):]...)
+	}
+	return delta
+}
+
+func totalExposure(st *state.State) float64 {
+	if st == nil {
+		return 0
+	}
+	total := 0.0
+	for _, v := range st.ExposureKnowledge() {
+		total += v
+	}
+	return total
+}
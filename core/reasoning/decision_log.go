@@ -0,0 +1,93 @@
+package reasoning
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+// DecisionLogVersion is the decision log format version written by WriteDecisionLog.
+const DecisionLogVersion = 1
+
+// decisionLogFormat identifies decision logs in their header line.
+const decisionLogFormat = "vantage-decision-log"
+
+// decisionLogHeader is the first line of a decision log.
+type decisionLogHeader struct {
+	Format  string `json:"format"`
+	Version int    `json:"version"`
+}
+
+// decisionRecord is the serialized form of one Decision, one per line after the header.
+type decisionRecord struct {
+	Selected  rankedActionRecord   `json:"selected"`
+	Ranked    []rankedActionRecord `json:"ranked"`
+	CreatedAt time.Time            `json:"created_at"`
+}
+
+// rankedActionRecord is the serialized form of a RankedAction.
+type rankedActionRecord struct {
+	TechniqueID   string  `json:"technique_id"`
+	ActionClassID string  `json:"action_class_id,omitempty"`
+	Target        string  `json:"target"`
+	Score         float64 `json:"score"`
+	Impact        float64 `json:"impact"`
+	Risk          float64 `json:"risk"`
+	Stealth       float64 `json:"stealth"`
+	Reason        string  `json:"reason,omitempty"`
+	// TieBreakReason is absent in logs written before tie-break auditing.
+	TieBreakReason string `json:"tie_break_reason,omitempty"`
+}
+
+// WriteDecisionLog writes decisions as JSON lines behind a versioned header line, in the order given.
+func WriteDecisionLog(w io.Writer, decisions []Decision) error {
+	enc := json.NewEncoder(w)
+	if err := enc.Encode(decisionLogHeader{Format: decisionLogFormat, Version: DecisionLogVersion}); err != nil {
+		return err
+	}
+	for _, d := range decisions {
+		record := decisionRecord{Selected: rankedActionRecord(d.Selected), Ranked: make([]rankedActionRecord, 0, len(d.Ranked)), CreatedAt: d.CreatedAt}
+		for _, ra := range d.Ranked {
+			record.Ranked = append(record.Ranked, rankedActionRecord(ra))
+		}
+		if err := enc.Encode(record); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ReadDecisionLog loads decisions written by WriteDecisionLog, rejecting logs without a recognized header
+// or with a newer format version.
+func ReadDecisionLog(r io.Reader) ([]Decision, error) {
+	dec := json.NewDecoder(r)
+	var header decisionLogHeader
+	if err := dec.Decode(&header); err != nil {
+		return nil, fmt.Errorf("decision log header: %w", err)
+	}
+	if header.Format != decisionLogFormat {
+		return nil, fmt.Errorf("decision log header: unexpected format %q", header.Format)
+	}
+	if header.Version < 1 || header.Version > DecisionLogVersion {
+		return nil, fmt.Errorf("decision log header: unsupported version %d", header.Version)
+	}
+
+	var decisions []Decision
+	for {
+		var record decisionRecord
+		err := dec.Decode(&record)
+		if errors.Is(err, io.EOF) {
+			return decisions, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("decision log record %d: %w", len(decisions)+1, err)
+		}
+		d := Decision{Selected: RankedAction(record.Selected), Ranked: make([]RankedAction, 0, len(record.Ranked)), CreatedAt: record.CreatedAt}
+		for _, ra := range record.Ranked {
+			d.Ranked = append(d.Ranked, RankedAction(ra))
+		}
+		decisions = append(decisions, d)
+	}
+}
@@ -0,0 +1,132 @@
+package reasoning
+
+import (
+	"sort"
+
+	"vantage/core/state"
+)
+
+// PhaseReachability reports which lifecycle phases the bound corpus can attain from the current graph.
+// A phase is reachable when at least one action class in that phase has preconditions satisfiable by
+// the closure of current graph state and everything reachable action classes produce.
+func (e *Engine) PhaseReachability() map[OperationPhase]bool {
+	out := make(map[OperationPhase]bool)
+	for _, phase := range state.Phases() {
+		out[phase] = false
+	}
+	if e == nil {
+		return out
+	}
+
+	e.mu.RLock()
+	snapshot := e.snapshots.get(e.graph)
+	e.mu.RUnlock()
+
+	nodes := map[NodeType]struct{}{}
+	for n, c := range snapshot.nodeCounts {
+		if c > 0 {
+			nodes[n] = struct{}{}
+		}
+	}
+	edges := map[EdgeType]struct{}{}
+	for t, c := range snapshot.edgeCounts {
+		if c > 0 {
+			edges[t] = struct{}{}
+		}
+	}
+
+	classes := e.boundActionClasses()
+	enabled := make(map[string]struct{}, len(classes))
+	for changed := true; changed; {
+		changed = false
+		for _, ac := range classes {
+			if _, ok := enabled[ac.ID]; ok || !preconditionsEligible(ac.Preconditions, nodes, edges) {
+				continue
+			}
+			enabled[ac.ID] = struct{}{}
+			out[ac.Phase] = true
+			for _, n := range ac.ProducesNodes {
+				nodes[n] = struct{}{}
+			}
+			for _, t := range ac.ProducesEdges {
+				edges[t] = struct{}{}
+			}
+			changed = true
+		}
+	}
+	return out
+}
+
+// RejectionReason explains why planning discarded an action-class candidate.
+type RejectionReason string
+
+const (
+	RejectionPhaseDisallowed          RejectionReason = "phase_disallowed"
+	RejectionPreconditionUnmet        RejectionReason = "precondition_unmet"
+	RejectionRiskOverTolerance        RejectionReason = "risk_over_tolerance"
+	RejectionStepRiskOverLimit        RejectionReason = "step_risk_over_limit"
+	RejectionROEDenied                RejectionReason = "roe_denied"
+	RejectionROEPolicyPanic           RejectionReason = "roe_policy_panic"
+	RejectionConfidenceBelowThreshold RejectionReason = "confidence_below_threshold"
+	RejectionFeasibilityRegressed     RejectionReason = "feasibility_regressed"
+	RejectionAlreadyExecuted          RejectionReason = "already_executed"
+	RejectionRepeatedStep             RejectionReason = "repeated_step"
+	RejectionObjectiveUnreached       RejectionReason = "objective_unreached"
+	RejectionInsufficientEvidence     RejectionReason = "insufficient_evidence"
+)
+
+// Rejection counts how often an action class was discarded for one reason during a planning run.
+type Rejection struct {
+	ActionClassID string
+	Reason        RejectionReason
+	Count         int
+}
+
+// PlanningReport attributes discarded action-class candidates to the reason they were rejected.
+type PlanningReport struct {
+	Rejections []Rejection
+	index      map[string]int
+}
+
+func newPlanningReport() *PlanningReport {
+	return &PlanningReport{index: map[string]int{}}
+}
+
+// reject records one rejection; it is a no-op on a nil report so diagnostics stay opt-in.
+func (r *PlanningReport) reject(actionClassID string, reason RejectionReason) {
+	if r == nil {
+		return
+	}
+	key := actionClassID + "|" + string(reason)
+	if i, ok := r.index[key]; ok {
+		r.Rejections[i].Count++
+		return
+	}
+	r.index[key] = len(r.Rejections)
+	r.Rejections = append(r.Rejections, Rejection{ActionClassID: actionClassID, Reason: reason, Count: 1})
+}
+
+func (r *PlanningReport) finalize() *PlanningReport {
+	sort.Slice(r.Rejections, func(i, j int) bool {
+		if r.Rejections[i].ActionClassID == r.Rejections[j].ActionClassID {
+			return r.Rejections[i].Reason < r.Rejections[j].Reason
+		}
+		return r.Rejections[i].ActionClassID < r.Rejections[j].ActionClassID
+	})
+	r.index = nil
+	return r
+}
+
+// Reasons returns the distinct rejection reasons recorded for an action class.
+func (r *PlanningReport) Reasons(actionClassID string) []RejectionReason {
+	if r == nil {
+		return nil
+	}
+	out := make([]RejectionReason, 0)
+	for _, rej := range r.Rejections {
+		if rej.ActionClassID == actionClassID {
+			out = append(out, rej.Reason)
+		}
+	}
+	return out
+}
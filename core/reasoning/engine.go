@@ -2,13 +2,18 @@ package reasoning
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"math"
+	"slices"
 	"sort"
+	"strings"
 	"sync"
 	"time"
 
 	"vantage/core/evidence"
 	"vantage/core/state"
+	"vantage/techniques"
 )
 
 // Decision is the structured planning output for executor consumption.
@@ -24,11 +29,56 @@ type Engine struct {
 	graph            *Graph
 	registry         *effectRegistry
 	planner          *Planner
-	expander         HypothesisExpander
+	expanders        []weightedExpander
 	actionBinder     ActionBinder
 	state            *state.State
 	cycle            CycleConfig
 	attackPathConfig AttackPathConfig
+	// lastPlanSignature identifies the inputs of the most recent campaign plan.
+	lastPlanSignature string
+	// snapshots memoizes the graph snapshot shared by planning calls between graph mutations.
+	snapshots snapshotCache
+	// cycles records the most recent RunCycle outcomes for stagnation detection.
+	cycles []cycleRecord
+	// evidenceLabel formats the label of evidence nodes created by IngestEvidence.
+	evidenceLabel func(EvidenceEvent) string
+	// selection picks the executed action from the ranking; nil means TopRankedPolicy.
+	selection SelectionPolicy
+	// unlockCacheSize caps the per-plan unlock-count memo; non-positive means unbounded.
+	unlockCacheSize int
+	// sinceObjectiveProgress counts RunCycle calls since one introduced an objective-advancing node type.
+	sinceObjectiveProgress int
+	// roePolicyPanics counts ROE policy invocations that panicked and were treated as denials.
+	roePolicyPanics int
+	// exposure feeds the risk penalty PlanNextAction applies as the halt budget runs out; nil disables it.
+	exposure ExposureGauge
+	// hypothesisStatus records the evidence verdict per action class and target.
+	hypothesisStatus map[hypothesisKey]hypothesisVerdict
+	// minEvidenceForImpact is how many corroborating evidence observations must exist before impact-phase
+	// action classes may be planned; non-positive disables the gate.
+	minEvidenceForImpact int
+	// objectiveScoreScale multiplies campaign scores per objective node type; missing types scale by 1.
+	objectiveScoreScale map[NodeType]float64
+	// consolidateAbove is the node count past which RunCycle consolidates duplicate evidence before planning;
+	// non-positive disables consolidation.
+	consolidateAbove int
+	// allowRedundant lets RunCycle execute selections that cannot change the graph.
+	allowRedundant bool
+}
+
+// cycleRecord summarizes one RunCycle: the technique it selected and how many node types it introduced.
+type cycleRecord struct {
+	techniqueID  string
+	newNodeTypes int
+}
+
+// maxCycleHistory bounds the RunCycle history kept for stagnation detection.
+const maxCycleHistory = 256
+
+// weightedExpander scales an expander's hypothesis confidences by its ensemble weight.
+type weightedExpander struct {
+	expander HypothesisExpander
+	weight   float64
 }
 
 // TechniqueExecutor executes a selected technique against a target.
@@ -44,24 +94,164 @@ type CycleConfig struct {
 	Timeout           time.Duration
 }
 
-// NewEngine constructs a reasoning engine with default technique effects.
+// NewEngine constructs a reasoning engine with technique effects derived from each registered technique.
 func NewEngine(expander HypothesisExpander) *Engine {
 	registry := newEffectRegistry()
+	for _, effect := range deriveTechniqueEffects() {
+		registry.RegisterTechniqueEffect(effect)
+	}
 	planner := NewPlanner(registry, DefaultTechniqueScoreWeights())
 	binder := NewDefaultActionBinder()
 	if classes, err := LoadActionClassesFromDir("action-classes-normalized"); err == nil {
 		binder.BindActionClasses(classes)
 	}
-	return &Engine{
+	e := &Engine{
 		graph:            NewGraph(),
 		registry:         registry,
 		planner:          planner,
-		expander:         expander,
 		actionBinder:     binder,
 		attackPathConfig: DefaultAttackPathConfig(),
+		unlockCacheSize:  DefaultUnlockCacheSize,
+	}
+	if expander != nil {
+		e.expanders = append(e.expanders, weightedExpander{expander: expander, weight: 1.0})
+	}
+	return e
+}
+
+// AddExpander registers an additional hypothesis expander whose confidences are scaled by weight.
+// Scaled confidences are capped at 1.0; non-positive weights are ignored.
+func (e *Engine) AddExpander(exp HypothesisExpander, weight float64) {
+	if exp == nil || weight <= 0 {
+		return
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.expanders = append(e.expanders, weightedExpander{expander: exp, weight: weight})
+}
+
+// SetSelectionPolicy overrides how PlanNextAction chooses among ranked actions; nil restores TopRankedPolicy.
+// A pinned technique still takes precedence over the policy.
+func (e *Engine) SetSelectionPolicy(policy SelectionPolicy) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.selection = policy
+}
+
+// SetExposureGauge lets PlanNextAction penalize risky actions in proportion to how much of the exposure
+// budget is spent; nil disables the penalty.
+func (e *Engine) SetExposureGauge(gauge ExposureGauge) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.exposure = gauge
+}
+
+// SetMinEvidenceForImpact keeps objective and exfiltration phase action classes out of PlanNextAction
+// rankings and planned campaigns until the graph holds at least n corroborating evidence nodes, that is
+// evidence not recorded as a failed execution. Non-positive n disables the gate.
+func (e *Engine) SetMinEvidenceForImpact(n int) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.minEvidenceForImpact = n
+}
+
+// SetObjectiveScoreNormalization scales campaign scores by a per-objective factor so plans for objectives
+// with systematically different score magnitudes can be compared directly. Objectives without a factor
+// keep their raw scores; nil clears every factor. Factors must be positive and finite.
+func (e *Engine) SetObjectiveScoreNormalization(factors map[NodeType]float64) error {
+	scale := make(map[NodeType]float64, len(factors))
+	for objective, factor := range factors {
+		if factor <= 0 || math.IsInf(factor, 0) || math.IsNaN(factor) {
+			return fmt.Errorf("objective %q score factor must be positive and finite, got %v", objective, factor)
+		}
+		scale[objective] = factor
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.objectiveScoreScale = scale
+	return nil
+}
+
+// SetGraphConsolidationThreshold makes RunCycle merge duplicate evidence nodes (see Graph.ConsolidateEvidence)
+// before planning whenever the graph holds more than maxNodes nodes, so per-cycle snapshotting stays cheap
+// on long runs. Non-positive maxNodes disables consolidation.
+func (e *Engine) SetGraphConsolidationThreshold(maxNodes int) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.consolidateAbove = maxNodes
+}
+
+// SetAllowRedundantCycles opts RunCycle into executing selections it would otherwise skip with ErrNoProgress.
+func (e *Engine) SetAllowRedundantCycles(allow bool) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.allowRedundant = allow
+}
+
+// impactGated reports whether impact-phase action classes are still held back for lack of evidence.
+// Callers must hold e.mu.
+func (e *Engine) impactGated() bool {
+	return e.minEvidenceForImpact > 0 && corroboratingEvidenceCount(e.graph) < e.minEvidenceForImpact
+}
+
+// corroboratingEvidenceCount counts observations behind evidence nodes that do not record a failed
+// execution. Consolidated nodes count by their merged weight, so ConsolidateEvidence cannot re-close the
+// impact gate.
+func corroboratingEvidenceCount(g *Graph) int {
+	if g == nil {
+		return 0
+	}
+	count := 0
+	for _, n := range g.NodesByType(NodeTypeEvidence) {
+		if !strings.EqualFold(n.Metadata["success"], "false") {
+			count += evidenceWeight(n)
+		}
+	}
+	return count
+}
+
+// isImpactPhase reports whether a phase acts on the objective itself rather than building toward it.
+func isImpactPhase(phase OperationPhase) bool {
+	return phase == state.PhaseObjective || phase == state.PhaseExfil
+}
+
+// UnboundClassDiscount is the fraction of its score magnitude an action whose action class is not bound
+// keeps: the score drops by (1-UnboundClassDiscount)*|score|, so negative scores fall further too. RunCycle
+// can only apply a bound class's state transition, so such actions would fall back to plain evidence
+// ingestion.
+const UnboundClassDiscount = 0.5
+
+// discountUnboundActions down-weights ranked actions whose action class the binder does not hold. It is a
+// no-op when no classes are bound, since every action would then be discounted alike.
+func discountUnboundActions(ranked []RankedAction, binder *DefaultActionBinder) {
+	if binder == nil || len(binder.Classes()) == 0 {
+		return
+	}
+	discounted := false
+	for i := range ranked {
+		if _, ok := binder.ActionClass(ranked[i].ActionClassID); !ok {
+			ranked[i].Score -= math.Abs(ranked[i].Score) * (1 - UnboundClassDiscount)
+			ranked[i].Reason = fmt.Sprintf("%s action_class=unbound", ranked[i].Reason)
+			discounted = true
+		}
+	}
+	if discounted {
+		sortRanked(ranked)
 	}
 }
 
+// withoutImpactActions drops ranked actions bound to impact-phase action classes.
+func withoutImpactActions(ranked []RankedAction, binder *DefaultActionBinder) []RankedAction {
+	kept := ranked[:0]
+	for _, ra := range ranked {
+		if ac, ok := binder.ActionClass(ra.ActionClassID); ok && isImpactPhase(ac.Phase) {
+			continue
+		}
+		kept = append(kept, ra)
+	}
+	return kept
+}
+
 // Graph returns the underlying operational graph.
 func (e *Engine) Graph() *Graph {
 	e.mu.RLock()
@@ -76,11 +266,70 @@ func (e *Engine) BindActionClasses(classes []ActionClass) {
 	}
 }
 
+// UpdateActionClass upserts one action class by ID into the bound set without re-supplying the rest, and
+// forgets the last plan signature so ReplanIncremental cannot reuse campaigns planned with the old class.
+// It fails when ac has no ID or the engine's binder cannot update classes individually.
+func (e *Engine) UpdateActionClass(ac ActionClass) error {
+	if ac.ID == "" {
+		return errors.New("update action class: missing id")
+	}
+	binder, ok := e.actionBinder.(*DefaultActionBinder)
+	if !ok {
+		return fmt.Errorf("update action class %s: action binder %T does not support updates", ac.ID, e.actionBinder)
+	}
+	binder.UpsertActionClass(ac)
+	e.mu.Lock()
+	e.lastPlanSignature = ""
+	e.mu.Unlock()
+	return nil
+}
+
+// ActionClasses returns the bound action classes sorted by ID.
+func (e *Engine) ActionClasses() []ActionClass {
+	classes := e.boundActionClasses()
+	sort.Slice(classes, func(i, j int) bool { return classes[i].ID < classes[j].ID })
+	return classes
+}
+
+// ActionClassProduction returns the node types then edge types the bound action class id produces, or nil
+// when it is not bound. It satisfies techniques.ProductionLookup.
+func (e *Engine) ActionClassProduction(id string) []string {
+	for _, ac := range e.boundActionClasses() {
+		if ac.ID != id {
+			continue
+		}
+		out := make([]string, 0, len(ac.ProducesNodes)+len(ac.ProducesEdges))
+		for _, t := range ac.ProducesNodes {
+			out = append(out, string(t))
+		}
+		for _, t := range ac.ProducesEdges {
+			out = append(out, string(t))
+		}
+		return out
+	}
+	return nil
+}
+
 // RegisterTechniqueEffect registers or updates effect metadata for a technique.
 func (e *Engine) RegisterTechniqueEffect(effect TechniqueEffect) {
 	e.registry.RegisterTechniqueEffect(effect)
 }
 
+// SetTechniqueScoreWeights replaces the weights the planner ranks techniques with.
+func (e *Engine) SetTechniqueScoreWeights(weights TechniqueScoreWeights) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.planner.SetWeights(weights)
+}
+
+// SetTechniquePreference replaces the policy that picks which admissible technique runs when a hypothesis
+// action class resolves to several; the zero value prefers lower-risk techniques.
+func (e *Engine) SetTechniquePreference(policy techniques.PreferencePolicy) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.planner.SetPreferencePolicy(policy)
+}
+
 // EffectForTechnique returns effect metadata for a technique.
 func (e *Engine) EffectForTechnique(techniqueID string) (TechniqueEffect, bool) {
 	return e.registry.EffectForTechnique(techniqueID)
@@ -91,28 +340,82 @@ func (e *Engine) KnownTechniques() []string {
 	return e.registry.KnownTechniques()
 }
 
+// DefaultEvidenceLabel is the default evidence node label, "{technique}@{target}".
+func DefaultEvidenceLabel(event EvidenceEvent) string {
+	return fmt.Sprintf("%s@%s", event.TechniqueID, event.Target)
+}
+
+// SetEvidenceLabeler overrides how IngestEvidence labels evidence nodes; nil restores DefaultEvidenceLabel.
+func (e *Engine) SetEvidenceLabeler(fn func(EvidenceEvent) string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.evidenceLabel = fn
+}
+
 // IngestEvidence updates graph state from executor evidence.
 func (e *Engine) IngestEvidence(event EvidenceEvent) error {
 	if event.TechniqueID == "" || event.Target == "" {
 		return fmt.Errorf("evidence event missing technique or target")
 	}
+	e.mu.RLock()
+	label := e.evidenceLabel
+	e.mu.RUnlock()
+	if label == nil {
+		label = DefaultEvidenceLabel
+	}
 	nodeID := fmt.Sprintf("ev-%d-%s", time.Now().UTC().UnixNano(), event.TechniqueID)
 	e.graph.UpsertNode(&Node{
 		ID:    nodeID,
 		Type:  NodeTypeEvidence,
-		Label: fmt.Sprintf("%s@%s", event.TechniqueID, event.Target),
+		Label: label(event),
 		Metadata: map[string]string{
-			"success": fmt.Sprintf("%t", event.Success),
-			"target":  event.Target,
+			"success":   fmt.Sprintf("%t", event.Success),
+			"target":    event.Target,
+			"technique": event.TechniqueID,
 		},
 	})
+	e.recordHypothesisStatus(event)
 	return nil
 }
 
+// IngestFrom polls an evidence source until it is exhausted, ingesting every event.
+// It returns the number of events ingested before exhaustion, cancellation, or error.
+func (e *Engine) IngestFrom(ctx context.Context, source EvidenceSource) (int, error) {
+	if source == nil {
+		return 0, fmt.Errorf("evidence source is nil")
+	}
+	ingested := 0
+	for {
+		if err := ctx.Err(); err != nil {
+			return ingested, err
+		}
+		event, ok, err := source.Next(ctx)
+		if err != nil {
+			return ingested, err
+		}
+		if !ok {
+			return ingested, nil
+		}
+		if err := e.IngestEvidence(event); err != nil {
+			return ingested, err
+		}
+		ingested++
+	}
+}
+
 // GenerateHypotheses creates deterministic hypotheses from evidence and action-class matching.
 // Action classes act as graph rules: when phase and preconditions match, the engine emits
-// deterministic hypotheses anchored to the matching action class IDs.
+// deterministic hypotheses anchored to the matching action class IDs. Statuses are those recorded
+// against the configured cycle target.
 func (e *Engine) GenerateHypotheses() []Hypothesis {
+	e.mu.RLock()
+	target := e.cycle.Target
+	e.mu.RUnlock()
+	return e.generateHypotheses(target)
+}
+
+// generateHypotheses is GenerateHypotheses with statuses taken from target.
+func (e *Engine) generateHypotheses(target string) []Hypothesis {
 	hypotheses := GenerateHypotheses(e.graph)
 	if e.actionBinder != nil {
 		matched, err := e.actionBinder.MatchAndGenerate(e.graph, e.state)
@@ -120,32 +423,59 @@ func (e *Engine) GenerateHypotheses() []Hypothesis {
 			hypotheses = append(hypotheses, matched...)
 		}
 	}
+	propagateSupportConfidence(e.graph, hypotheses)
+	applyHypothesisStatus(hypotheses, e.hypothesisStatuses(target))
 	return hypotheses
 }
 
 // PlanNextAction runs hypothesis generation, scoring, and action selection.
 func (e *Engine) PlanNextAction(query PlannerQuery) (*Decision, error) {
-	hypotheses := e.GenerateHypotheses()
-	if e.expander != nil {
-		aiHypotheses, err := e.expander.Expand(e.graph, e.state)
-		if err == nil {
-			hypotheses = append(hypotheses, aiHypotheses...)
+	if query.Phase == "" {
+		e.mu.RLock()
+		query.Phase = phaseForState(e.state)
+		e.mu.RUnlock()
+	}
+	hypotheses := e.generateHypotheses(query.Target)
+	statuses := e.hypothesisStatuses(query.Target)
+	e.mu.RLock()
+	expanders := append([]weightedExpander(nil), e.expanders...)
+	e.mu.RUnlock()
+	for _, we := range expanders {
+		expanded, err := we.expander.Expand(e.graph, e.state)
+		if err != nil {
+			continue
+		}
+		applyHypothesisStatus(expanded, statuses)
+		for _, h := range expanded {
+			h.Confidence = math.Min(h.Confidence*we.weight, 1.0)
+			hypotheses = append(hypotheses, h)
 		}
 	}
-	for _, h := range hypotheses {
-		e.graph.UpsertNode(&Node{ID: h.ID, Type: NodeTypeHypothesis, Label: h.Statement, Metadata: map[string]string{"confidence": fmt.Sprintf("%.2f", h.Confidence), "action_class": h.ActionClassID}})
+	// Merge order varies with action-class iteration, so graph mutations walk hypotheses by ID to keep
+	// edge insertion order, and therefore DOT output, byte-stable.
+	byID := append([]Hypothesis(nil), hypotheses...)
+	sort.SliceStable(byID, func(i, j int) bool { return byID[i].ID < byID[j].ID })
+	for _, h := range byID {
+		e.graph.UpsertNode(&Node{ID: h.ID, Type: NodeTypeHypothesis, Label: h.Statement, Metadata: map[string]string{"confidence": fmt.Sprintf("%.2f", h.Confidence), "action_class": h.ActionClassID, "status": string(h.Status)}})
 		for _, support := range h.SupportingNodeIDs {
 			_ = e.graph.AddEdge(&Edge{From: support, To: h.ID, Type: EdgeTypeSupports, Weight: h.Confidence})
 		}
 	}
 
+	e.mu.RLock()
+	gated := e.impactGated()
+	e.mu.RUnlock()
 	var ranked []RankedAction
-	if binder, ok := e.actionBinder.(*DefaultActionBinder); ok {
+	binder, hasBinder := e.actionBinder.(*DefaultActionBinder)
+	if hasBinder {
 		ranked = e.planner.RankedActionsForHypotheses(e.graph, query.Target, hypotheses, binder.ActionClass, query.TopN)
 	}
 	if len(ranked) == 0 {
 		ranked = e.planner.RankedActions(query)
 	}
+	if gated && hasBinder {
+		ranked = withoutImpactActions(ranked, binder)
+	}
 	if e.state != nil {
 		phase := phaseForState(e.state)
 		if phase == state.PhaseLateralMovement || phase == state.PhaseObjective || phase == state.PhaseC2 {
@@ -155,28 +485,151 @@ func (e *Engine) PlanNextAction(query PlannerQuery) (*Decision, error) {
 		}
 	}
 
+	if query.PreferPathEnabling != "" {
+		if campaigns, err := e.planCampaign(query.PreferPathEnabling, DefaultCampaignOptions(), nil, nil, false); err == nil && len(campaigns) > 0 && len(campaigns[0].Steps) > 0 {
+			boostPathEnablingActions(ranked, campaigns[0].Steps[0].ActionClassID)
+		}
+	}
+
 	if e.state != nil {
 		applyStateMemoryAdjustments(ranked, e.state)
 	}
+	discountRefutedActions(ranked, statuses)
+	if hasBinder {
+		discountUnboundActions(ranked, binder)
+	}
+	e.mu.RLock()
+	policy := e.selection
+	gauge := e.exposure
+	e.mu.RUnlock()
+	applyExposurePressure(ranked, gauge)
 	if len(ranked) == 0 {
 		return nil, fmt.Errorf("no ranked actions available")
 	}
-	decision := &Decision{Selected: ranked[0], Ranked: ranked, CreatedAt: time.Now().UTC()}
+	if policy == nil {
+		policy = TopRankedPolicy{}
+	}
+	selected := policy.Select(ranked)
+	if !slices.ContainsFunc(ranked, func(ra RankedAction) bool {
+		return ra.TechniqueID == selected.TechniqueID && ra.ActionClassID == selected.ActionClassID
+	}) {
+		return nil, fmt.Errorf("selection policy chose %q, which is not in the ranking", selected.TechniqueID)
+	}
+	if query.PinnedTechniqueID != "" {
+		pinned, err := e.pinnedAction(query, ranked, gated)
+		if err != nil {
+			return nil, err
+		}
+		selected = pinned
+	}
+	decision := &Decision{Selected: selected, Ranked: ranked, CreatedAt: time.Now().UTC()}
 
 	selectedNodeID := fmt.Sprintf("tech-%s", decision.Selected.TechniqueID)
-	e.graph.UpsertNode(&Node{ID: selectedNodeID, Type: NodeTypeTechnique, Label: decision.Selected.TechniqueID})
-	for _, h := range hypotheses {
+	var selectedMeta map[string]string
+	if decision.Selected.ActionClassID != "" {
+		selectedMeta = map[string]string{"action_class": decision.Selected.ActionClassID}
+	}
+	e.graph.UpsertNode(&Node{ID: selectedNodeID, Type: NodeTypeTechnique, Label: decision.Selected.TechniqueID, Metadata: selectedMeta})
+	for _, h := range byID {
 		_ = e.graph.AddEdge(&Edge{From: h.ID, To: selectedNodeID, Type: EdgeTypeEnables, Weight: h.Confidence})
 	}
 
 	return decision, nil
 }
 
+// PinRejectedError reports a pinned technique that cannot be selected for a cycle.
+type PinRejectedError struct {
+	TechniqueID string
+	Reason      string
+}
+
+func (e *PinRejectedError) Error() string {
+	return fmt.Sprintf("pinned technique %s rejected: %s", e.TechniqueID, e.Reason)
+}
+
+// pinnedAction resolves query.PinnedTechniqueID under the same governance as ranked actions: the pin must be
+// an allowed technique, its bound action class must pass the ROE policy, and impact-phase classes stay
+// gated until enough corroborating evidence exists. A pin the ranking truncated away is scored afresh and
+// discounted like any other action whose class is unbound.
+func (e *Engine) pinnedAction(query PlannerQuery, ranked []RankedAction, gated bool) (RankedAction, error) {
+	id := query.PinnedTechniqueID
+	if len(query.AllowedTechniques) > 0 && !containsString(query.AllowedTechniques, id) {
+		return RankedAction{}, &PinRejectedError{TechniqueID: id, Reason: "not in allowed techniques"}
+	}
+	pinned, found := RankedAction{}, false
+	for _, ra := range ranked {
+		if ra.TechniqueID == id {
+			pinned, found = ra, true
+			break
+		}
+	}
+	binder, hasBinder := e.actionBinder.(*DefaultActionBinder)
+	if !found {
+		scored := e.planner.RankedActions(PlannerQuery{Target: query.Target, AllowedTechniques: []string{id}, TopN: 1, Phase: query.Phase})
+		if len(scored) == 0 {
+			return RankedAction{}, &PinRejectedError{TechniqueID: id, Reason: "unknown technique"}
+		}
+		if hasBinder {
+			discountUnboundActions(scored, binder)
+		}
+		pinned = scored[0]
+	}
+	if hasBinder {
+		if ac, ok := binder.ActionClass(pinned.ActionClassID); ok {
+			if gated && isImpactPhase(ac.Phase) {
+				return RankedAction{}, &PinRejectedError{TechniqueID: id, Reason: "impact phase gated pending corroborating evidence"}
+			}
+			e.mu.RLock()
+			policy := e.attackPathConfig.ROEPolicy
+			st := e.state
+			e.mu.RUnlock()
+			if policy != nil {
+				if allowed, _ := e.roeAllows(policy, ac, st); !allowed {
+					return RankedAction{}, &PinRejectedError{TechniqueID: id, Reason: "denied by roe policy"}
+				}
+			}
+		}
+	}
+	pinned.Reason = fmt.Sprintf("%s pinned=true", pinned.Reason)
+	return pinned, nil
+}
+
+func containsString(values []string, want string) bool {
+	for _, v := range values {
+		if v == want {
+			return true
+		}
+	}
+	return false
+}
+
 // DOT returns Graphviz DOT output for the current reasoning graph.
 func (e *Engine) DOT() string {
 	return e.graph.ToDOT()
 }
 
+// DOTForPhase returns Graphviz DOT output for the part of the graph relevant to phase: technique nodes whose
+// action class belongs to phase, plus the evidence and hypothesis nodes directly connected to them.
+func (e *Engine) DOTForPhase(phase OperationPhase) string {
+	phases := make(map[string]OperationPhase)
+	for _, ac := range e.boundActionClasses() {
+		phases[ac.ID] = ac.Phase
+	}
+	inPhase := func(n *Node) bool {
+		if n.Type != NodeTypeTechnique {
+			return false
+		}
+		classID := n.Metadata["action_class"]
+		if classID == "" {
+			effect, _ := e.registry.EffectForTechnique(n.Label)
+			classID = effect.ActionClassID
+		}
+		p, ok := phases[classID]
+		return ok && p == phase
+	}
+	return e.graph.neighbourhoodDOT(inPhase, NodeTypeEvidence, NodeTypeHypothesis)
+}
+
 // ConfigureCycle configures runtime dependencies for RunCycle.
 func (e *Engine) ConfigureCycle(cfg CycleConfig) {
 	e.mu.Lock()
@@ -184,11 +637,65 @@ func (e *Engine) ConfigureCycle(cfg CycleConfig) {
 	e.cycle = cfg
 }
 
+// ErrNoProgress reports a RunCycle selection that was not executed because its action class has already
+// produced every node type it declares against the cycle target, so running it again could add nothing new.
+var ErrNoProgress = errors.New("selected action would make no progress")
+
+// producesNothingNew reports whether running ac against target would only repeat outputs the graph already
+// holds: every node type ac declares must already be present and already attributed to ac for target by a
+// prior ApplyAction. Type presence alone is not enough, since many classes share the same output types.
+// Classes that declare no outputs are never considered redundant.
+func producesNothingNew(graph *Graph, snapshot *graphSnapshot, ac ActionClass, target string) bool {
+	if graph == nil || snapshot == nil || len(ac.ProducesNodes)+len(ac.ProducesEdges) == 0 {
+		return false
+	}
+	for _, n := range ac.ProducesNodes {
+		if snapshot.nodeCounts[n] == 0 {
+			return false
+		}
+	}
+	for _, t := range ac.ProducesEdges {
+		if snapshot.edgeCounts[t] == 0 {
+			return false
+		}
+	}
+	for _, n := range ac.ProducesNodes {
+		produced := false
+		for _, node := range graph.NodesByType(n) {
+			if node.Metadata["action_class"] == ac.ID && node.Metadata["target"] == target {
+				produced = true
+				break
+			}
+		}
+		if !produced {
+			return false
+		}
+	}
+	return true
+}
+
 // RunCycle executes one deterministic reasoning + execution cycle.
 func (e *Engine) RunCycle(state *state.State) (*Decision, error) {
+	return e.runCycle(state, nil)
+}
+
+// RunCycleTraced runs a cycle like RunCycle and also returns its audit trace. The trace is nil only when
+// the cycle fails before an action is selected.
+func (e *Engine) RunCycleTraced(state *state.State) (*Decision, *CycleTrace, error) {
+	trace := &CycleTrace{}
+	decision, err := e.runCycle(state, trace)
+	if decision == nil {
+		return nil, nil, err
+	}
+	return decision, trace, err
+}
+
+func (e *Engine) runCycle(state *state.State, trace *CycleTrace) (*Decision, error) {
 	e.mu.Lock()
 	e.state = state
 	cfg := e.cycle
+	consolidateAbove := e.consolidateAbove
+	allowRedundant := e.allowRedundant
 	e.mu.Unlock()
 
 	if cfg.Target == "" {
@@ -197,7 +704,11 @@ func (e *Engine) RunCycle(state *state.State) (*Decision, error) {
 	if cfg.Executor == nil {
 		return nil, fmt.Errorf("run cycle executor is required")
 	}
+	if consolidateAbove > 0 && e.graph.NodeCount() > consolidateAbove {
+		e.graph.ConsolidateEvidence()
+	}
 
+	trace.begin(e.graph, state)
 	decision, err := e.PlanNextAction(PlannerQuery{
 		Target:            cfg.Target,
 		AllowedTechniques: cfg.AllowedTechniques,
@@ -206,6 +717,15 @@ func (e *Engine) RunCycle(state *state.State) (*Decision, error) {
 	if err != nil {
 		return nil, err
 	}
+	if !allowRedundant {
+		if binder, ok := e.actionBinder.(*DefaultActionBinder); ok {
+			if ac, found := binder.ActionClass(decision.Selected.ActionClassID); found && producesNothingNew(e.graph, e.snapshots.get(e.graph), ac, cfg.Target) {
+				err := fmt.Errorf("%w: %s (action class %s) already produced its outputs for %s", ErrNoProgress, decision.Selected.TechniqueID, ac.ID, cfg.Target)
+				trace.finish(e.graph, state, decision, nil, err)
+				return decision, err
+			}
+		}
+	}
 
 	timeout := cfg.Timeout
 	if timeout <= 0 {
@@ -214,13 +734,23 @@ func (e *Engine) RunCycle(state *state.State) (*Decision, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), timeout)
 	defer cancel()
 
+	before := e.snapshots.get(e.graph)
 	artifact, execErr := cfg.Executor.Run(ctx, decision.Selected.TechniqueID, cfg.Target)
+	// The tie-break rule is sealed into signed evidence so an audit can defend why this technique ran.
+	var sealErr error
+	if artifact != nil && artifact.Integrity != "" && decision.Selected.TieBreakReason != "" {
+		if err := artifact.Reseal(map[string]string{"tie_break_reason": decision.Selected.TieBreakReason}); err != nil {
+			sealErr = fmt.Errorf("seal tie-break reason: %w", err)
+		}
+	}
 	if artifact != nil {
 		event := EvidenceEvent{TechniqueID: artifact.TechniqueID, Target: artifact.Target, Success: artifact.Success, Output: artifact.Output, Artifact: artifact}
 		applied := false
 		if binder, ok := e.actionBinder.(*DefaultActionBinder); ok && decision.Selected.ActionClassID != "" {
 			if ac, found := binder.ActionClass(decision.Selected.ActionClassID); found {
 				if err := e.actionBinder.ApplyAction(e.graph, ac, event); err == nil {
+					// ApplyAction replaces IngestEvidence here, so the outcome still has to reach the hypothesis.
+					e.recordHypothesisStatus(event)
 					applied = true
 				}
 			}
@@ -229,6 +759,9 @@ func (e *Engine) RunCycle(state *state.State) (*Decision, error) {
 			_ = e.IngestEvidence(event)
 		}
 	}
+	// Evidence behind an attained objective is audit-relevant and must survive node pruning.
+	e.graph.PinObjectivePaths(objectiveNodeTypes())
+	e.recordCycle(decision.Selected.TechniqueID, before, e.snapshots.get(e.graph))
 
 	if e.state != nil {
 		reconLike := false
@@ -237,10 +770,105 @@ func (e *Engine) RunCycle(state *state.State) (*Decision, error) {
 		}
 		e.state.RecordActionMemory(decision.Selected.ActionClassID, execErr == nil, reconLike)
 	}
+	trace.finish(e.graph, state, decision, artifact, execErr)
 	if execErr != nil {
 		return decision, execErr
 	}
-	return decision, nil
+	return decision, sealErr
+}
+
+func (e *Engine) recordCycle(techniqueID string, before, after *graphSnapshot) {
+	e.mu.RLock()
+	objectives := append([]NodeType(nil), e.attackPathConfig.ObjectiveNodeTypes...)
+	e.mu.RUnlock()
+	advancing := objectiveAdvancingTypes(e.boundActionClasses(), objectives)
+
+	// Any new node of an advancing type is progress, except evidence: every executed cycle records some, so
+	// it only counts when it first appears.
+	introduced, advanced := 0, false
+	for n, c := range after.nodeCounts {
+		isNew := c > 0 && !before.hasNodeType(n)
+		if isNew {
+			introduced++
+		}
+		if _, ok := advancing[n]; ok && (isNew || (n != NodeTypeEvidence && c > before.nodeCount(n))) {
+			advanced = true
+		}
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.cycles = append(e.cycles, cycleRecord{techniqueID: techniqueID, newNodeTypes: introduced})
+	if len(e.cycles) > maxCycleHistory {
+		e.cycles = e.cycles[len(e.cycles)-maxCycleHistory:]
+	}
+	if advanced {
+		e.sinceObjectiveProgress = 0
+	} else {
+		e.sinceObjectiveProgress++
+	}
+}
+
+// CyclesSinceObjectiveProgress returns how many RunCycle calls have passed since a cycle last added a node
+// of a type that advances toward a configured objective node type.
+func (e *Engine) CyclesSinceObjectiveProgress() int {
+	if e == nil {
+		return 0
+	}
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return e.sinceObjectiveProgress
+}
+
+// objectiveAdvancingTypes returns the objective node types plus every node type required, directly or
+// transitively, by an action class that produces one of them.
+func objectiveAdvancingTypes(classes []ActionClass, objectives []NodeType) map[NodeType]struct{} {
+	advancing := make(map[NodeType]struct{}, len(objectives))
+	for _, n := range objectives {
+		advancing[n] = struct{}{}
+	}
+	for changed := true; changed; {
+		changed = false
+		for _, ac := range classes {
+			leads := false
+			for _, n := range ac.ProducesNodes {
+				if _, ok := advancing[n]; ok {
+					leads = true
+					break
+				}
+			}
+			if !leads {
+				continue
+			}
+			for _, n := range requiredNodes(ac.Preconditions) {
+				if _, ok := advancing[n]; !ok {
+					advancing[n] = struct{}{}
+					changed = true
+				}
+			}
+		}
+	}
+	return advancing
+}
+
+// DetectStagnation reports whether the last window cycles introduced no new node types while only
+// re-selecting techniques already chosen within that window, i.e. the loop is oscillating in place.
+func (e *Engine) DetectStagnation(window int) bool {
+	if e == nil || window <= 1 {
+		return false
+	}
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	if len(e.cycles) < window {
+		return false
+	}
+	distinct := map[string]struct{}{}
+	for _, rec := range e.cycles[len(e.cycles)-window:] {
+		if rec.newNodeTypes > 0 {
+			return false
+		}
+		distinct[rec.techniqueID] = struct{}{}
+	}
+	return len(distinct) < window
 }
 
 type effectRegistry struct {
@@ -0,0 +1,48 @@
+package reasoning
+
+import (
+	"fmt"
+
+	"vantage/core/state"
+)
+
+// sequenceStepRejection is the ordering rule shared by campaign planning and attack-path expansion:
+// an action class appears at most once per sequence and may stay in, or advance one phase past, the
+// phase of the step before it (the operation's current phase for the first step). Preconditions are
+// checked separately against the projected snapshot.
+func sequenceStepRejection(root OperationPhase, prior []ActionClass, next ActionClass) RejectionReason {
+	if actionInStack(prior, next.ID) {
+		return RejectionRepeatedStep
+	}
+	previous := root
+	if len(prior) > 0 {
+		previous = prior[len(prior)-1].Phase
+	}
+	if !phaseAllowed(previous, next.Phase) {
+		return RejectionPhaseDisallowed
+	}
+	return ""
+}
+
+// VerifyFeasible reports whether the action classes named by seq can run in order from a recon-phase
+// graph holding only evidence, under the rules PlanCampaign and ExpandAttackPaths both apply.
+func VerifyFeasible(classes []ActionClass, seq []string) (bool, error) {
+	byID := make(map[string]ActionClass, len(classes))
+	for _, ac := range classes {
+		byID[ac.ID] = ac
+	}
+	snapshot := &graphSnapshot{nodeCounts: map[NodeType]int{NodeTypeEvidence: 1}, edgeCounts: map[EdgeType]int{}}
+	prior := make([]ActionClass, 0, len(seq))
+	for _, id := range seq {
+		ac, ok := byID[id]
+		if !ok {
+			return false, fmt.Errorf("unknown action class %s", id)
+		}
+		if sequenceStepRejection(state.PhaseRecon, prior, ac) != "" || !matchSnapshotPatterns(snapshot, ac.Preconditions) {
+			return false, nil
+		}
+		snapshot.applyAction(ac)
+		prior = append(prior, ac)
+	}
+	return true, nil
+}
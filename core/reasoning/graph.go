@@ -2,7 +2,9 @@ package reasoning
 
 import (
 	"fmt"
+	"slices"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -35,6 +37,8 @@ type Node struct {
 	Label     string
 	CreatedAt time.Time
 	Metadata  map[string]string
+	// Pinned marks a node on the path to an attained objective; PruneNodes never evicts it.
+	Pinned bool
 }
 
 // EdgeType identifies how two graph nodes are related.
@@ -58,13 +62,32 @@ type Edge struct {
 	CreatedAt time.Time
 }
 
-// Graph is an in-memory operational graph of evidence and hypotheses.
+// Graph is an in-memory operational graph of evidence and hypotheses. Stored nodes and edges are never
+// mutated after insertion; updates swap in a new pointer under the lock, so pointers returned by Node,
+// NodesByType, and EdgesFrom stay safe to read without holding it.
 type Graph struct {
 	mu    sync.RWMutex
 	nodes map[string]*Node
 	edges []*Edge
+	// version increments on every mutation so derived snapshots can detect staleness.
+	version uint64
+	// permissiveEdges lets AddEdge accept edges whose endpoints are not (yet) known nodes.
+	permissiveEdges bool
+	// upsertMode controls how UpsertNode treats an existing node ID.
+	upsertMode UpsertMode
 }
 
+// UpsertMode controls how UpsertNode treats a node whose ID is already in the graph.
+type UpsertMode string
+
+const (
+	// ReplaceNode swaps in the new node wholesale, discarding the prior label and metadata. It is the default.
+	ReplaceNode UpsertMode = "replace"
+	// MergeMetadata merges the new metadata over the prior node's, adding new keys and updating existing ones,
+	// and keeps the prior label when the new node has none.
+	MergeMetadata UpsertMode = "merge_metadata"
+)
+
 // NewGraph constructs an empty operational graph.
 func NewGraph() *Graph {
 	return &Graph{
@@ -86,30 +109,287 @@ func (g *Graph) UpsertNode(node *Node) {
 	if node.Metadata == nil {
 		node.Metadata = map[string]string{}
 	}
+	existing, ok := g.nodes[node.ID]
+	// Pinning is one-way so objective evidence stays protected across re-upserts.
+	if ok && existing.Pinned && !node.Pinned {
+		node.Pinned = true
+	}
+	if ok && g.upsertMode == MergeMetadata {
+		merged := make(map[string]string, len(existing.Metadata)+len(node.Metadata))
+		for k, v := range existing.Metadata {
+			merged[k] = v
+		}
+		for k, v := range node.Metadata {
+			merged[k] = v
+		}
+		node.Metadata = merged
+		if node.Label == "" {
+			node.Label = existing.Label
+		}
+	}
 	g.nodes[node.ID] = node
+	g.version++
 }
 
-// AddEdge appends an edge if both endpoint nodes exist.
+// SetUpsertMode sets how UpsertNode treats node IDs already in the graph; the zero value and ReplaceNode
+// replace, MergeMetadata merges metadata.
+func (g *Graph) SetUpsertMode(mode UpsertMode) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.upsertMode = mode
+}
+
+// SetRequireEndpoints toggles whether AddEdge rejects edges referencing unknown nodes. Graphs require
+// endpoints by default; disabling it lets importers add edges before both endpoints are upserted, at
+// the cost of possibly dangling edges.
+func (g *Graph) SetRequireEndpoints(require bool) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.permissiveEdges = !require
+}
+
+// AddEdge appends an edge if both endpoint nodes exist, or unconditionally when endpoints are not required.
 func (g *Graph) AddEdge(edge *Edge) error {
 	if edge == nil || edge.From == "" || edge.To == "" {
 		return fmt.Errorf("invalid edge")
 	}
 	g.mu.Lock()
 	defer g.mu.Unlock()
-	if _, ok := g.nodes[edge.From]; !ok {
-		return fmt.Errorf("from node not found: %s", edge.From)
-	}
-	if _, ok := g.nodes[edge.To]; !ok {
-		return fmt.Errorf("to node not found: %s", edge.To)
+	if !g.permissiveEdges {
+		if _, ok := g.nodes[edge.From]; !ok {
+			return fmt.Errorf("from node not found: %s", edge.From)
+		}
+		if _, ok := g.nodes[edge.To]; !ok {
+			return fmt.Errorf("to node not found: %s", edge.To)
+		}
 	}
 	if edge.CreatedAt.IsZero() {
 		edge.CreatedAt = time.Now().UTC()
 	}
 	g.edges = append(g.edges, edge)
+	g.version++
 	return nil
 }
 
-// Node returns a copy-safe pointer to a node by ID.
+// PruneEdges removes edges weighted below minWeight, preserving the order of the rest,
+// and returns how many were removed.
+func (g *Graph) PruneEdges(minWeight float64) int {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	kept := g.edges[:0]
+	for _, e := range g.edges {
+		if e.Weight >= minWeight {
+			kept = append(kept, e)
+		}
+	}
+	removed := len(g.edges) - len(kept)
+	for i := len(kept); i < len(g.edges); i++ {
+		g.edges[i] = nil
+	}
+	g.edges = kept
+	if removed > 0 {
+		g.version++
+	}
+	return removed
+}
+
+// PinObjectivePaths pins every node of an objective type together with all nodes that reach it through
+// incoming edges, and returns how many nodes were newly pinned.
+func (g *Graph) PinObjectivePaths(objectives []NodeType) int {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	wanted := make(map[NodeType]struct{}, len(objectives))
+	for _, t := range objectives {
+		wanted[t] = struct{}{}
+	}
+	queue := make([]string, 0)
+	for id, n := range g.nodes {
+		if _, ok := wanted[n.Type]; ok {
+			queue = append(queue, id)
+		}
+	}
+	incoming := map[string][]string{}
+	for _, e := range g.edges {
+		incoming[e.To] = append(incoming[e.To], e.From)
+	}
+	visited := map[string]struct{}{}
+	pinned := 0
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+		if _, seen := visited[id]; seen {
+			continue
+		}
+		visited[id] = struct{}{}
+		n, ok := g.nodes[id]
+		if !ok {
+			continue
+		}
+		if !n.Pinned {
+			// Copy on write: readers may hold the previous pointer outside the lock.
+			updated := *n
+			updated.Pinned = true
+			g.nodes[id] = &updated
+			pinned++
+		}
+		queue = append(queue, incoming[id]...)
+	}
+	if pinned > 0 {
+		g.version++
+	}
+	return pinned
+}
+
+// PruneNodes evicts the oldest unpinned nodes, and every edge touching them, until at most maxNodes remain
+// or only pinned nodes are left. It returns how many nodes were removed.
+func (g *Graph) PruneNodes(maxNodes int) int {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	excess := len(g.nodes) - maxNodes
+	if maxNodes < 0 || excess <= 0 {
+		return 0
+	}
+	candidates := make([]*Node, 0, len(g.nodes))
+	for _, n := range g.nodes {
+		if !n.Pinned {
+			candidates = append(candidates, n)
+		}
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		if !candidates[i].CreatedAt.Equal(candidates[j].CreatedAt) {
+			return candidates[i].CreatedAt.Before(candidates[j].CreatedAt)
+		}
+		return candidates[i].ID < candidates[j].ID
+	})
+	if excess > len(candidates) {
+		excess = len(candidates)
+	}
+	removed := make(map[string]struct{}, excess)
+	for _, n := range candidates[:excess] {
+		removed[n.ID] = struct{}{}
+		delete(g.nodes, n.ID)
+	}
+	kept := g.edges[:0]
+	for _, e := range g.edges {
+		_, fromGone := removed[e.From]
+		_, toGone := removed[e.To]
+		if !fromGone && !toGone {
+			kept = append(kept, e)
+		}
+	}
+	for i := len(kept); i < len(g.edges); i++ {
+		g.edges[i] = nil
+	}
+	g.edges = kept
+	if excess > 0 {
+		g.version++
+	}
+	return excess
+}
+
+// ConsolidateEvidence merges evidence nodes recorded for the same technique, target, and outcome into one
+// survivor, whose "weight" metadata accumulates the merged count. The survivor is the oldest pinned node of
+// a group, or the oldest node when none is pinned; pinned nodes are never merged away. Edges touching
+// merged nodes are redirected to the survivor, dropping any that would duplicate another edge, so node and
+// edge types present before stay present. Evidence without technique and target metadata is left alone.
+// It returns how many nodes were removed.
+func (g *Graph) ConsolidateEvidence() int {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	groups := map[string][]*Node{}
+	for _, n := range g.nodes {
+		if n.Type != NodeTypeEvidence || n.Metadata["technique"] == "" || n.Metadata["target"] == "" {
+			continue
+		}
+		key := n.Metadata["technique"] + "|" + n.Metadata["target"] + "|" + n.Metadata["success"]
+		groups[key] = append(groups[key], n)
+	}
+	into := map[string]string{}
+	for _, group := range groups {
+		if len(group) < 2 {
+			continue
+		}
+		sort.Slice(group, func(i, j int) bool {
+			if group[i].Pinned != group[j].Pinned {
+				return group[i].Pinned
+			}
+			if !group[i].CreatedAt.Equal(group[j].CreatedAt) {
+				return group[i].CreatedAt.Before(group[j].CreatedAt)
+			}
+			return group[i].ID < group[j].ID
+		})
+		// Copy on write: readers may hold the previous pointer outside the lock.
+		survivor := *group[0]
+		survivor.Metadata = make(map[string]string, len(group[0].Metadata)+1)
+		for k, v := range group[0].Metadata {
+			survivor.Metadata[k] = v
+		}
+		weight := evidenceWeight(group[0])
+		for _, n := range group[1:] {
+			if n.Pinned {
+				continue
+			}
+			weight += evidenceWeight(n)
+			into[n.ID] = survivor.ID
+			delete(g.nodes, n.ID)
+		}
+		survivor.Metadata["weight"] = strconv.Itoa(weight)
+		g.nodes[survivor.ID] = &survivor
+	}
+	if len(into) == 0 {
+		return 0
+	}
+	edgeKey := func(from, to string, t EdgeType) string { return fmt.Sprintf("%s|%s|%s", from, to, t) }
+	seen := map[string]struct{}{}
+	for _, e := range g.edges {
+		_, fromMerged := into[e.From]
+		_, toMerged := into[e.To]
+		if !fromMerged && !toMerged {
+			seen[edgeKey(e.From, e.To, e.Type)] = struct{}{}
+		}
+	}
+	kept := make([]*Edge, 0, len(g.edges))
+	for _, e := range g.edges {
+		from, to := e.From, e.To
+		if id, ok := into[from]; ok {
+			from = id
+		}
+		if id, ok := into[to]; ok {
+			to = id
+		}
+		if from != e.From || to != e.To {
+			key := edgeKey(from, to, e.Type)
+			if _, dup := seen[key]; dup {
+				continue
+			}
+			seen[key] = struct{}{}
+			redirected := *e
+			redirected.From, redirected.To = from, to
+			e = &redirected
+		}
+		kept = append(kept, e)
+	}
+	g.edges = kept
+	g.version++
+	return len(into)
+}
+
+// evidenceWeight reads how many observations an evidence node stands for; unconsolidated nodes count once.
+func evidenceWeight(n *Node) int {
+	if w, err := strconv.Atoi(n.Metadata["weight"]); err == nil && w > 0 {
+		return w
+	}
+	return 1
+}
+
+// NodeCount returns how many nodes the graph holds.
+func (g *Graph) NodeCount() int {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	return len(g.nodes)
+}
+
+// Node returns a read-only pointer to a node by ID; it is never mutated after being returned.
 func (g *Graph) Node(id string) (*Node, bool) {
 	g.mu.RLock()
 	defer g.mu.RUnlock()
@@ -144,6 +424,58 @@ func (g *Graph) EdgesFrom(nodeID string) []*Edge {
 	return out
 }
 
+// FindPath returns the shortest directed edge sequence from one node to another, following only the given
+// edge types (all types when none are given). Ties resolve to edges added earlier. A node reaches itself by
+// the empty path; unknown endpoints or unreachable targets report false.
+func (g *Graph) FindPath(from, to string, edgeTypes ...EdgeType) ([]*Edge, bool) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	if _, ok := g.nodes[from]; !ok {
+		return nil, false
+	}
+	if _, ok := g.nodes[to]; !ok {
+		return nil, false
+	}
+	if from == to {
+		return []*Edge{}, true
+	}
+	allowed := make(map[EdgeType]struct{}, len(edgeTypes))
+	for _, t := range edgeTypes {
+		allowed[t] = struct{}{}
+	}
+	outgoing := map[string][]*Edge{}
+	for _, e := range g.edges {
+		if _, ok := allowed[e.Type]; len(allowed) > 0 && !ok {
+			continue
+		}
+		outgoing[e.From] = append(outgoing[e.From], e)
+	}
+	via := map[string]*Edge{from: nil}
+	queue := []string{from}
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+		for _, e := range outgoing[id] {
+			if _, seen := via[e.To]; seen {
+				continue
+			}
+			via[e.To] = e
+			if e.To == to {
+				path := make([]*Edge, 0)
+				for cur := to; cur != from; cur = via[cur].From {
+					path = append(path, via[cur])
+				}
+				for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+					path[i], path[j] = path[j], path[i]
+				}
+				return path, true
+			}
+			queue = append(queue, e.To)
+		}
+	}
+	return nil, false
+}
+
 // HasEdgeType returns true when at least one edge of the requested type exists.
 func (g *Graph) HasEdgeType(edgeType EdgeType) bool {
 	g.mu.RLock()
@@ -161,9 +493,47 @@ func (g *Graph) ToDOT() string {
 	g.mu.RLock()
 	defer g.mu.RUnlock()
 
+	return g.dotLocked(nil)
+}
+
+// neighbourhoodDOT renders the nodes selected by seed plus their direct neighbours of the given types,
+// with only the edges between rendered nodes.
+func (g *Graph) neighbourhoodDOT(seed func(*Node) bool, neighbours ...NodeType) string {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	seeds := make(map[string]bool)
+	for id, n := range g.nodes {
+		if seed(n) {
+			seeds[id] = true
+		}
+	}
+	keep := make(map[string]bool, len(seeds))
+	for id := range seeds {
+		keep[id] = true
+	}
+	for _, e := range g.edges {
+		from, to := g.nodes[e.From], g.nodes[e.To]
+		if from == nil || to == nil {
+			continue
+		}
+		if seeds[from.ID] && slices.Contains(neighbours, to.Type) {
+			keep[to.ID] = true
+		}
+		if seeds[to.ID] && slices.Contains(neighbours, from.Type) {
+			keep[from.ID] = true
+		}
+	}
+	return g.dotLocked(keep)
+}
+
+// dotLocked renders the nodes in keep, or every node when keep is nil. Callers hold g.mu.
+func (g *Graph) dotLocked(keep map[string]bool) string {
 	ids := make([]string, 0, len(g.nodes))
 	for id := range g.nodes {
-		ids = append(ids, id)
+		if keep == nil || keep[id] {
+			ids = append(ids, id)
+		}
 	}
 	sort.Strings(ids)
 
@@ -174,6 +544,9 @@ func (g *Graph) ToDOT() string {
 		b.WriteString(fmt.Sprintf("  \"%s\" [label=\"%s\\n(%s)\"];\n", n.ID, escapeDOT(n.Label), n.Type))
 	}
 	for _, e := range g.edges {
+		if keep != nil && (!keep[e.From] || !keep[e.To]) {
+			continue
+		}
 		b.WriteString(fmt.Sprintf("  \"%s\" -> \"%s\" [label=\"%s:%.2f\"];\n", e.From, e.To, e.Type, e.Weight))
 	}
 	b.WriteString("}\n")
@@ -0,0 +1,114 @@
+package reasoning
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// GraphExport is the serializable form of a reasoning graph.
+type GraphExport struct {
+	Nodes []NodeExport `json:"nodes"`
+	Edges []EdgeExport `json:"edges"`
+}
+
+// NodeExport is the serializable form of a graph node.
+type NodeExport struct {
+	ID        string            `json:"id"`
+	Type      NodeType          `json:"type"`
+	Label     string            `json:"label"`
+	CreatedAt time.Time         `json:"created_at"`
+	Metadata  map[string]string `json:"metadata,omitempty"`
+	Pinned    bool              `json:"pinned,omitempty"`
+}
+
+// EdgeExport is the serializable form of a graph edge.
+type EdgeExport struct {
+	From      string    `json:"from"`
+	To        string    `json:"to"`
+	Type      EdgeType  `json:"type"`
+	Weight    float64   `json:"weight"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// CanonicalJSON renders the graph as JSON with nodes sorted by ID and edges by from/to/type,
+// so identical graphs always serialize to identical bytes.
+func (g *Graph) CanonicalJSON() ([]byte, error) {
+	export := g.export()
+	sort.SliceStable(export.Edges, func(i, j int) bool {
+		a, b := export.Edges[i], export.Edges[j]
+		if a.From != b.From {
+			return a.From < b.From
+		}
+		if a.To != b.To {
+			return a.To < b.To
+		}
+		return a.Type < b.Type
+	})
+	return json.MarshalIndent(export, "", "  ")
+}
+
+// ToJSON serializes every node with its metadata and every edge with its weight, nodes sorted by ID and
+// edges in insertion order, so LoadGraphFromJSON restores a graph that renders the same DOT.
+func (g *Graph) ToJSON() ([]byte, error) {
+	return json.MarshalIndent(g.export(), "", "  ")
+}
+
+// LoadGraphFromJSON rebuilds a graph from ToJSON output, keeping node timestamps, pin state, and edge order.
+// Nodes must have unique non-empty IDs and every edge must connect nodes present in the document.
+func LoadGraphFromJSON(data []byte) (*Graph, error) {
+	var export GraphExport
+	if err := json.Unmarshal(data, &export); err != nil {
+		return nil, fmt.Errorf("graph json: %w", err)
+	}
+	g := NewGraph()
+	for i, n := range export.Nodes {
+		if n.ID == "" {
+			return nil, fmt.Errorf("graph json: node %d has no id", i)
+		}
+		if _, exists := g.nodes[n.ID]; exists {
+			return nil, fmt.Errorf("graph json: duplicate node id %s", n.ID)
+		}
+		meta := make(map[string]string, len(n.Metadata))
+		for k, v := range n.Metadata {
+			meta[k] = v
+		}
+		g.nodes[n.ID] = &Node{ID: n.ID, Type: n.Type, Label: n.Label, CreatedAt: n.CreatedAt, Metadata: meta, Pinned: n.Pinned}
+	}
+	for i, e := range export.Edges {
+		_, fromOK := g.nodes[e.From]
+		_, toOK := g.nodes[e.To]
+		if !fromOK || !toOK {
+			return nil, fmt.Errorf("graph json: edge %d (%s -> %s) references an unknown node", i, e.From, e.To)
+		}
+		g.edges = append(g.edges, &Edge{From: e.From, To: e.To, Type: e.Type, Weight: e.Weight, CreatedAt: e.CreatedAt})
+	}
+	return g, nil
+}
+
+// export copies graph contents with nodes sorted by ID and edges in insertion order.
+func (g *Graph) export() GraphExport {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	ids := make([]string, 0, len(g.nodes))
+	for id := range g.nodes {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	out := GraphExport{Nodes: make([]NodeExport, 0, len(ids)), Edges: make([]EdgeExport, 0, len(g.edges))}
+	for _, id := range ids {
+		n := g.nodes[id]
+		meta := make(map[string]string, len(n.Metadata))
+		for k, v := range n.Metadata {
+			meta[k] = v
+		}
+		out.Nodes = append(out.Nodes, NodeExport{ID: n.ID, Type: n.Type, Label: n.Label, CreatedAt: n.CreatedAt, Metadata: meta, Pinned: n.Pinned})
+	}
+	for _, e := range g.edges {
+		out.Edges = append(out.Edges, EdgeExport{From: e.From, To: e.To, Type: e.Type, Weight: e.Weight, CreatedAt: e.CreatedAt})
+	}
+	return out
+}
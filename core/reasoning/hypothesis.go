@@ -2,6 +2,10 @@ package reasoning
 
 import (
 	"fmt"
+	"math"
+	"slices"
+	"sort"
+	"strconv"
 	"strings"
 )
 
@@ -13,8 +17,27 @@ type Hypothesis struct {
 	SupportingNodeIDs []string
 	DerivedFrom       []string
 	Confidence        float64
+	// Status records whether evidence ingested for the anchoring action class on the planned target has borne
+	// the hypothesis out.
+	Status HypothesisStatus
 }
 
+// HypothesisStatus is the lifecycle state of a hypothesis.
+type HypothesisStatus string
+
+const (
+	// HypothesisStatusOpen marks a hypothesis no evidence has decided yet.
+	HypothesisStatusOpen HypothesisStatus = "open"
+	// HypothesisStatusConfirmed marks a hypothesis corroborated by successful evidence.
+	HypothesisStatusConfirmed HypothesisStatus = "confirmed"
+	// HypothesisStatusRefuted marks a hypothesis contradicted by repeated failed evidence.
+	HypothesisStatusRefuted HypothesisStatus = "refuted"
+)
+
+// RefutedHypothesisDiscount scales the confidence of refuted hypotheses; actions they anchor lose
+// 1-RefutedHypothesisDiscount of their score's magnitude.
+const RefutedHypothesisDiscount = 0.5
+
 // GenerateHypotheses derives hypotheses from current graph evidence.
 // GenerateHypotheses derives baseline deterministic hypotheses from current graph evidence.
 func GenerateHypotheses(graph *Graph) []Hypothesis {
@@ -39,3 +62,206 @@ func GenerateHypotheses(graph *Graph) []Hypothesis {
 	}
 	return out
 }
+
+// SupportPropagationWeight scales how far supporting evidence moves an action-class hypothesis's
+// confidence away from its ConfidenceBoost baseline; neutral evidence (0.5) leaves it unchanged.
+const SupportPropagationWeight = 0.5
+
+// MaxSupportingNodes caps how many evidence nodes an action-class hypothesis cites as support.
+const MaxSupportingNodes = 8
+
+// supportingEvidenceIDs returns the evidence nodes with an edge to the hypothesis when the action class's
+// preconditions rely on evidence, strongest edge first and capped at MaxSupportingNodes.
+func supportingEvidenceIDs(graph *Graph, hypothesisID string, patterns []GraphPattern) []string {
+	if !slices.Contains(requiredNodes(patterns), NodeTypeEvidence) {
+		return nil
+	}
+	type support struct {
+		id     string
+		weight float64
+	}
+	var supports []support
+	for _, node := range graph.NodesByType(NodeTypeEvidence) {
+		for _, edge := range graph.EdgesFrom(node.ID) {
+			if edge.To == hypothesisID {
+				supports = append(supports, support{id: node.ID, weight: edge.Weight})
+				break
+			}
+		}
+	}
+	sort.Slice(supports, func(i, j int) bool {
+		if supports[i].weight != supports[j].weight {
+			return supports[i].weight > supports[j].weight
+		}
+		return supports[i].id < supports[j].id
+	})
+	ids := make([]string, 0, min(len(supports), MaxSupportingNodes))
+	for _, s := range supports[:min(len(supports), MaxSupportingNodes)] {
+		ids = append(ids, s.id)
+	}
+	return ids
+}
+
+// propagateSupportConfidence shifts each action-class hypothesis's confidence by the mean confidence
+// of its supporting nodes. Baseline evidence hypotheses already derive from their node and are skipped.
+func propagateSupportConfidence(graph *Graph, hypotheses []Hypothesis) {
+	for i := range hypotheses {
+		h := &hypotheses[i]
+		if h.ActionClassID == "" || len(h.SupportingNodeIDs) == 0 {
+			continue
+		}
+		total, rated := 0.0, 0
+		for _, id := range h.SupportingNodeIDs {
+			if n, ok := graph.Node(id); ok {
+				if c, ok := nodeConfidence(n); ok {
+					total += c
+					rated++
+				}
+			}
+		}
+		if rated == 0 {
+			continue
+		}
+		shifted := h.Confidence + (total/float64(rated)-0.5)*SupportPropagationWeight
+		h.Confidence = clamp01(shifted)
+	}
+}
+
+// hypothesisNodeID is the graph node ID of the hypothesis anchored to an action class.
+func hypothesisNodeID(actionClassID string) string {
+	return fmt.Sprintf("hyp-ac-%s", actionClassID)
+}
+
+// RefuteAfterFailures is the number of consecutive failed observations of an action class against a target
+// needed to refute its hypothesis there; a single failure can be a transient error rather than a verdict.
+const RefuteAfterFailures = 2
+
+// hypothesisKey scopes a hypothesis verdict to one action class on one target.
+type hypothesisKey struct {
+	actionClassID string
+	target        string
+}
+
+// hypothesisVerdict is the evidence tally behind a hypothesis status.
+type hypothesisVerdict struct {
+	status   HypothesisStatus
+	failures int
+}
+
+// recordHypothesisStatus updates the verdict for the action class of the event's technique on the event's
+// target. Success confirms the hypothesis and resets the failure count; it is refuted once
+// RefuteAfterFailures consecutive failures accumulate. Techniques without a known action class leave
+// statuses untouched.
+func (e *Engine) recordHypothesisStatus(event EvidenceEvent) {
+	effect, ok := e.registry.EffectForTechnique(event.TechniqueID)
+	if !ok || effect.ActionClassID == "" {
+		return
+	}
+	key := hypothesisKey{actionClassID: effect.ActionClassID, target: event.Target}
+	e.mu.Lock()
+	if e.hypothesisStatus == nil {
+		e.hypothesisStatus = map[hypothesisKey]hypothesisVerdict{}
+	}
+	verdict := e.hypothesisStatus[key]
+	if verdict.status == "" {
+		verdict.status = HypothesisStatusOpen
+	}
+	if event.Success {
+		verdict = hypothesisVerdict{status: HypothesisStatusConfirmed}
+	} else {
+		verdict.failures++
+		if verdict.failures >= RefuteAfterFailures {
+			verdict.status = HypothesisStatusRefuted
+		}
+	}
+	e.hypothesisStatus[key] = verdict
+	cycleTarget := e.cycle.Target
+	e.mu.Unlock()
+
+	// The anchoring node is shared by every target, so it reflects the target cycles run against.
+	if event.Target != cycleTarget {
+		return
+	}
+	if n, ok := e.graph.Node(hypothesisNodeID(effect.ActionClassID)); ok {
+		updated := *n
+		updated.Metadata = make(map[string]string, len(n.Metadata)+1)
+		for k, v := range n.Metadata {
+			updated.Metadata[k] = v
+		}
+		updated.Metadata["status"] = string(verdict.status)
+		e.graph.UpsertNode(&updated)
+	}
+}
+
+// hypothesisStatuses returns the recorded statuses on target keyed by action class ID.
+func (e *Engine) hypothesisStatuses(target string) map[string]HypothesisStatus {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	out := make(map[string]HypothesisStatus)
+	for key, verdict := range e.hypothesisStatus {
+		if key.target == target {
+			out[key.actionClassID] = verdict.status
+		}
+	}
+	return out
+}
+
+// applyHypothesisStatus stamps each hypothesis with its recorded status, defaulting to open, and discounts
+// the confidence of refuted ones.
+func applyHypothesisStatus(hypotheses []Hypothesis, statuses map[string]HypothesisStatus) {
+	for i := range hypotheses {
+		h := &hypotheses[i]
+		status, ok := statuses[h.ActionClassID]
+		if h.ActionClassID == "" || !ok {
+			status = HypothesisStatusOpen
+		}
+		h.Status = status
+		if status == HypothesisStatusRefuted {
+			h.Confidence *= RefutedHypothesisDiscount
+		}
+	}
+}
+
+// discountRefutedActions lowers actions whose action-class hypothesis was refuted by the
+// RefutedHypothesisDiscount fraction of their score's magnitude, so failure-penalised negative scores drop
+// further instead of rising toward zero, and re-ranks.
+func discountRefutedActions(ranked []RankedAction, statuses map[string]HypothesisStatus) {
+	discounted := false
+	for i := range ranked {
+		if statuses[ranked[i].ActionClassID] == HypothesisStatusRefuted {
+			ranked[i].Score -= math.Abs(ranked[i].Score) * (1 - RefutedHypothesisDiscount)
+			ranked[i].Reason = fmt.Sprintf("%s hypothesis=refuted", ranked[i].Reason)
+			discounted = true
+		}
+	}
+	if discounted {
+		sortRanked(ranked)
+	}
+}
+
+// nodeConfidence reads a node's explicit "confidence" metadata, falling back to the baseline
+// success-derived confidence for ingested evidence.
+func nodeConfidence(n *Node) (float64, bool) {
+	if raw, ok := n.Metadata["confidence"]; ok {
+		if c, err := strconv.ParseFloat(raw, 64); err == nil {
+			return clamp01(c), true
+		}
+	}
+	if success, ok := n.Metadata["success"]; ok {
+		if strings.EqualFold(success, "true") {
+			return 0.8, true
+		}
+		return 0.5, true
+	}
+	return 0, false
+}
+
+func clamp01(v float64) float64 {
+	if v < 0 {
+		return 0
+	}
+	if v > 1 {
+		return 1
+	}
+	return v
+}
@@ -1,6 +1,8 @@
 package reasoning
 
 import (
+	"context"
+
 	"vantage/core/evidence"
 	"vantage/core/state"
 )
@@ -13,6 +15,20 @@ type TechniqueEffect struct {
 	Risk          float64
 	Stealth       float64
 	Produces      []string
+	// PhaseOverrides replaces Impact, Risk, and Stealth while the campaign is in a given phase.
+	PhaseOverrides map[OperationPhase]TechniqueEffect
+}
+
+// ForPhase returns the effect to score in phase: the phase override when one exists, otherwise the base
+// effect. Overrides inherit the base technique and action class IDs and production.
+func (e TechniqueEffect) ForPhase(phase OperationPhase) TechniqueEffect {
+	override, ok := e.PhaseOverrides[phase]
+	if !ok {
+		return e
+	}
+	override.TechniqueID, override.ActionClassID, override.Produces = e.TechniqueID, e.ActionClassID, e.Produces
+	override.PhaseOverrides = e.PhaseOverrides
+	return override
 }
 
 // TechniqueEffectRegistry stores technique effects used during planning.
@@ -36,12 +52,33 @@ type EvidenceIngestor interface {
 	IngestEvidence(event EvidenceEvent) error
 }
 
+// EvidenceSource yields evidence events from an external acquisition pipeline.
+// Next returns false once the source is exhausted.
+type EvidenceSource interface {
+	Next(ctx context.Context) (EvidenceEvent, bool, error)
+}
+
+// ExposureGauge reports accumulated exposure against a halt budget; *exposure.Tracker satisfies it.
+type ExposureGauge interface {
+	Score() uint64
+	Remaining() uint64
+}
+
 // PlannerQuery is the planner query input.
 type PlannerQuery struct {
 	Target             string
 	AllowedTechniques  []string
 	CurrentTechniqueID string
 	TopN               int
+	// PinnedTechniqueID forces selection of a technique regardless of score when it is allowed, its action
+	// class passes the ROE policy, and the impact gate does not hold it back; otherwise planning fails with
+	// a PinRejectedError.
+	PinnedTechniqueID string
+	// Phase selects technique effect phase overrides; PlanNextAction fills it from campaign state when empty.
+	Phase OperationPhase
+	// PreferPathEnabling, when set, boosts techniques whose action class opens the best campaign toward this
+	// objective node type.
+	PreferPathEnabling NodeType
 }
 
 // RankedAction is a scored action candidate returned by the planner.
@@ -54,6 +91,30 @@ type RankedAction struct {
 	Risk          float64
 	Stealth       float64
 	Reason        string
+	// TieBreakReason records which ordering rule placed the action: TieBreakScore when its score differs
+	// from both neighbours, TieBreakTechniqueID when it shares a score with a neighbour and the lexical
+	// technique ID decided the order.
+	TieBreakReason string
+}
+
+// Tie-break reasons recorded on RankedAction.TieBreakReason.
+const (
+	TieBreakScore       = "score"
+	TieBreakTechniqueID = "technique_id"
+)
+
+// SelectionPolicy chooses the action to execute from a non-empty, score-ordered ranking. The choice must
+// be one of the ranked actions; PlanNextAction rejects anything else.
+type SelectionPolicy interface {
+	Select(ranked []RankedAction) RankedAction
+}
+
+// TopRankedPolicy selects the highest-scoring action; it is the engine default.
+type TopRankedPolicy struct{}
+
+// Select returns ranked[0].
+func (TopRankedPolicy) Select(ranked []RankedAction) RankedAction {
+	return ranked[0]
 }
 
 // RankedActionPlanner returns ranked next actions.
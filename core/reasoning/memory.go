@@ -1,6 +1,10 @@
 package reasoning
 
-import "vantage/core/state"
+import (
+	"fmt"
+
+	"vantage/core/state"
+)
 
 type CampaignTrace struct {
 	StateProgression    []state.Status
@@ -23,6 +27,35 @@ func applyStateMemoryAdjustments(ranked []RankedAction, st *state.State) {
 	}
 }
 
+// ExposurePenaltyWeight scales the risk penalty applied as the exposure budget approaches its halt limit.
+const ExposurePenaltyWeight = 1.0
+
+// exposurePressure returns the spent fraction of the exposure budget, or zero without a gauge.
+func exposurePressure(gauge ExposureGauge) float64 {
+	if gauge == nil {
+		return 0
+	}
+	spent, remaining := float64(gauge.Score()), float64(gauge.Remaining())
+	if spent+remaining == 0 {
+		return 0
+	}
+	return spent / (spent + remaining)
+}
+
+// applyExposurePressure lowers each score by its risk times the spent budget fraction and re-ranks, so
+// high-risk actions fall behind safer ones as exposure nears the halt limit.
+func applyExposurePressure(ranked []RankedAction, gauge ExposureGauge) {
+	pressure := exposurePressure(gauge)
+	if pressure <= 0 {
+		return
+	}
+	for i := range ranked {
+		ranked[i].Score -= ranked[i].Risk * pressure * ExposurePenaltyWeight
+		ranked[i].Reason = fmt.Sprintf("%s exposure_pressure=%.2f", ranked[i].Reason, pressure)
+	}
+	sortRanked(ranked)
+}
+
 func (e *Engine) SimulateCampaignCycles(n int) CampaignTrace {
 	trace := CampaignTrace{StateProgression: make([]state.Status, 0, n), PhaseTransitions: make([]state.OperationPhase, 0, n), ConfidenceEvolution: make([]float64, 0, n)}
 	if e == nil || n <= 0 {
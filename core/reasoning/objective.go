@@ -0,0 +1,103 @@
+package reasoning
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// Objective names a planning goal: either a node type or an edge type that must appear in the graph.
+// Exactly one of NodeType and EdgeType is set.
+type Objective struct {
+	Name     string
+	NodeType NodeType
+	EdgeType EdgeType
+}
+
+// IsEdge reports whether the objective is satisfied by an edge type rather than a node type.
+func (o Objective) IsEdge() bool {
+	return o.EdgeType != ""
+}
+
+var objectiveRegistry = struct {
+	mu     sync.RWMutex
+	byName map[string]Objective
+}{byName: map[string]Objective{
+	string(NodeTypeDataExposure):        {Name: string(NodeTypeDataExposure), NodeType: NodeTypeDataExposure},
+	string(NodeTypePrivEsc):             {Name: string(NodeTypePrivEsc), NodeType: NodeTypePrivEsc},
+	string(NodeTypeLateralReachability): {Name: string(NodeTypeLateralReachability), NodeType: NodeTypeLateralReachability},
+	"ENABLES":                           {Name: "ENABLES", EdgeType: EdgeTypeEnables},
+}}
+
+// normalizeObjectiveName canonicalizes objective names so lookups are case- and whitespace-insensitive.
+func normalizeObjectiveName(name string) string {
+	return strings.ToUpper(strings.TrimSpace(name))
+}
+
+// RegisterObjective adds a custom objective under name. Built-in and previously registered names
+// cannot be redefined, and the objective must target exactly one of a node type or an edge type.
+func RegisterObjective(name string, objective Objective) error {
+	key := normalizeObjectiveName(name)
+	if key == "" {
+		return fmt.Errorf("objective name is required")
+	}
+	if (objective.NodeType == "") == (objective.EdgeType == "") {
+		return fmt.Errorf("objective %q must name exactly one node type or edge type", name)
+	}
+	objectiveRegistry.mu.Lock()
+	defer objectiveRegistry.mu.Unlock()
+	if _, exists := objectiveRegistry.byName[key]; exists {
+		return fmt.Errorf("objective %q is already registered", name)
+	}
+	objective.Name = key
+	objectiveRegistry.byName[key] = objective
+	return nil
+}
+
+// UnregisterObjective removes a custom objective registered under name. Built-in objectives cannot be
+// removed.
+func UnregisterObjective(name string) error {
+	key := normalizeObjectiveName(name)
+	if isBuiltinObjective(key) {
+		return fmt.Errorf("objective %q is built in", name)
+	}
+	objectiveRegistry.mu.Lock()
+	defer objectiveRegistry.mu.Unlock()
+	if _, exists := objectiveRegistry.byName[key]; !exists {
+		return fmt.Errorf("objective %q is not registered", name)
+	}
+	delete(objectiveRegistry.byName, key)
+	return nil
+}
+
+func isBuiltinObjective(key string) bool {
+	switch key {
+	case string(NodeTypeDataExposure), string(NodeTypePrivEsc), string(NodeTypeLateralReachability), "ENABLES":
+		return true
+	}
+	return false
+}
+
+// ParseObjective resolves an objective name against the built-in and registered objectives.
+func ParseObjective(name string) (Objective, error) {
+	objectiveRegistry.mu.RLock()
+	defer objectiveRegistry.mu.RUnlock()
+	objective, ok := objectiveRegistry.byName[normalizeObjectiveName(name)]
+	if !ok {
+		return Objective{}, fmt.Errorf("unsupported objective %q", name)
+	}
+	return objective, nil
+}
+
+// objectiveNodeTypes returns the node types of every known node objective.
+func objectiveNodeTypes() []NodeType {
+	objectiveRegistry.mu.RLock()
+	defer objectiveRegistry.mu.RUnlock()
+	out := make([]NodeType, 0, len(objectiveRegistry.byName))
+	for _, o := range objectiveRegistry.byName {
+		if !o.IsEdge() {
+			out = append(out, o.NodeType)
+		}
+	}
+	return out
+}
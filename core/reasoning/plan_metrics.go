@@ -0,0 +1,41 @@
+package reasoning
+
+import "time"
+
+// PlanMetrics describes the work one campaign plan performed, for sizing BeamWidth and MaxDepth.
+type PlanMetrics struct {
+	// CandidatesProjected counts action classes projected onto a beam candidate.
+	CandidatesProjected int
+	// PrunedPerDepth holds, at index depth-1, how many projected candidates beam pruning discarded.
+	PrunedPerDepth []int
+	WallTime       time.Duration
+	// UnlockCacheHits and UnlockCacheMisses count unlock-count memo lookups; UnlockCacheHitRate is
+	// hits over lookups, or 0 when nothing was looked up.
+	UnlockCacheHits    int
+	UnlockCacheMisses  int
+	UnlockCacheHitRate float64
+}
+
+// projected records one candidate projection; it is a no-op on nil metrics so collection stays opt-in.
+func (m *PlanMetrics) projected() {
+	if m != nil {
+		m.CandidatesProjected++
+	}
+}
+
+// pruned records how many candidates the next depth's beam pruning discarded.
+func (m *PlanMetrics) pruned(n int) {
+	if m != nil {
+		m.PrunedPerDepth = append(m.PrunedPerDepth, n)
+	}
+}
+
+func (m *PlanMetrics) recordUnlockCache(c *unlockCache) {
+	if m == nil || c == nil {
+		return
+	}
+	m.UnlockCacheHits, m.UnlockCacheMisses = c.hits, c.misses
+	if lookups := c.hits + c.misses; lookups > 0 {
+		m.UnlockCacheHitRate = float64(c.hits) / float64(lookups)
+	}
+}
@@ -3,6 +3,7 @@ package reasoning
 import (
 	"fmt"
 	"sort"
+	"sync"
 
 	"vantage/techniques"
 	"vantage/techniqueset"
@@ -11,7 +12,11 @@ import (
 // Planner ranks action candidates using technique effects.
 type Planner struct {
 	registry TechniqueEffectRegistry
-	weights  TechniqueScoreWeights
+	// mu guards weights and preference, which setters may replace while another goroutine is ranking.
+	mu      sync.RWMutex
+	weights TechniqueScoreWeights
+	// preference picks which admissible technique runs for a hypothesis action class.
+	preference techniques.PreferencePolicy
 }
 
 // NewPlanner creates a planner with a technique effect registry.
@@ -19,6 +24,21 @@ func NewPlanner(registry TechniqueEffectRegistry, weights TechniqueScoreWeights)
 	return &Planner{registry: registry, weights: weights}
 }
 
+// SetWeights replaces the technique score weights used by RankedActions.
+func (p *Planner) SetWeights(weights TechniqueScoreWeights) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.weights = weights
+}
+
+// SetPreferencePolicy replaces the policy RankedActionsForHypotheses uses to choose among a class's
+// admissible techniques.
+func (p *Planner) SetPreferencePolicy(policy techniques.PreferencePolicy) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.preference = policy
+}
+
 // RankedActions returns sorted candidates for a target.
 func (p *Planner) RankedActions(query PlannerQuery) []RankedAction {
 	if p == nil || p.registry == nil {
@@ -29,13 +49,18 @@ func (p *Planner) RankedActions(query PlannerQuery) []RankedAction {
 		techniquesToScore = p.registry.KnownTechniques()
 	}
 
+	p.mu.RLock()
+	weights := p.weights
+	p.mu.RUnlock()
+
 	out := make([]RankedAction, 0, len(techniquesToScore))
 	for _, id := range techniquesToScore {
-		effect, ok := p.registry.EffectForTechnique(id)
+		base, ok := p.registry.EffectForTechnique(id)
 		if !ok {
 			continue
 		}
-		score := ScoreTechnique(effect, p.weights)
+		effect := base.ForPhase(query.Phase)
+		score := ScoreTechnique(effect, weights)
 		out = append(out, RankedAction{TechniqueID: id, ActionClassID: effect.ActionClassID, Target: query.Target, Score: score, Impact: effect.Impact, Risk: effect.Risk, Stealth: effect.Stealth, Reason: fmt.Sprintf("impact=%.2f risk=%.2f stealth=%.2f", effect.Impact, effect.Risk, effect.Stealth)})
 	}
 
@@ -46,7 +71,8 @@ func (p *Planner) RankedActions(query PlannerQuery) []RankedAction {
 	return out
 }
 
-// RankedActionsForHypotheses scores techniques that map to each hypothesis action class.
+// RankedActionsForHypotheses scores, for each hypothesis action class, the admissible technique the
+// preference policy ranks first, so each class contributes the one technique that would run for it.
 func (p *Planner) RankedActionsForHypotheses(graph *Graph, target string, hypotheses []Hypothesis, classLookup func(string) (ActionClass, bool), topN int) []RankedAction {
 	if graph == nil || classLookup == nil {
 		return nil
@@ -61,12 +87,21 @@ func (p *Planner) RankedActionsForHypotheses(graph *Graph, target string, hypoth
 			continue
 		}
 		snapshot := techniqueGraphSnapshot(graph)
+		admissible := make([]techniques.Technique, 0)
 		for _, tech := range techniqueset.ForActionClass(h.ActionClassID) {
-			if !tech.Evaluate(snapshot) {
-				continue
+			if tech.Evaluate(snapshot) {
+				admissible = append(admissible, tech)
 			}
+		}
+		if len(admissible) == 0 {
+			continue
+		}
+		p.mu.RLock()
+		preference := p.preference
+		p.mu.RUnlock()
+		for _, tech := range preference.Order(h.ActionClassID, admissible)[:1] {
 			score := (ac.ImpactWeight * tech.ImpactModifier()) + ((1 - ac.RiskWeight) * (1 - tech.RiskModifier()))
-			ra := RankedAction{TechniqueID: tech.ID(), ActionClassID: h.ActionClassID, Target: target, Score: score, Impact: tech.ImpactModifier(), Risk: tech.RiskModifier(), Stealth: 1 - tech.RiskModifier(), Reason: fmt.Sprintf("ac_impact=%.2f ac_risk=%.2f tech_impact=%.2f tech_risk=%.2f", ac.ImpactWeight, ac.RiskWeight, tech.ImpactModifier(), tech.RiskModifier())}
+			ra := RankedAction{TechniqueID: tech.ID(), ActionClassID: h.ActionClassID, Target: target, Score: score, Impact: tech.ImpactModifier(), Risk: tech.RiskModifier(), Stealth: 1 - tech.RiskModifier(), Reason: fmt.Sprintf("ac_impact=%.2f ac_risk=%.2f tech_impact=%.2f tech_risk=%.2f preference=%s", ac.ImpactWeight, ac.RiskWeight, tech.ImpactModifier(), tech.RiskModifier(), preference.For(h.ActionClassID))}
 			if existing, exists := candidates[ra.TechniqueID]; !exists || ra.Score > existing.Score {
 				candidates[ra.TechniqueID] = ra
 			}
@@ -90,6 +125,18 @@ func sortRanked(out []RankedAction) {
 		}
 		return out[i].Score > out[j].Score
 	})
+	annotateTieBreaks(out)
+}
+
+// annotateTieBreaks sets TieBreakReason on a ranking already ordered by score then technique ID.
+func annotateTieBreaks(ranked []RankedAction) {
+	for i := range ranked {
+		tied := (i > 0 && ranked[i-1].Score == ranked[i].Score) || (i+1 < len(ranked) && ranked[i+1].Score == ranked[i].Score)
+		ranked[i].TieBreakReason = TieBreakScore
+		if tied {
+			ranked[i].TieBreakReason = TieBreakTechniqueID
+		}
+	}
 }
 
 func techniqueGraphSnapshot(graph *Graph) *techniques.Graph {
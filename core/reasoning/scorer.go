@@ -20,12 +20,16 @@ const (
 	DepthFactor = 0.25
 	// ObjectiveProximityFactor boosts chains that end by producing the requested objective.
 	ObjectiveProximityFactor = 1.35
+	// EfficiencyEpsilon keeps efficiency scores finite for zero-risk techniques.
+	EfficiencyEpsilon = 0.01
 )
 
 type TechniqueScoreWeights struct {
 	ImpactWeight  float64
 	RiskWeight    float64
 	StealthWeight float64
+	// EfficiencyMode ranks techniques by Impact / (Risk + EfficiencyEpsilon) instead of the weighted sum.
+	EfficiencyMode bool
 }
 
 func DefaultTechniqueScoreWeights() TechniqueScoreWeights {
@@ -33,6 +37,9 @@ func DefaultTechniqueScoreWeights() TechniqueScoreWeights {
 }
 
 func ScoreTechnique(effect TechniqueEffect, weights TechniqueScoreWeights) float64 {
+	if weights.EfficiencyMode {
+		return effect.Impact / (effect.Risk + EfficiencyEpsilon)
+	}
 	if weights == (TechniqueScoreWeights{}) {
 		weights = DefaultTechniqueScoreWeights()
 	}
@@ -43,7 +50,7 @@ func scorePath(steps []Hypothesis, pathClasses []ActionClass, allClasses []Actio
 	return scorePathWithCache(steps, pathClasses, allClasses, objective, cfg, nil, "")
 }
 
-func scorePathWithCache(steps []Hypothesis, pathClasses []ActionClass, allClasses []ActionClass, objective NodeType, cfg AttackPathConfig, unlockCache map[string]float64, graphHash string) AttackPath {
+func scorePathWithCache(steps []Hypothesis, pathClasses []ActionClass, allClasses []ActionClass, objective NodeType, cfg AttackPathConfig, unlockCache *unlockCache, graphHash string) AttackPath {
 	totalConfidence := 0.0
 	risk := 0.0
 	for i := range pathClasses {
@@ -57,17 +64,46 @@ func scorePathWithCache(steps []Hypothesis, pathClasses []ActionClass, allClasse
 	if len(steps) > 0 {
 		averageConfidence = totalConfidence / float64(len(steps))
 	}
-	feasibilityScore := averageFeasibility(pathClasses)
+	feasibilityScore := averageFeasibility(pathClasses, cfg.EdgeImportance)
 	unlockBonus := unlockedActionCount(pathClasses, allClasses, unlockCache, graphHash) * UnlockFactor
-	score := (averageConfidence * ConfidenceWeight) + (feasibilityScore * FeasibilityWeight) + unlockBonus - riskPenalty(risk) - (float64(len(steps)) * DepthFactor)
-
 	proximity := objectiveProximity(pathClasses, objective, cfg)
-	score += proximity
+	explanation := PathExplanation{
+		Confidence:         averageConfidence * ConfidenceWeight,
+		Feasibility:        feasibilityScore * FeasibilityWeight,
+		UnlockBonus:        unlockBonus,
+		RiskPenalty:        riskPenalty(risk),
+		DepthPenalty:       float64(len(steps)) * DepthFactor,
+		StagnationPenalty:  float64(stagnantSteps(pathClasses)) * cfg.StagnationPenalty,
+		DiversityBonus:     float64(distinctProducedNodeTypes(pathClasses)) * cfg.DiversityFactor,
+		ObjectiveProximity: proximity,
+	}
 	if objective != "" {
-		score *= ObjectiveProximityFactor
+		explanation = explanation.scaled(ObjectiveProximityFactor)
 	}
 
-	return AttackPath{Steps: steps, Score: score, Risk: risk, Objective: objective, ObjectiveProximityScore: proximity, Valid: true}
+	return AttackPath{Steps: steps, Score: explanation.Total(), Risk: risk, Objective: objective, ObjectiveProximityScore: proximity, Valid: true, explanation: explanation}
+}
+
+// stagnantSteps counts steps that remain in the phase of the step before them.
+func stagnantSteps(pathClasses []ActionClass) int {
+	count := 0
+	for i := 1; i < len(pathClasses); i++ {
+		if pathClasses[i].Phase == pathClasses[i-1].Phase {
+			count++
+		}
+	}
+	return count
+}
+
+// distinctProducedNodeTypes counts the node types a path produces, ignoring repeats.
+func distinctProducedNodeTypes(pathClasses []ActionClass) int {
+	seen := map[NodeType]struct{}{}
+	for _, ac := range pathClasses {
+		for _, n := range ac.ProducesNodes {
+			seen[n] = struct{}{}
+		}
+	}
+	return len(seen)
 }
 
 func objectiveProximity(pathClasses []ActionClass, objective NodeType, cfg AttackPathConfig) float64 {
@@ -87,7 +123,7 @@ func objectiveProximity(pathClasses []ActionClass, objective NodeType, cfg Attac
 	return 1 / float64(len(pathClasses)+1)
 }
 
-func averageFeasibility(path []ActionClass) float64 { /* unchanged */
+func averageFeasibility(path []ActionClass, edgeImportance map[EdgeType]float64) float64 {
 	if len(path) == 0 {
 		return 0
 	}
@@ -95,10 +131,10 @@ func averageFeasibility(path []ActionClass) float64 { /* unchanged */
 	edgeTypes := map[EdgeType]struct{}{}
 	totalRatio := 0.0
 	for _, ac := range path {
-		matched, total := matchedPreconditions(ac.Preconditions, nodeTypes, edgeTypes)
+		matched, total := matchedPreconditions(ac.Preconditions, nodeTypes, edgeTypes, edgeImportance)
 		ratio := 1.0
 		if total > 0 {
-			ratio = float64(matched) / float64(total)
+			ratio = matched / total
 		}
 		totalRatio += ratio
 		for _, n := range ac.ProducesNodes {
@@ -111,7 +147,7 @@ func averageFeasibility(path []ActionClass) float64 { /* unchanged */
 	return totalRatio / float64(len(path))
 }
 
-func unlockedActionCount(path []ActionClass, universe []ActionClass, cache map[string]float64, graphHash string) float64 {
+func unlockedActionCount(path []ActionClass, universe []ActionClass, cache *unlockCache, graphHash string) float64 {
 	if len(path) == 0 || len(universe) == 0 {
 		return 0
 	}
@@ -124,7 +160,7 @@ func unlockedActionCount(path []ActionClass, universe []ActionClass, cache map[s
 	cacheKey := ""
 	if cache != nil {
 		cacheKey = fmt.Sprintf("%s|%s|%s", graphHash, availabilityHash(beforeNodes, beforeEdges), availabilityHash(afterNodes, afterEdges))
-		if v, ok := cache[cacheKey]; ok {
+		if v, ok := cache.get(cacheKey); ok {
 			return v
 		}
 	}
@@ -140,7 +176,7 @@ func unlockedActionCount(path []ActionClass, universe []ActionClass, cache map[s
 		}
 	}
 	if cache != nil {
-		cache[cacheKey] = float64(unlocked)
+		cache.put(cacheKey, float64(unlocked))
 	}
 	return float64(unlocked)
 }
@@ -187,16 +223,39 @@ func availabilityAfterPath(path []ActionClass) (map[NodeType]struct{}, map[EdgeT
 	return nodeTypes, edgeTypes
 }
 
-func matchedPreconditions(patterns []GraphPattern, nodeTypes map[NodeType]struct{}, edgeTypes map[EdgeType]struct{}) (matched int, total int) {
-	total = len(patterns)
+// matchedPreconditions sums the weights of satisfied patterns and of all patterns.
+func matchedPreconditions(patterns []GraphPattern, nodeTypes map[NodeType]struct{}, edgeTypes map[EdgeType]struct{}, edgeImportance map[EdgeType]float64) (matched float64, total float64) {
 	for _, pattern := range patterns {
+		weight := patternWeight(pattern, edgeImportance)
+		total += weight
 		if patternSatisfied(pattern, nodeTypes, edgeTypes) {
-			matched++
+			matched += weight
 		}
 	}
 	return matched, total
 }
 
+// patternWeight is the pattern's own Weight (1 when unset) times the mean importance of its required edges,
+// where unlisted edge types weigh 1; with unweighted patterns and a nil map it reduces to counting patterns.
+func patternWeight(pattern GraphPattern, edgeImportance map[EdgeType]float64) float64 {
+	base := pattern.Weight
+	if base <= 0 {
+		base = 1
+	}
+	if len(pattern.RequiredEdges) == 0 || len(edgeImportance) == 0 {
+		return base
+	}
+	sum := 0.0
+	for _, t := range pattern.RequiredEdges {
+		w, ok := edgeImportance[t]
+		if !ok {
+			w = 1
+		}
+		sum += w
+	}
+	return base * sum / float64(len(pattern.RequiredEdges))
+}
+
 func preconditionsEligible(patterns []GraphPattern, nodeTypes map[NodeType]struct{}, edgeTypes map[EdgeType]struct{}) bool {
 	for _, pattern := range patterns {
 		if !patternSatisfied(pattern, nodeTypes, edgeTypes) {
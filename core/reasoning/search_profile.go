@@ -0,0 +1,276 @@
+package reasoning
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"reflect"
+	"time"
+
+	"vantage/core/state"
+)
+
+// Built-in ROE presets that a search profile can name in place of a ROEPolicy func.
+const (
+	// ROEPresetAllowAll admits every action class (default).
+	ROEPresetAllowAll = "allow_all"
+	// ROEPresetReconOnly admits only recon-phase action classes.
+	ROEPresetReconOnly = "recon_only"
+	// ROEPresetLowRisk admits action classes whose risk weight does not exceed LowRiskROEThreshold.
+	ROEPresetLowRisk = "low_risk"
+)
+
+// LowRiskROEThreshold is the highest action-class risk weight admitted by ROEPresetLowRisk.
+const LowRiskROEThreshold = 0.3
+
+var roePresets = map[string]func(ac ActionClass, graph *Graph, st *state.State) bool{
+	ROEPresetAllowAll:  func(ActionClass, *Graph, *state.State) bool { return true },
+	ROEPresetReconOnly: func(ac ActionClass, _ *Graph, _ *state.State) bool { return ac.Phase == state.PhaseRecon },
+	ROEPresetLowRisk:   func(ac ActionClass, _ *Graph, _ *state.State) bool { return ac.RiskWeight <= LowRiskROEThreshold },
+}
+
+// ResolveROEPreset returns the built-in ROE policy registered under name.
+func ResolveROEPreset(name string) (func(ac ActionClass, graph *Graph, st *state.State) bool, error) {
+	policy, ok := roePresets[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown roe preset %q", name)
+	}
+	return policy, nil
+}
+
+type attackPathConfigFile struct {
+	MaxDepth               int                  `json:"max_depth"`
+	BeamWidth              int                  `json:"beam_width"`
+	RiskThreshold          float64              `json:"risk_threshold"`
+	DepthPenalty           float64              `json:"depth_penalty"`
+	ConfidenceWeight       float64              `json:"confidence_weight"`
+	StartNodeTypes         []NodeType           `json:"start_node_types"`
+	ObjectiveNodeTypes     []NodeType           `json:"objective_node_types"`
+	ROEPreset              string               `json:"roe_preset"`
+	BeamObjective          BeamObjective        `json:"beam_objective"`
+	StagnationPenalty      float64              `json:"stagnation_penalty"`
+	DiversityFactor        float64              `json:"diversity_factor"`
+	EdgeImportance         map[EdgeType]float64 `json:"edge_importance,omitempty"`
+	RandomTieBreak         bool                 `json:"random_tie_break,omitempty"`
+	TieBreakSeed           int64                `json:"tie_break_seed,omitempty"`
+	MinObjectiveConfidence float64              `json:"min_objective_confidence,omitempty"`
+}
+
+type campaignOptionsFile struct {
+	MaxDepth                int                   `json:"max_depth"`
+	RiskTolerance           float64               `json:"risk_tolerance"`
+	ConfidenceThreshold     float64               `json:"confidence_threshold"`
+	BeamWidth               int                   `json:"beam_width"`
+	TopN                    int                   `json:"top_n"`
+	ObjectiveBiasWeight     float64               `json:"objective_bias_weight"`
+	ObjectiveProximityScore float64               `json:"objective_proximity_score"`
+	ExcludeExecuted         bool                  `json:"exclude_executed"`
+	BeamObjective           BeamObjective         `json:"beam_objective"`
+	AllowGaps               bool                  `json:"allow_gaps"`
+	ConfidenceAggregation   ConfidenceAggregation `json:"confidence_aggregation"`
+	ObjectiveBiasDecay      ObjectiveBiasDecay    `json:"objective_bias_decay,omitempty"`
+	MaxStepRisk             float64               `json:"max_step_risk,omitempty"`
+	RandomTieBreak          bool                  `json:"random_tie_break,omitempty"`
+	TieBreakSeed            int64                 `json:"tie_break_seed,omitempty"`
+	// PhaseDeadlines lists {"phase", "deadline"} entries with deadlines as Go duration strings such as "48h".
+	PhaseDeadlines []PhaseDeadline `json:"phase_deadlines,omitempty"`
+}
+
+type phaseDeadlineFile struct {
+	Phase    OperationPhase `json:"phase"`
+	Deadline string         `json:"deadline"`
+}
+
+// MarshalJSON writes the deadline as a duration string.
+func (d PhaseDeadline) MarshalJSON() ([]byte, error) {
+	return json.Marshal(phaseDeadlineFile{Phase: d.Phase, Deadline: d.Deadline.String()})
+}
+
+// UnmarshalJSON reads a deadline written by MarshalJSON.
+func (d *PhaseDeadline) UnmarshalJSON(raw []byte) error {
+	var file phaseDeadlineFile
+	if err := json.Unmarshal(raw, &file); err != nil {
+		return err
+	}
+	deadline, err := time.ParseDuration(file.Deadline)
+	if err != nil {
+		return fmt.Errorf("phase deadline %s: %w", file.Phase, err)
+	}
+	*d = PhaseDeadline{Phase: file.Phase, Deadline: deadline}
+	return nil
+}
+
+// LoadAttackPathConfig reads a search profile, as YAML when path ends in .yaml or .yml and JSON otherwise. Omitted fields keep DefaultAttackPathConfig values
+// and the named ROE preset is resolved into ROEPolicy.
+func LoadAttackPathConfig(path string) (AttackPathConfig, error) {
+	def := DefaultAttackPathConfig()
+	file := attackPathConfigFile{
+		MaxDepth: def.MaxDepth, BeamWidth: def.BeamWidth, RiskThreshold: def.RiskThreshold, DepthPenalty: def.DepthPenalty,
+		ConfidenceWeight: def.ConfidenceWeight, StartNodeTypes: def.StartNodeTypes, ObjectiveNodeTypes: def.ObjectiveNodeTypes,
+		ROEPreset: def.ROEPreset, BeamObjective: def.BeamObjective, StagnationPenalty: def.StagnationPenalty, DiversityFactor: def.DiversityFactor,
+	}
+	if err := decodeSearchProfile(path, &file); err != nil {
+		return AttackPathConfig{}, err
+	}
+	if file.MaxDepth <= 0 || file.BeamWidth <= 0 {
+		return AttackPathConfig{}, fmt.Errorf("attack path config %s: max_depth and beam_width must be positive", path)
+	}
+	if file.RiskThreshold < 0 || file.DepthPenalty < 0 || file.ConfidenceWeight < 0 || file.StagnationPenalty < 0 || file.DiversityFactor < 0 || file.MinObjectiveConfidence < 0 {
+		return AttackPathConfig{}, fmt.Errorf("attack path config %s: weights and thresholds must be non-negative", path)
+	}
+	for t, w := range file.EdgeImportance {
+		if w < 0 {
+			return AttackPathConfig{}, fmt.Errorf("attack path config %s: edge importance for %s must be non-negative", path, t)
+		}
+	}
+	if err := validateBeamObjective(file.BeamObjective); err != nil {
+		return AttackPathConfig{}, fmt.Errorf("attack path config %s: %w", path, err)
+	}
+	policy, err := ResolveROEPreset(file.ROEPreset)
+	if err != nil {
+		return AttackPathConfig{}, fmt.Errorf("attack path config %s: %w", path, err)
+	}
+	return AttackPathConfig{
+		MaxDepth: file.MaxDepth, BeamWidth: file.BeamWidth, RiskThreshold: file.RiskThreshold, DepthPenalty: file.DepthPenalty,
+		ConfidenceWeight: file.ConfidenceWeight, StartNodeTypes: file.StartNodeTypes, ObjectiveNodeTypes: file.ObjectiveNodeTypes,
+		ROEPolicy: policy, ROEPreset: file.ROEPreset, BeamObjective: file.BeamObjective, StagnationPenalty: file.StagnationPenalty,
+		DiversityFactor: file.DiversityFactor, EdgeImportance: file.EdgeImportance, RandomTieBreak: file.RandomTieBreak, TieBreakSeed: file.TieBreakSeed,
+		MinObjectiveConfidence: file.MinObjectiveConfidence,
+	}, nil
+}
+
+// SaveAttackPathConfig writes cfg as a search profile, as YAML or JSON by file extension. ROEPolicy is
+// persisted by its preset name, so a config whose ROEPolicy is not the policy its ROEPreset resolves to is
+// rejected: saving it under the preset name would change the policy on reload.
+func SaveAttackPathConfig(path string, cfg AttackPathConfig) error {
+	preset := cfg.ROEPreset
+	if preset == "" {
+		if cfg.ROEPolicy != nil {
+			return fmt.Errorf("attack path config has a custom roe policy without a preset name")
+		}
+		preset = ROEPresetAllowAll
+	}
+	policy, err := ResolveROEPreset(preset)
+	if err != nil {
+		return err
+	}
+	if cfg.ROEPolicy != nil && reflect.ValueOf(cfg.ROEPolicy).Pointer() != reflect.ValueOf(policy).Pointer() {
+		return fmt.Errorf("attack path config roe policy does not match roe preset %q", preset)
+	}
+	return writeSearchProfile(path, attackPathConfigFile{
+		MaxDepth: cfg.MaxDepth, BeamWidth: cfg.BeamWidth, RiskThreshold: cfg.RiskThreshold, DepthPenalty: cfg.DepthPenalty,
+		ConfidenceWeight: cfg.ConfidenceWeight, StartNodeTypes: cfg.StartNodeTypes, ObjectiveNodeTypes: cfg.ObjectiveNodeTypes,
+		ROEPreset: preset, BeamObjective: cfg.BeamObjective, StagnationPenalty: cfg.StagnationPenalty, DiversityFactor: cfg.DiversityFactor,
+		EdgeImportance: cfg.EdgeImportance, RandomTieBreak: cfg.RandomTieBreak, TieBreakSeed: cfg.TieBreakSeed,
+		MinObjectiveConfidence: cfg.MinObjectiveConfidence,
+	})
+}
+
+// LoadCampaignOptions reads campaign options, as YAML or JSON by file extension like LoadAttackPathConfig. Omitted fields keep DefaultCampaignOptions values.
+func LoadCampaignOptions(path string) (CampaignOptions, error) {
+	def := DefaultCampaignOptions()
+	file := campaignOptionsFile(def)
+	if err := decodeSearchProfile(path, &file); err != nil {
+		return CampaignOptions{}, err
+	}
+	if file.MaxDepth <= 0 || file.BeamWidth <= 0 || file.TopN <= 0 {
+		return CampaignOptions{}, fmt.Errorf("campaign options %s: max_depth, beam_width and top_n must be positive", path)
+	}
+	if file.RiskTolerance < 0 || file.MaxStepRisk < 0 || file.ObjectiveBiasWeight < 0 || file.ObjectiveProximityScore < 0 {
+		return CampaignOptions{}, fmt.Errorf("campaign options %s: weights and tolerances must be non-negative", path)
+	}
+	if file.ConfidenceThreshold < 0 || file.ConfidenceThreshold > 1 {
+		return CampaignOptions{}, fmt.Errorf("campaign options %s: confidence_threshold must be within [0,1]", path)
+	}
+	if err := validateBeamObjective(file.BeamObjective); err != nil {
+		return CampaignOptions{}, fmt.Errorf("campaign options %s: %w", path, err)
+	}
+	switch file.ConfidenceAggregation {
+	case ConfidenceAggregationMean, ConfidenceAggregationMin, ConfidenceAggregationGeometric:
+	default:
+		return CampaignOptions{}, fmt.Errorf("campaign options %s: unknown confidence aggregation %q", path, file.ConfidenceAggregation)
+	}
+	switch file.ObjectiveBiasDecay {
+	case ObjectiveBiasDecayUniform, ObjectiveBiasDecayRamp, ObjectiveBiasDecayGeometric:
+	default:
+		return CampaignOptions{}, fmt.Errorf("campaign options %s: unknown objective bias decay %q", path, file.ObjectiveBiasDecay)
+	}
+	seen := map[OperationPhase]bool{}
+	for _, d := range file.PhaseDeadlines {
+		if err := d.Phase.Validate(); err != nil {
+			return CampaignOptions{}, fmt.Errorf("campaign options %s: phase_deadlines: %w", path, err)
+		}
+		if d.Deadline <= 0 {
+			return CampaignOptions{}, fmt.Errorf("campaign options %s: phase_deadlines.%s must be positive", path, d.Phase)
+		}
+		if seen[d.Phase] {
+			return CampaignOptions{}, fmt.Errorf("campaign options %s: phase_deadlines.%s is listed twice", path, d.Phase)
+		}
+		seen[d.Phase] = true
+	}
+	file.PhaseDeadlines = sortedPhaseDeadlines(file.PhaseDeadlines)
+	return CampaignOptions(file), nil
+}
+
+// sortedPhaseDeadlines returns deadlines in lifecycle order.
+func sortedPhaseDeadlines(deadlines []PhaseDeadline) []PhaseDeadline {
+	if len(deadlines) == 0 {
+		return nil
+	}
+	out := make([]PhaseDeadline, 0, len(deadlines))
+	for _, phase := range state.Phases() {
+		for _, d := range deadlines {
+			if d.Phase == phase {
+				out = append(out, d)
+			}
+		}
+	}
+	return out
+}
+
+// SaveCampaignOptions writes opts as campaign options, as YAML or JSON by file extension.
+func SaveCampaignOptions(path string, opts CampaignOptions) error {
+	opts.PhaseDeadlines = sortedPhaseDeadlines(opts.PhaseDeadlines)
+	return writeSearchProfile(path, campaignOptionsFile(opts))
+}
+
+func validateBeamObjective(objective BeamObjective) error {
+	switch objective {
+	case BeamObjectiveMaxScore, BeamObjectiveMinRisk, BeamObjectiveMaxConfidence:
+		return nil
+	}
+	return fmt.Errorf("unknown beam objective %q", objective)
+}
+
+func decodeSearchProfile(path string, out any) error {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if isYAMLProfile(path) {
+		if raw, err = searchProfileYAMLToJSON(raw); err != nil {
+			return fmt.Errorf("decode search profile %s: %w", path, err)
+		}
+	}
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(out); err != nil {
+		return fmt.Errorf("decode search profile %s: %w", path, err)
+	}
+	return nil
+}
+
+func writeSearchProfile(path string, in any) error {
+	raw, err := json.MarshalIndent(in, "", "  ")
+	if err != nil {
+		return err
+	}
+	if isYAMLProfile(path) {
+		if raw, err = searchProfileJSONToYAML(raw); err != nil {
+			return err
+		}
+		return os.WriteFile(path, raw, 0o644)
+	}
+	return os.WriteFile(path, append(raw, '\n'), 0o644)
+}
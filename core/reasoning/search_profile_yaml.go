@@ -0,0 +1,114 @@
+package reasoning
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// isYAMLProfile reports whether path names a YAML search profile by its extension.
+func isYAMLProfile(path string) bool {
+	ext := strings.ToLower(filepath.Ext(path))
+	return ext == ".yaml" || ext == ".yml"
+}
+
+// searchProfileYAMLToJSON converts the YAML subset search profiles use into JSON so both formats share one
+// decoder and its unknown-field checks. The subset is a flat mapping of scalars and inline lists, plus
+// nested mappings one level deep for map-valued fields such as edge_importance.
+func searchProfileYAMLToJSON(raw []byte) ([]byte, error) {
+	root := map[string]any{}
+	var nested map[string]any
+	scanner := bufio.NewScanner(bytes.NewReader(raw))
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		text := strings.TrimRight(scanner.Text(), " \t\r")
+		trimmed := strings.TrimSpace(text)
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") || trimmed == "---" {
+			continue
+		}
+		key, value, ok := strings.Cut(trimmed, ":")
+		if !ok {
+			return nil, fmt.Errorf("line %d: expected key: value", lineNo)
+		}
+		key, value = trimScalar(key), strings.TrimSpace(value)
+		indented := text[0] == ' ' || text[0] == '\t'
+		switch {
+		case indented && nested == nil:
+			return nil, fmt.Errorf("line %d: unexpected indentation", lineNo)
+		case indented:
+			nested[key] = yamlValue(value)
+		case value == "":
+			nested = map[string]any{}
+			root[key] = nested
+		default:
+			nested = nil
+			root[key] = yamlValue(value)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return json.Marshal(root)
+}
+
+// yamlValue interprets a scalar or inline list; JSON-compatible values keep their JSON type and anything
+// else is read as a plain string.
+func yamlValue(v string) any {
+	var decoded any
+	if err := json.Unmarshal([]byte(v), &decoded); err == nil {
+		return decoded
+	}
+	if strings.HasPrefix(v, "[") && strings.HasSuffix(v, "]") {
+		items := parseInlineList(v)
+		out := make([]any, 0, len(items))
+		for _, item := range items {
+			out = append(out, yamlValue(item))
+		}
+		return out
+	}
+	return trimScalar(v)
+}
+
+// searchProfileJSONToYAML renders a JSON search profile in the YAML subset searchProfileYAMLToJSON reads.
+// Values are written in JSON flow form, which is valid YAML.
+func searchProfileJSONToYAML(raw []byte) ([]byte, error) {
+	var root map[string]any
+	if err := json.Unmarshal(raw, &root); err != nil {
+		return nil, err
+	}
+	var b bytes.Buffer
+	for _, key := range sortedKeys(root) {
+		if m, ok := root[key].(map[string]any); ok {
+			if len(m) == 0 {
+				continue
+			}
+			fmt.Fprintf(&b, "%s:\n", key)
+			for _, k := range sortedKeys(m) {
+				v, err := json.Marshal(m[k])
+				if err != nil {
+					return nil, err
+				}
+				fmt.Fprintf(&b, "  %s: %s\n", k, v)
+			}
+			continue
+		}
+		v, err := json.Marshal(root[key])
+		if err != nil {
+			return nil, err
+		}
+		fmt.Fprintf(&b, "%s: %s\n", key, v)
+	}
+	return b.Bytes(), nil
+}
+
+func sortedKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
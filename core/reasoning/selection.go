@@ -0,0 +1,43 @@
+package reasoning
+
+import (
+	"fmt"
+	"math/rand"
+	"sync"
+)
+
+// EpsilonGreedyPolicy exploits the top-ranked action except on a seeded fraction of selections, where it
+// explores a uniformly drawn alternative among the next best TopK-1 actions. Exploring lower-ranked options
+// across a long loop surfaces graph state the best action alone would never reveal. The same seed and
+// rankings always yield the same selections.
+type EpsilonGreedyPolicy struct {
+	epsilon float64
+	topK    int
+
+	mu  sync.Mutex
+	rng *rand.Rand
+}
+
+// NewEpsilonGreedyPolicy returns a policy exploring with probability epsilon within the top topK actions.
+func NewEpsilonGreedyPolicy(epsilon float64, topK int, seed int64) (*EpsilonGreedyPolicy, error) {
+	if epsilon < 0 || epsilon > 1 {
+		return nil, fmt.Errorf("explore epsilon must be within [0,1], got %v", epsilon)
+	}
+	if topK < 2 {
+		return nil, fmt.Errorf("explore top-k must be at least 2, got %d", topK)
+	}
+	return &EpsilonGreedyPolicy{epsilon: epsilon, topK: topK, rng: rand.New(rand.NewSource(seed))}, nil
+}
+
+// Select returns ranked[0] or, on an exploring draw, one of ranked[1:topK]. A ranking with a single action
+// is always exploited.
+func (p *EpsilonGreedyPolicy) Select(ranked []RankedAction) RankedAction {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	explore := p.rng.Float64() < p.epsilon
+	alternatives := min(p.topK, len(ranked)) - 1
+	if !explore || alternatives < 1 {
+		return ranked[0]
+	}
+	return ranked[1+p.rng.Intn(alternatives)]
+}
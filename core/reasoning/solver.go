@@ -0,0 +1,131 @@
+package reasoning
+
+import (
+	"fmt"
+	"sort"
+)
+
+// SolveCampaign searches backward from the objective for the shortest action-class chain, at most maxDepth
+// long, whose preconditions are satisfiable from the current graph. Iterative deepening over every
+// producer keeps the search complete within maxDepth where PlanCampaign's beam may prune a feasible chain.
+// Risk and confidence thresholds are not applied. It returns nil when no chain exists.
+func (e *Engine) SolveCampaign(objective NodeType, maxDepth int) (*Campaign, error) {
+	if e == nil {
+		return nil, fmt.Errorf("engine is nil")
+	}
+	if objective == "" {
+		return nil, fmt.Errorf("objective is required")
+	}
+	if maxDepth <= 0 {
+		return nil, fmt.Errorf("max depth must be positive")
+	}
+
+	inputs := e.capturePlanInputs(CampaignOptions{})
+	if inputs.snapshot == nil {
+		return nil, fmt.Errorf("start graph is nil")
+	}
+	classes := e.boundActionClasses()
+	sort.Slice(classes, func(i, j int) bool { return classes[i].ID < classes[j].ID })
+
+	s := &campaignSolver{base: inputs.snapshot, root: inputs.phase, classes: classes}
+	for depth := 1; depth <= maxDepth; depth++ {
+		if chain := s.regress(map[string]struct{}{nodeGoal(objective): {}}, nil, depth); chain != nil {
+			steps := make([]AttackStep, 0, len(chain))
+			for i, ac := range chain {
+				steps = append(steps, attackStepForAction(ac, i+1))
+			}
+			return &Campaign{Steps: steps, Risk: cumulativeRisk(chain), Objective: objective, Confidence: averageCampaignConfidence(steps), Impact: cumulativeImpact(chain), Meta: newCampaignMeta(classes, CampaignOptions{MaxDepth: maxDepth})}, nil
+		}
+	}
+	return nil, nil
+}
+
+// campaignSolver regresses goal sets of node and edge types through producing action classes.
+type campaignSolver struct {
+	base    *graphSnapshot
+	root    OperationPhase
+	classes []ActionClass
+}
+
+func nodeGoal(n NodeType) string { return "node:" + string(n) }
+func edgeGoal(t EdgeType) string { return "edge:" + string(t) }
+
+// regress picks a producer for an open goal and recurses on the goals left after it, with chain holding
+// producers in reverse execution order. Graphs only grow, so once no goal is open the reversed chain
+// satisfies every precondition; only its phase ordering remains to be checked.
+func (s *campaignSolver) regress(goals map[string]struct{}, chain []ActionClass, budget int) []ActionClass {
+	if len(goals) == 0 {
+		forward := make([]ActionClass, len(chain))
+		for i, ac := range chain {
+			forward[len(chain)-1-i] = ac
+		}
+		if s.phaseOrdered(forward) {
+			return forward
+		}
+		return nil
+	}
+	if budget == 0 {
+		return nil
+	}
+	for _, ac := range s.classes {
+		if inChain(chain, ac.ID) || !producesAnyGoal(ac, goals) {
+			continue
+		}
+		next := map[string]struct{}{}
+		for g := range goals {
+			next[g] = struct{}{}
+		}
+		for _, n := range ac.ProducesNodes {
+			delete(next, nodeGoal(n))
+		}
+		for _, t := range ac.ProducesEdges {
+			delete(next, edgeGoal(t))
+		}
+		for _, n := range requiredNodes(ac.Preconditions) {
+			if !s.base.hasNodeType(n) {
+				next[nodeGoal(n)] = struct{}{}
+			}
+		}
+		for _, t := range requiredEdges(ac.Preconditions) {
+			if !s.base.hasEdgeType(t) {
+				next[edgeGoal(t)] = struct{}{}
+			}
+		}
+		if found := s.regress(next, append(chain, ac), budget-1); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+func (s *campaignSolver) phaseOrdered(chain []ActionClass) bool {
+	for i, ac := range chain {
+		if sequenceStepRejection(s.root, chain[:i], ac) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+func producesAnyGoal(ac ActionClass, goals map[string]struct{}) bool {
+	for _, n := range ac.ProducesNodes {
+		if _, ok := goals[nodeGoal(n)]; ok {
+			return true
+		}
+	}
+	for _, t := range ac.ProducesEdges {
+		if _, ok := goals[edgeGoal(t)]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+func inChain(chain []ActionClass, id string) bool {
+	for _, ac := range chain {
+		if ac.ID == id {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,49 @@
+package reasoning
+
+import (
+	"fmt"
+	"math/rand"
+
+	"vantage/core/state"
+)
+
+// syntheticObjectiveEvery makes every n-th synthetic class produce NodeTypeDataExposure.
+const syntheticObjectiveEvery = 10
+
+// GenerateSyntheticClasses builds a deterministic corpus of n recon-phase action classes for tests and
+// benchmarks. Every class requires evidence or a node type produced by an earlier class, so the whole
+// corpus is reachable from an evidence seed, and every syntheticObjectiveEvery-th class (and always the
+// last) produces NodeTypeDataExposure.
+func GenerateSyntheticClasses(n int, seed int64) []ActionClass {
+	if n <= 0 {
+		return nil
+	}
+	rng := rand.New(rand.NewSource(seed))
+	available := []NodeType{NodeTypeEvidence}
+	classes := make([]ActionClass, 0, n)
+	for i := 0; i < n; i++ {
+		required := []NodeType{available[rng.Intn(len(available))]}
+		if len(available) > 2 && rng.Intn(3) == 0 {
+			if extra := available[rng.Intn(len(available))]; extra != required[0] {
+				required = append(required, extra)
+			}
+		}
+		produced := NodeType(fmt.Sprintf("synthetic-%04d", i))
+		if (i+1)%syntheticObjectiveEvery == 0 || i == n-1 {
+			produced = NodeTypeDataExposure
+		} else {
+			available = append(available, produced)
+		}
+		classes = append(classes, ActionClass{
+			ID:              fmt.Sprintf("AC-SYN-%04d", i),
+			Name:            fmt.Sprintf("synthetic %d", i),
+			Phase:           state.PhaseRecon,
+			Preconditions:   []GraphPattern{{RequiredNodeTypes: required}},
+			ProducesNodes:   []NodeType{produced},
+			RiskWeight:      0.01 + rng.Float64()*0.2,
+			ImpactWeight:    rng.Float64(),
+			ConfidenceBoost: 0.05 + rng.Float64()*0.3,
+		})
+	}
+	return classes
+}
@@ -0,0 +1,125 @@
+package reasoning
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"vantage/techniques"
+)
+
+// deriveTechniqueEffects builds one effect per registered technique from its own risk and impact modifiers.
+// Stealth mirrors the planner's convention of treating it as the complement of risk. Effects follow
+// techniques.List order so registration is reproducible.
+func deriveTechniqueEffects() []TechniqueEffect {
+	all := techniques.List()
+	out := make([]TechniqueEffect, 0, len(all))
+	for _, tech := range all {
+		out = append(out, TechniqueEffect{
+			TechniqueID:   tech.ID(),
+			ActionClassID: tech.ActionClassID(),
+			Impact:        tech.ImpactModifier(),
+			Risk:          tech.RiskModifier(),
+			Stealth:       1 - tech.RiskModifier(),
+		})
+	}
+	return out
+}
+
+// LoadTechniqueEffects overrides registered technique effects from a YAML mapping of technique ID to
+// impact, risk, stealth, and produces. Fields omitted for a technique keep their current values, so a file
+// only needs to list what it changes. Every technique must already have a registered effect, so a typo
+// cannot introduce an unplanned technique. The file is validated in full before any effect is registered.
+func (e *Engine) LoadTechniqueEffects(path string) error {
+	overrides, err := parseTechniqueEffectsYAML(path)
+	if err != nil {
+		return err
+	}
+	effects := make([]TechniqueEffect, 0, len(overrides))
+	for _, o := range overrides {
+		effect, ok := e.registry.EffectForTechnique(o.id)
+		if !ok {
+			return fmt.Errorf("technique effects %s: unknown technique %q", path, o.id)
+		}
+		for key, value := range o.fields {
+			if key == "produces" {
+				effect.Produces = parseInlineList(value)
+				continue
+			}
+			v, err := strconv.ParseFloat(trimScalar(value), 64)
+			if err != nil || v < 0 || v > 1 {
+				return fmt.Errorf("technique effects %s: %s.%s must be a number within [0,1]", path, o.id, key)
+			}
+			switch key {
+			case "impact":
+				effect.Impact = v
+			case "risk":
+				effect.Risk = v
+			case "stealth":
+				effect.Stealth = v
+			}
+		}
+		effects = append(effects, effect)
+	}
+	for _, effect := range effects {
+		e.registry.RegisterTechniqueEffect(effect)
+	}
+	return nil
+}
+
+// techniqueEffectOverride holds the raw fields declared for one technique in an effects file.
+type techniqueEffectOverride struct {
+	id     string
+	fields map[string]string
+}
+
+func parseTechniqueEffectsYAML(path string) ([]techniqueEffectOverride, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var out []techniqueEffectOverride
+	seen := map[string]struct{}{}
+	scanner := bufio.NewScanner(f)
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		raw := scanner.Text()
+		line := strings.TrimSpace(raw)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			return nil, fmt.Errorf("technique effects %s:%d: expected key: value", path, lineNo)
+		}
+		key, value = strings.TrimSpace(key), strings.TrimSpace(value)
+		if raw == strings.TrimLeft(raw, " \t") {
+			id := trimScalar(key)
+			if id == "" || value != "" {
+				return nil, fmt.Errorf("technique effects %s:%d: expected a technique ID mapping", path, lineNo)
+			}
+			if _, dup := seen[id]; dup {
+				return nil, fmt.Errorf("technique effects %s:%d: technique %s declared more than once", path, lineNo, id)
+			}
+			seen[id] = struct{}{}
+			out = append(out, techniqueEffectOverride{id: id, fields: map[string]string{}})
+			continue
+		}
+		if len(out) == 0 {
+			return nil, fmt.Errorf("technique effects %s:%d: field outside a technique mapping", path, lineNo)
+		}
+		switch key {
+		case "impact", "risk", "stealth", "produces":
+			out[len(out)-1].fields[key] = value
+		default:
+			return nil, fmt.Errorf("technique effects %s:%d: unknown field %q", path, lineNo, key)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
@@ -1,8 +1,11 @@
 package tests
 
 import (
+	"fmt"
+	"reflect"
 	"testing"
 
+	"vantage/core/evidence"
 	"vantage/core/reasoning"
 	"vantage/core/state"
 )
@@ -88,3 +91,201 @@ func TestPhaseRestrictionEnforced(t *testing.T) {
 		t.Fatalf("expected no hypotheses for mismatched phase")
 	}
 }
+
+func TestActionClassHypothesisConfidenceFollowsSupportingEvidence(t *testing.T) {
+	// Evidence without an edge to the hypothesis carries the opposite confidence and must not count.
+	confidenceWith := func(supporting, unrelated string) float64 {
+		eng := reasoning.NewEngine(nil)
+		st, _ := state.New("camp")
+		eng.BindActionClasses([]reasoning.ActionClass{{
+			ID:              "AC-SUPPORT",
+			Name:            "Support",
+			Phase:           state.PhaseRecon,
+			ConfidenceBoost: 0.1,
+			Preconditions:   []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{reasoning.NodeTypeEvidence}}},
+		}})
+		eng.Graph().UpsertNode(&reasoning.Node{ID: "ev-support", Type: reasoning.NodeTypeEvidence, Label: "evidence", Metadata: map[string]string{"confidence": supporting}})
+		eng.Graph().UpsertNode(&reasoning.Node{ID: "ev-unrelated", Type: reasoning.NodeTypeEvidence, Label: "evidence", Metadata: map[string]string{"confidence": unrelated}})
+		eng.ConfigureCycle(reasoning.CycleConfig{Target: "host", AllowedTechniques: []string{"T-1"}, Executor: &executorStub{}})
+		_, _ = eng.RunCycle(st)
+		if err := eng.Graph().AddEdge(&reasoning.Edge{From: "ev-support", To: "hyp-ac-AC-SUPPORT", Type: reasoning.EdgeTypeSupports, Weight: 1}); err != nil {
+			t.Fatalf("add supports edge: %v", err)
+		}
+
+		for _, hyp := range eng.GenerateHypotheses() {
+			if hyp.ActionClassID == "AC-SUPPORT" {
+				if !reflect.DeepEqual(hyp.SupportingNodeIDs, []string{"ev-support"}) {
+					t.Fatalf("expected only the linked evidence as support, got %v", hyp.SupportingNodeIDs)
+				}
+				return hyp.Confidence
+			}
+		}
+		t.Fatalf("expected action-class derived hypothesis")
+		return 0
+	}
+
+	strong := confidenceWith("0.95", "0.2")
+	weak := confidenceWith("0.2", "0.95")
+	if strong <= weak {
+		t.Fatalf("expected strongly supported hypothesis to outrank weakly supported one, got %.3f <= %.3f", strong, weak)
+	}
+	if strong > 1 || weak < 0 {
+		t.Fatalf("expected confidences to stay within [0,1], got %.3f and %.3f", strong, weak)
+	}
+}
+
+func TestActionClassHypothesisCapsSupportingEvidence(t *testing.T) {
+	g := reasoning.NewGraph()
+	st, _ := state.New("camp")
+	binder := reasoning.NewDefaultActionBinder()
+	binder.BindActionClasses([]reasoning.ActionClass{{ID: "AC-SUPPORT", Name: "Support", Phase: state.PhaseRecon, ConfidenceBoost: 0.1, Preconditions: []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{reasoning.NodeTypeEvidence}}}}})
+	g.UpsertNode(&reasoning.Node{ID: "hyp-ac-AC-SUPPORT", Type: reasoning.NodeTypeHypothesis, Label: "support"})
+	for i := 0; i < reasoning.MaxSupportingNodes+4; i++ {
+		id := fmt.Sprintf("ev-%02d", i)
+		g.UpsertNode(&reasoning.Node{ID: id, Type: reasoning.NodeTypeEvidence, Label: id})
+		if err := g.AddEdge(&reasoning.Edge{From: id, To: "hyp-ac-AC-SUPPORT", Type: reasoning.EdgeTypeSupports, Weight: float64(i+1) / 100}); err != nil {
+			t.Fatalf("add supports edge: %v", err)
+		}
+	}
+
+	matched, err := binder.MatchAndGenerate(g, st)
+	if err != nil || len(matched) != 1 {
+		t.Fatalf("expected one action-class hypothesis, got %+v (%v)", matched, err)
+	}
+	ids := matched[0].SupportingNodeIDs
+	if len(ids) != reasoning.MaxSupportingNodes || ids[0] != fmt.Sprintf("ev-%02d", reasoning.MaxSupportingNodes+3) {
+		t.Fatalf("expected the %d strongest supports, got %v", reasoning.MaxSupportingNodes, ids)
+	}
+}
+
+func TestContradictingEvidenceRefutesAndDiscountsHypothesis(t *testing.T) {
+	eng := reasoning.NewEngine(nil)
+	st, _ := state.New("camp")
+	evidence := []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{reasoning.NodeTypeEvidence}}}
+	eng.BindActionClasses([]reasoning.ActionClass{
+		{ID: "AC-01", Name: "Passive", Phase: state.PhaseRecon, Preconditions: evidence, ImpactWeight: 0.6, RiskWeight: 0.4, ConfidenceBoost: 0.1},
+		{ID: "AC-02", Name: "Active", Phase: state.PhaseRecon, Preconditions: evidence, ImpactWeight: 0.6, RiskWeight: 0.4, ConfidenceBoost: 0.1},
+	})
+	eng.Graph().UpsertNode(&reasoning.Node{ID: "ev-seed", Type: reasoning.NodeTypeEvidence, Label: "seed"})
+	eng.ConfigureCycle(reasoning.CycleConfig{Target: "host", Executor: &executorStub{}})
+	_, _ = eng.RunCycle(st)
+
+	hypothesisFor := func(id string) reasoning.Hypothesis {
+		for _, h := range eng.GenerateHypotheses() {
+			if h.ActionClassID == id {
+				return h
+			}
+		}
+		t.Fatalf("expected hypothesis for %s", id)
+		return reasoning.Hypothesis{}
+	}
+	before := hypothesisFor("AC-01")
+	if before.Status != reasoning.HypothesisStatusOpen {
+		t.Fatalf("expected open hypothesis before evidence, got %s", before.Status)
+	}
+
+	failed := reasoning.EvidenceEvent{TechniqueID: "AC01PassiveDNSCollection", Target: "host", Success: false}
+	if err := eng.IngestEvidence(failed); err != nil {
+		t.Fatalf("ingest evidence: %v", err)
+	}
+	if once := hypothesisFor("AC-01"); once.Status != reasoning.HypothesisStatusOpen {
+		t.Fatalf("expected a single failure to leave the hypothesis open, got %s", once.Status)
+	}
+	if err := eng.IngestEvidence(reasoning.EvidenceEvent{TechniqueID: "AC01PassiveDNSCollection", Target: "other-host", Success: false}); err != nil {
+		t.Fatalf("ingest evidence: %v", err)
+	}
+	if elsewhere := hypothesisFor("AC-01"); elsewhere.Status != reasoning.HypothesisStatusOpen {
+		t.Fatalf("expected a failure on another target not to count toward refutation, got %s", elsewhere.Status)
+	}
+	if err := eng.IngestEvidence(failed); err != nil {
+		t.Fatalf("ingest evidence: %v", err)
+	}
+	after := hypothesisFor("AC-01")
+	if after.Status != reasoning.HypothesisStatusRefuted || after.Confidence >= before.Confidence {
+		t.Fatalf("expected refuted, discounted hypothesis, got %s %.3f (was %.3f)", after.Status, after.Confidence, before.Confidence)
+	}
+	if node, ok := eng.Graph().Node("hyp-ac-AC-01"); !ok || node.Metadata["status"] != string(reasoning.HypothesisStatusRefuted) {
+		t.Fatalf("expected hypothesis node to carry refuted status")
+	}
+	if other := hypothesisFor("AC-02"); other.Status != reasoning.HypothesisStatusOpen {
+		t.Fatalf("expected unrelated hypothesis to stay open, got %s", other.Status)
+	}
+
+	decision, err := eng.PlanNextAction(reasoning.PlannerQuery{Target: "host"})
+	if err != nil {
+		t.Fatalf("plan next action: %v", err)
+	}
+	if decision.Selected.ActionClassID == "AC-01" {
+		t.Fatalf("expected refuted AC-01 actions to be discounted below AC-02, ranked %+v", decision.Ranked)
+	}
+}
+
+func TestRunCycleRefutesBoundHypothesisOnRepeatedFailure(t *testing.T) {
+	eng := reasoning.NewEngine(nil)
+	st, _ := state.New("camp")
+	preconditions := []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{reasoning.NodeTypeEvidence}}}
+	eng.BindActionClasses([]reasoning.ActionClass{
+		{ID: "AC-01", Name: "Passive", Phase: state.PhaseRecon, Preconditions: preconditions, ImpactWeight: 0.6, RiskWeight: 0.4, ConfidenceBoost: 0.1},
+	})
+	eng.Graph().UpsertNode(&reasoning.Node{ID: "ev-seed", Type: reasoning.NodeTypeEvidence, Label: "seed"})
+	failed := &evidence.Artifact{TechniqueID: "AC01PassiveDNSCollection", Target: "host", Success: false}
+	eng.ConfigureCycle(reasoning.CycleConfig{Target: "host", AllowedTechniques: []string{"AC01PassiveDNSCollection"}, Executor: &executorStub{artifact: failed}})
+
+	for i := 0; i < reasoning.RefuteAfterFailures; i++ {
+		decision, err := eng.RunCycle(st)
+		if err != nil {
+			t.Fatalf("run cycle %d: %v", i+1, err)
+		}
+		if decision.Selected.ActionClassID != "AC-01" {
+			t.Fatalf("expected the bound AC-01 technique to run, got %+v", decision.Selected)
+		}
+	}
+
+	for _, h := range eng.GenerateHypotheses() {
+		if h.ActionClassID == "AC-01" {
+			if h.Status != reasoning.HypothesisStatusRefuted {
+				t.Fatalf("expected repeated failed cycles to refute AC-01, got %s", h.Status)
+			}
+			return
+		}
+	}
+	t.Fatal("expected a hypothesis for AC-01")
+}
+
+func TestRefutedDiscountLowersNegativeScores(t *testing.T) {
+	eng := reasoning.NewEngine(nil)
+	st, _ := state.New("camp")
+	preconditions := []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{reasoning.NodeTypeEvidence}}}
+	eng.BindActionClasses([]reasoning.ActionClass{
+		{ID: "AC-01", Name: "Passive", Phase: state.PhaseRecon, Preconditions: preconditions, ImpactWeight: 0.6, RiskWeight: 0.4, ConfidenceBoost: 0.1},
+	})
+	eng.Graph().UpsertNode(&reasoning.Node{ID: "ev-seed", Type: reasoning.NodeTypeEvidence, Label: "seed"})
+	// The cycle only attaches st; its executor returns nothing.
+	eng.ConfigureCycle(reasoning.CycleConfig{Target: "host", Executor: &executorStub{}})
+	_, _ = eng.RunCycle(st)
+	for i := 0; i < 10; i++ {
+		st.RecordActionMemory("AC-01", false, false)
+	}
+
+	query := reasoning.PlannerQuery{Target: "host", AllowedTechniques: []string{"AC01PassiveDNSCollection"}}
+	open, err := eng.PlanNextAction(query)
+	if err != nil {
+		t.Fatalf("plan next action: %v", err)
+	}
+	if open.Selected.Score >= 0 {
+		t.Fatalf("expected recorded failures to push the score negative, got %.3f", open.Selected.Score)
+	}
+	failed := reasoning.EvidenceEvent{TechniqueID: "AC01PassiveDNSCollection", Target: "host", Success: false}
+	for i := 0; i < reasoning.RefuteAfterFailures; i++ {
+		if err := eng.IngestEvidence(failed); err != nil {
+			t.Fatalf("ingest evidence: %v", err)
+		}
+	}
+	refuted, err := eng.PlanNextAction(query)
+	if err != nil {
+		t.Fatalf("plan next action: %v", err)
+	}
+	if refuted.Selected.Score >= open.Selected.Score {
+		t.Fatalf("expected refutation to lower the negative score %.3f, got %.3f", open.Selected.Score, refuted.Selected.Score)
+	}
+}
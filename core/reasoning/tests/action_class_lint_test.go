@@ -0,0 +1,42 @@
+package tests
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"vantage/core/reasoning"
+	"vantage/core/state"
+)
+
+func TestFindDuplicateActionClassesGroupsIdenticalBehavior(t *testing.T) {
+	evidenceThenEnables := []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{reasoning.NodeTypeEvidence}}, {RequiredEdges: []reasoning.EdgeType{reasoning.EdgeTypeEnables}}}
+	enablesThenEvidence := []reasoning.GraphPattern{{RequiredEdges: []reasoning.EdgeType{reasoning.EdgeTypeEnables}}, {RequiredNodeTypes: []reasoning.NodeType{reasoning.NodeTypeEvidence}}}
+	classes := []reasoning.ActionClass{
+		{ID: "AC-B", Name: "second", Phase: state.PhaseRecon, Preconditions: enablesThenEvidence, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeHypothesis}, RiskWeight: 0.2, ImpactWeight: 0.5, ConfidenceBoost: 0.1},
+		{ID: "AC-A", Name: "first", Phase: state.PhaseRecon, Preconditions: evidenceThenEnables, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeHypothesis}, RiskWeight: 0.2, ImpactWeight: 0.5, ConfidenceBoost: 0.1},
+		{ID: "AC-C", Name: "riskier", Phase: state.PhaseRecon, Preconditions: evidenceThenEnables, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeHypothesis}, RiskWeight: 0.3, ImpactWeight: 0.5, ConfidenceBoost: 0.1},
+		{ID: "AC-D", Name: "slower", Phase: state.PhaseRecon, Preconditions: evidenceThenEnables, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeHypothesis}, RiskWeight: 0.2, ImpactWeight: 0.5, ConfidenceBoost: 0.1, Duration: time.Hour},
+		{ID: "AC-E", Name: "weighted", Phase: state.PhaseRecon, Preconditions: []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{reasoning.NodeTypeEvidence}, Weight: 2}, {RequiredEdges: []reasoning.EdgeType{reasoning.EdgeTypeEnables}}}, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeHypothesis}, RiskWeight: 0.2, ImpactWeight: 0.5, ConfidenceBoost: 0.1},
+		{ID: "AC-F", Name: "explicit default weight", Phase: state.PhaseRecon, Preconditions: []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{reasoning.NodeTypeEvidence}, Weight: 1}, {RequiredEdges: []reasoning.EdgeType{reasoning.EdgeTypeEnables}}}, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeHypothesis}, RiskWeight: 0.2, ImpactWeight: 0.5, ConfidenceBoost: 0.1},
+	}
+
+	groups := reasoning.FindDuplicateActionClasses(classes)
+	if want := [][]string{{"AC-A", "AC-B", "AC-F"}}; !reflect.DeepEqual(groups, want) {
+		t.Fatalf("expected %v, got %v", want, groups)
+	}
+}
+
+func TestFindDeadEndActionClassesSkipsObjectiveTerminal(t *testing.T) {
+	classes := []reasoning.ActionClass{
+		{ID: "AC-PRODUCES", Phase: state.PhaseRecon, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeHypothesis}},
+		{ID: "AC-EDGE-ONLY", Phase: state.PhaseRecon, ProducesEdges: []reasoning.EdgeType{reasoning.EdgeTypeEnables}},
+		{ID: "AC-DEAD", Phase: state.PhaseInitialAccess},
+		{ID: "AC-OBJECTIVE", Phase: state.PhaseObjective},
+		{ID: "AC-EXFIL", Phase: state.PhaseExfil},
+	}
+
+	if got, want := reasoning.FindDeadEndActionClasses(classes), []string{"AC-DEAD"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+}
@@ -0,0 +1,40 @@
+package tests
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"vantage/core/reasoning"
+	"vantage/core/state"
+)
+
+func TestActionClassPhaseKeyOverridesInferredPhase(t *testing.T) {
+	dir := t.TempDir()
+	class := "id: AC-OVERRIDE\nname: Override\nintent_domains: [discovery]\nphase: impact\npreconditions: [network_reachability]\n"
+	if err := os.WriteFile(filepath.Join(dir, "AC-OVERRIDE.yaml"), []byte(class), 0o644); err != nil {
+		t.Fatalf("write class: %v", err)
+	}
+
+	classes, err := reasoning.LoadActionClassesFromDir(dir)
+	if err != nil {
+		t.Fatalf("load action classes: %v", err)
+	}
+	if len(classes) != 1 || classes[0].Phase != state.PhaseObjective {
+		t.Fatalf("expected phase override to yield %s, got %+v", state.PhaseObjective, classes)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "AC-OVERRIDE.yaml"), []byte("id: AC-OVERRIDE\nphase: LATERAL_MOVEMENT\n"), 0o644); err != nil {
+		t.Fatalf("write class: %v", err)
+	}
+	if classes, err := reasoning.LoadActionClassesFromDir(dir); err != nil || classes[0].Phase != state.PhaseLateralMovement {
+		t.Fatalf("expected canonical phase name to be accepted, got %+v err=%v", classes, err)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "AC-OVERRIDE.yaml"), []byte("id: AC-OVERRIDE\nphase: sideways\n"), 0o644); err != nil {
+		t.Fatalf("write class: %v", err)
+	}
+	if _, err := reasoning.LoadActionClassesFromDir(dir); err == nil {
+		t.Fatalf("expected unknown phase to be rejected")
+	}
+}
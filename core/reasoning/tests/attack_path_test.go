@@ -1,6 +1,9 @@
 package tests
 
 import (
+	"context"
+	"errors"
+	"math"
 	"testing"
 
 	"vantage/core/reasoning"
@@ -114,3 +117,367 @@ func TestExpandAttackPathsObjectiveReachability(t *testing.T) {
 		t.Fatalf("expected objective type %s, got %s", reasoning.NodeTypeAttackPath, paths[0].Objective)
 	}
 }
+
+func TestExpandAttackPathsStagnationPenaltyFavorsAdvancingPath(t *testing.T) {
+	const foothold reasoning.NodeType = "foothold"
+	cfg := reasoning.DefaultAttackPathConfig()
+	cfg.MaxDepth = 2
+	cfg.ObjectiveNodeTypes = []reasoning.NodeType{reasoning.NodeTypeDataExposure}
+	cfg.StagnationPenalty = 0.2
+	eng := reasoning.NewEngine(nil)
+	eng.ConfigureAttackPathExpansion(cfg)
+	eng.BindActionClasses([]reasoning.ActionClass{
+		{ID: "AC-1", Name: "scan", Phase: state.PhaseRecon, Preconditions: []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{reasoning.NodeTypeEvidence}}}, ProducesNodes: []reasoning.NodeType{foothold}, RiskWeight: 0.1, ConfidenceBoost: 0.2},
+		{ID: "AC-ADV", Name: "advance", Phase: state.PhaseInitialAccess, Preconditions: []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{foothold}}}, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeDataExposure}, RiskWeight: 0.2, ConfidenceBoost: 0.3},
+		{ID: "AC-CHURN", Name: "churn", Phase: state.PhaseRecon, Preconditions: []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{foothold}}}, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeDataExposure}, RiskWeight: 0.2, ConfidenceBoost: 0.3},
+	})
+	eng.Graph().UpsertNode(&reasoning.Node{ID: "ev-1", Type: reasoning.NodeTypeEvidence, Label: "seed"})
+	st, _ := state.New("stagnation")
+
+	paths, err := eng.ExpandAttackPaths(st)
+	if err != nil {
+		t.Fatalf("expand attack paths: %v", err)
+	}
+	scores := map[string]float64{}
+	for _, p := range paths {
+		if len(p.Steps) == 2 {
+			scores[p.Steps[1].ActionClassID] = p.Score
+		}
+	}
+	advancing, ok := scores["AC-ADV"]
+	if !ok {
+		t.Fatalf("expected advancing path, got %+v", paths)
+	}
+	churning, ok := scores["AC-CHURN"]
+	if !ok {
+		t.Fatalf("expected churning path, got %+v", paths)
+	}
+	if advancing <= churning {
+		t.Fatalf("expected advancing path to outscore recon churn: %.4f <= %.4f", advancing, churning)
+	}
+}
+
+func TestExpandAttackPathsDiversityFactorFavorsTypeDiversePath(t *testing.T) {
+	const foothold reasoning.NodeType = "foothold"
+	cfg := reasoning.DefaultAttackPathConfig()
+	cfg.MaxDepth = 2
+	cfg.ObjectiveNodeTypes = []reasoning.NodeType{reasoning.NodeTypeDataExposure}
+	cfg.DiversityFactor = 0.2
+	eng := reasoning.NewEngine(nil)
+	eng.ConfigureAttackPathExpansion(cfg)
+	eng.BindActionClasses([]reasoning.ActionClass{
+		{ID: "AC-1", Name: "scan", Phase: state.PhaseRecon, Preconditions: []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{reasoning.NodeTypeEvidence}}}, ProducesNodes: []reasoning.NodeType{foothold}, RiskWeight: 0.1, ConfidenceBoost: 0.2},
+		{ID: "AC-DIVERSE", Name: "diverse", Phase: state.PhaseRecon, Preconditions: []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{foothold}}}, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeDataExposure, reasoning.NodeTypeHypothesis}, RiskWeight: 0.2, ConfidenceBoost: 0.3},
+		{ID: "AC-MONO", Name: "mono", Phase: state.PhaseRecon, Preconditions: []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{foothold}}}, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeDataExposure, reasoning.NodeTypeDataExposure}, RiskWeight: 0.2, ConfidenceBoost: 0.3},
+	})
+	eng.Graph().UpsertNode(&reasoning.Node{ID: "ev-1", Type: reasoning.NodeTypeEvidence, Label: "seed"})
+	st, _ := state.New("diversity")
+
+	paths, err := eng.ExpandAttackPaths(st)
+	if err != nil {
+		t.Fatalf("expand attack paths: %v", err)
+	}
+	scores := map[string]float64{}
+	for _, p := range paths {
+		if len(p.Steps) == 2 {
+			scores[p.Steps[1].ActionClassID] = p.Score
+		}
+	}
+	diverse, okDiverse := scores["AC-DIVERSE"]
+	mono, okMono := scores["AC-MONO"]
+	if !okDiverse || !okMono {
+		t.Fatalf("expected both two-step paths, got %+v", scores)
+	}
+	if diverse <= mono {
+		t.Fatalf("expected type-diverse path to outscore monotone path: %.4f <= %.4f", diverse, mono)
+	}
+}
+
+func TestExpandAttackGraphCapturesConvergingBranches(t *testing.T) {
+	const credential reasoning.NodeType = "credential"
+	const reachability reasoning.NodeType = "reachability"
+	eng := reasoning.NewEngine(nil)
+	eng.BindActionClasses([]reasoning.ActionClass{
+		{ID: "AC-CRED", Name: "cred", Phase: state.PhaseRecon, Preconditions: []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{reasoning.NodeTypeEvidence}}}, ProducesNodes: []reasoning.NodeType{credential}, RiskWeight: 0.2},
+		{ID: "AC-REACH", Name: "reach", Phase: state.PhaseRecon, Preconditions: []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{reasoning.NodeTypeEvidence}}}, ProducesNodes: []reasoning.NodeType{reachability}, RiskWeight: 0.1},
+		{ID: "AC-OBJ", Name: "objective", Phase: state.PhaseRecon, Preconditions: []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{credential, reachability}}}, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeDataExposure}, RiskWeight: 0.3},
+	})
+	eng.Graph().UpsertNode(&reasoning.Node{ID: "ev-1", Type: reasoning.NodeTypeEvidence, Label: "seed"})
+	st, _ := state.New("attack-graph")
+
+	graphs, err := eng.ExpandAttackGraph(st, reasoning.NodeTypeDataExposure)
+	if err != nil {
+		t.Fatalf("expand attack graph: %v", err)
+	}
+	if len(graphs) != 1 {
+		t.Fatalf("expected one attack graph, got %d", len(graphs))
+	}
+	steps := graphs[0].Steps
+	if len(steps) != 3 {
+		t.Fatalf("expected three steps, got %+v", steps)
+	}
+	last := steps[len(steps)-1]
+	if last.ActionClassID != "AC-OBJ" {
+		t.Fatalf("expected objective step last, got %s", last.ActionClassID)
+	}
+	if len(last.DependsOn) != 2 || last.DependsOn[0] != "AC-CRED" || last.DependsOn[1] != "AC-REACH" {
+		t.Fatalf("expected objective to converge from both branches, got %v", last.DependsOn)
+	}
+	for _, step := range steps[:2] {
+		if len(step.DependsOn) != 0 {
+			t.Fatalf("expected %s to be satisfied by the current graph, got %v", step.ActionClassID, step.DependsOn)
+		}
+	}
+	if diff := graphs[0].Risk - 0.6; diff > 1e-9 || diff < -1e-9 {
+		t.Fatalf("expected cumulative risk 0.6, got %.4f", graphs[0].Risk)
+	}
+}
+
+func TestExpandAttackPathsEdgeImportanceWeighsFeasibility(t *testing.T) {
+	classes := []reasoning.ActionClass{
+		{ID: "AC-ENABLES", Name: "enables", Phase: state.PhaseRecon, Preconditions: []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{reasoning.NodeTypeEvidence}}, {RequiredEdges: []reasoning.EdgeType{reasoning.EdgeTypeEnables}}}, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeDataExposure}, RiskWeight: 0.2, ConfidenceBoost: 0.3},
+		{ID: "AC-SUPPORTS", Name: "supports", Phase: state.PhaseRecon, Preconditions: []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{reasoning.NodeTypeEvidence}}, {RequiredEdges: []reasoning.EdgeType{reasoning.EdgeTypeSupports}}}, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeDataExposure}, RiskWeight: 0.2, ConfidenceBoost: 0.3},
+	}
+	scores := func(importance map[reasoning.EdgeType]float64) map[string]float64 {
+		cfg := reasoning.DefaultAttackPathConfig()
+		cfg.MaxDepth = 1
+		cfg.ObjectiveNodeTypes = []reasoning.NodeType{reasoning.NodeTypeDataExposure}
+		cfg.EdgeImportance = importance
+		eng := reasoning.NewEngine(nil)
+		eng.ConfigureAttackPathExpansion(cfg)
+		eng.BindActionClasses(classes)
+		eng.Graph().UpsertNode(&reasoning.Node{ID: "ev-1", Type: reasoning.NodeTypeEvidence, Label: "seed"})
+		eng.Graph().UpsertNode(&reasoning.Node{ID: "ev-2", Type: reasoning.NodeTypeEvidence, Label: "peer"})
+		_ = eng.Graph().AddEdge(&reasoning.Edge{From: "ev-1", To: "ev-2", Type: reasoning.EdgeTypeEnables, Weight: 1})
+		_ = eng.Graph().AddEdge(&reasoning.Edge{From: "ev-2", To: "ev-1", Type: reasoning.EdgeTypeSupports, Weight: 1})
+		st, _ := state.New("edge-importance")
+		paths, err := eng.ExpandAttackPaths(st)
+		if err != nil {
+			t.Fatalf("expand attack paths: %v", err)
+		}
+		out := map[string]float64{}
+		for _, p := range paths {
+			out[p.Steps[0].ActionClassID] = p.Score
+		}
+		return out
+	}
+
+	equal := scores(nil)
+	if len(equal) != 2 || math.Abs(equal["AC-ENABLES"]-equal["AC-SUPPORTS"]) > 1e-9 {
+		t.Fatalf("expected equal scores without edge importance, got %+v", equal)
+	}
+	weighted := scores(map[reasoning.EdgeType]float64{reasoning.EdgeTypeEnables: 3})
+	if math.Abs(weighted["AC-ENABLES"]-weighted["AC-SUPPORTS"]) < 1e-9 {
+		t.Fatalf("expected enables importance to separate otherwise-equal paths, got %+v", weighted)
+	}
+	if weighted["AC-SUPPORTS"] != equal["AC-SUPPORTS"] {
+		t.Fatalf("unweighted edge type should keep its score: %.4f != %.4f", weighted["AC-SUPPORTS"], equal["AC-SUPPORTS"])
+	}
+}
+
+func TestRandomTieBreakIsReproducibleAndNotKeyOrdered(t *testing.T) {
+	survivor := func(random bool, seed int64) string {
+		eng := reasoning.NewEngine(nil)
+		classes := make([]reasoning.ActionClass, 0, 6)
+		for _, id := range []string{"AC-T1", "AC-T2", "AC-T3", "AC-T4", "AC-T5", "AC-T6"} {
+			classes = append(classes, reasoning.ActionClass{ID: id, Name: id, Phase: state.PhaseRecon, Preconditions: []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{reasoning.NodeTypeEvidence}}}, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeTechnique}, ImpactWeight: 1.0, RiskWeight: 0.2})
+		}
+		eng.BindActionClasses(classes)
+		eng.Graph().UpsertNode(&reasoning.Node{ID: "ev-1", Type: reasoning.NodeTypeEvidence, Label: "seed"})
+		cfg := reasoning.DefaultAttackPathConfig()
+		cfg.MaxDepth = 1
+		cfg.BeamWidth = 1
+		cfg.RandomTieBreak = random
+		cfg.TieBreakSeed = seed
+		eng.ConfigureAttackPathExpansion(cfg)
+
+		st, _ := state.New("campaign-tie-break")
+		paths, err := eng.ExpandAttackPaths(st)
+		if err != nil {
+			t.Fatalf("expand attack paths: %v", err)
+		}
+		if len(paths) != 1 {
+			t.Fatalf("expected only the surviving tied root to yield a path, got %d", len(paths))
+		}
+		return paths[0].Steps[0].ActionClassID
+	}
+
+	if got := survivor(false, 0); got != "AC-T1" {
+		t.Fatalf("expected key-ordered tie-break by default, got %s", got)
+	}
+	first := survivor(true, 3)
+	if first == "AC-T1" {
+		t.Fatalf("expected seeded tie-break not to follow key order")
+	}
+	if again := survivor(true, 3); again != first {
+		t.Fatalf("expected reproducible tie-break for a fixed seed, got %s then %s", first, again)
+	}
+}
+
+func TestGraphPatternWeightRaisesPartiallySatisfiedFeasibility(t *testing.T) {
+	score := func(essentialWeight float64) float64 {
+		cfg := reasoning.DefaultAttackPathConfig()
+		cfg.MaxDepth = 1
+		cfg.ObjectiveNodeTypes = []reasoning.NodeType{reasoning.NodeTypeDataExposure}
+		eng := reasoning.NewEngine(nil)
+		eng.ConfigureAttackPathExpansion(cfg)
+		// Feasibility credits the evidence pattern but not the enables edge, so the class is half satisfied by count.
+		eng.BindActionClasses([]reasoning.ActionClass{{ID: "AC-PARTIAL", Name: "partial", Phase: state.PhaseRecon, Preconditions: []reasoning.GraphPattern{
+			{RequiredNodeTypes: []reasoning.NodeType{reasoning.NodeTypeEvidence}, Weight: essentialWeight},
+			{RequiredEdges: []reasoning.EdgeType{reasoning.EdgeTypeEnables}},
+		}, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeDataExposure}, RiskWeight: 0.2, ConfidenceBoost: 0.3}})
+		eng.Graph().UpsertNode(&reasoning.Node{ID: "ev-1", Type: reasoning.NodeTypeEvidence, Label: "seed"})
+		eng.Graph().UpsertNode(&reasoning.Node{ID: "ev-2", Type: reasoning.NodeTypeEvidence, Label: "peer"})
+		_ = eng.Graph().AddEdge(&reasoning.Edge{From: "ev-1", To: "ev-2", Type: reasoning.EdgeTypeEnables, Weight: 1})
+		st, _ := state.New("pattern-weight")
+		paths, err := eng.ExpandAttackPaths(st)
+		if err != nil || len(paths) != 1 {
+			t.Fatalf("expected one path, got %d (%v)", len(paths), err)
+		}
+		return paths[0].Score
+	}
+
+	unset, unit := score(0), score(1)
+	if math.Abs(unset-unit) > 1e-9 {
+		t.Fatalf("expected an unset weight to default to 1: %.4f vs %.4f", unset, unit)
+	}
+	if essential := score(3); essential <= unit {
+		t.Fatalf("expected weighting the satisfied essential pattern to raise feasibility: %.4f <= %.4f", essential, unit)
+	}
+}
+
+func TestAttackPathStatsHistogramCoversEveryPath(t *testing.T) {
+	eng := reasoning.NewEngine(nil)
+	cfg := reasoning.DefaultAttackPathConfig()
+	cfg.ObjectiveNodeTypes = []reasoning.NodeType{reasoning.NodeTypeDataExposure}
+	eng.ConfigureAttackPathExpansion(cfg)
+	eng.BindActionClasses([]reasoning.ActionClass{
+		{ID: "AC-1", Name: "scan", Phase: state.PhaseRecon, Preconditions: []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{reasoning.NodeTypeEvidence}}}, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeHypothesis}, ImpactWeight: 0.4, RiskWeight: 0.1},
+		{ID: "AC-2", Name: "probe", Phase: state.PhaseRecon, Preconditions: []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{reasoning.NodeTypeHypothesis}}}, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeTechnique}, ImpactWeight: 0.6, RiskWeight: 0.2},
+		{ID: "AC-3", Name: "exfil check", Phase: state.PhaseRecon, Preconditions: []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{reasoning.NodeTypeTechnique}}}, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeDataExposure}, ImpactWeight: 1.0, RiskWeight: 0.3},
+		{ID: "AC-4", Name: "direct", Phase: state.PhaseRecon, Preconditions: []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{reasoning.NodeTypeHypothesis}}}, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeDataExposure}, ImpactWeight: 0.8, RiskWeight: 0.4},
+	})
+	eng.Graph().UpsertNode(&reasoning.Node{ID: "ev-1", Type: reasoning.NodeTypeEvidence, Label: "seed"})
+	st, _ := state.New("path-stats")
+
+	paths, err := eng.ExpandAttackPaths(st)
+	if err != nil {
+		t.Fatalf("expand attack paths: %v", err)
+	}
+	stats := reasoning.ComputeAttackPathStats(paths)
+	if stats.Count != len(paths) || len(paths) < 2 {
+		t.Fatalf("expected stats over several paths, got count %d for %d paths", stats.Count, len(paths))
+	}
+	total := 0
+	for length, n := range stats.LengthHistogram {
+		if length < stats.MinLength || length > stats.MaxLength {
+			t.Fatalf("histogram length %d outside [%d,%d]", length, stats.MinLength, stats.MaxLength)
+		}
+		total += n
+	}
+	if total != len(paths) {
+		t.Fatalf("expected histogram buckets to sum to %d paths, got %d", len(paths), total)
+	}
+	if stats.MeanLength < float64(stats.MinLength) || stats.MeanLength > float64(stats.MaxLength) || math.Abs(stats.ScoreLengthCorrelation) > 1+1e-9 {
+		t.Fatalf("inconsistent stats %+v", stats)
+	}
+}
+
+func TestExpandAttackPathsContextDiscardsPartialResultsOnCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	cfg := reasoning.DefaultAttackPathConfig()
+	// Cancel from inside the search, after the roots are admitted but before any depth is expanded.
+	cfg.ROEPolicy = func(reasoning.ActionClass, *reasoning.Graph, *state.State) bool {
+		cancel()
+		return true
+	}
+	eng := reasoning.NewEngine(nil)
+	eng.BindActionClasses([]reasoning.ActionClass{
+		{ID: "AC-1", Name: "one", Phase: state.PhaseRecon, Preconditions: []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{reasoning.NodeTypeEvidence}}}, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeHypothesis}, ImpactWeight: 1.0, RiskWeight: 0.2},
+		{ID: "AC-2", Name: "two", Phase: state.PhaseRecon, Preconditions: []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{reasoning.NodeTypeEvidence}}}, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeTechnique}, ImpactWeight: 1.1, RiskWeight: 0.2},
+	})
+	eng.ConfigureAttackPathExpansion(cfg)
+	eng.Graph().UpsertNode(&reasoning.Node{ID: "ev-1", Type: reasoning.NodeTypeEvidence, Label: "seed"})
+	st, _ := state.New("campaign-cancel")
+
+	paths, err := eng.ExpandAttackPathsContext(ctx, st)
+	if !errors.Is(err, context.Canceled) || paths != nil {
+		t.Fatalf("expected cancellation with no paths, got %d paths and %v", len(paths), err)
+	}
+
+	cfg.ROEPolicy = nil
+	eng.ConfigureAttackPathExpansion(cfg)
+	if paths, err := eng.ExpandAttackPaths(st); err != nil || len(paths) == 0 {
+		t.Fatalf("expected uncancelled expansion to find paths, got %d paths and %v", len(paths), err)
+	}
+}
+
+func TestAttackPathExplainComponentsSumToScore(t *testing.T) {
+	eng := reasoning.NewEngine(nil)
+	cfg := reasoning.DefaultAttackPathConfig()
+	cfg.ObjectiveNodeTypes = []reasoning.NodeType{reasoning.NodeTypeDataExposure}
+	eng.ConfigureAttackPathExpansion(cfg)
+	eng.BindActionClasses([]reasoning.ActionClass{
+		{ID: "AC-1", Name: "scan", Phase: state.PhaseRecon, Preconditions: []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{reasoning.NodeTypeEvidence}}}, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeHypothesis}, ImpactWeight: 0.4, RiskWeight: 0.1},
+		{ID: "AC-2", Name: "exfil check", Phase: state.PhaseRecon, Preconditions: []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{reasoning.NodeTypeHypothesis}}}, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeDataExposure}, ImpactWeight: 1.0, RiskWeight: 0.3},
+	})
+	eng.Graph().UpsertNode(&reasoning.Node{ID: "ev-1", Type: reasoning.NodeTypeEvidence, Label: "seed"})
+	st, _ := state.New("path-explain")
+
+	paths, err := eng.ExpandAttackPaths(st)
+	if err != nil {
+		t.Fatalf("expand attack paths: %v", err)
+	}
+	if len(paths) == 0 {
+		t.Fatalf("expected at least one path")
+	}
+	sawObjective := false
+	for _, p := range paths {
+		e := p.Explain()
+		if math.Abs(e.Total()-p.Score) > 1e-9 {
+			t.Fatalf("expected components %+v to sum to score %.6f, got %.6f", e, p.Score, e.Total())
+		}
+		if e.Confidence <= 0 || e.RiskPenalty <= 0 || e.DepthPenalty <= 0 {
+			t.Fatalf("expected populated confidence, risk and depth components, got %+v", e)
+		}
+		if p.Objective != "" {
+			sawObjective = true
+			if e.ObjectiveProximity <= 0 {
+				t.Fatalf("expected objective-reaching path to carry proximity, got %+v", e)
+			}
+		}
+	}
+	if !sawObjective {
+		t.Fatalf("expected a path reaching the objective")
+	}
+}
+
+func TestMinObjectiveConfidenceWithholdsLowConfidenceObjective(t *testing.T) {
+	expand := func(boost float64) []reasoning.AttackPath {
+		eng := reasoning.NewEngine(nil)
+		cfg := reasoning.DefaultAttackPathConfig()
+		cfg.ObjectiveNodeTypes = []reasoning.NodeType{reasoning.NodeTypeDataExposure}
+		cfg.MinObjectiveConfidence = 0.7
+		eng.ConfigureAttackPathExpansion(cfg)
+		eng.BindActionClasses([]reasoning.ActionClass{
+			{ID: "AC-1", Name: "scan", Phase: state.PhaseRecon, Preconditions: []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{reasoning.NodeTypeEvidence}}}, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeHypothesis}, ImpactWeight: 0.4, RiskWeight: 0.1},
+			{ID: "AC-2", Name: "exfil check", Phase: state.PhaseRecon, Preconditions: []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{reasoning.NodeTypeHypothesis}}}, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeDataExposure}, ImpactWeight: 1.0, RiskWeight: 0.3, ConfidenceBoost: boost},
+		})
+		eng.Graph().UpsertNode(&reasoning.Node{ID: "ev-1", Type: reasoning.NodeTypeEvidence, Label: "seed"})
+		st, _ := state.New("objective-confidence")
+		paths, err := eng.ExpandAttackPaths(st)
+		if err != nil {
+			t.Fatalf("expand attack paths: %v", err)
+		}
+		return paths
+	}
+
+	if paths := expand(0.1); len(paths) != 0 {
+		t.Fatalf("expected a 0.6-confidence objective step to fall short of 0.7, got %d paths", len(paths))
+	}
+	paths := expand(0.2)
+	if len(paths) == 0 || paths[0].Objective != reasoning.NodeTypeDataExposure {
+		t.Fatalf("expected the objective to count once the step meets 0.7 confidence, got %+v", paths)
+	}
+}
@@ -1,7 +1,14 @@
 package tests
 
 import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
 	"testing"
+	"time"
 
 	"vantage/core/reasoning"
 	"vantage/core/state"
@@ -62,3 +69,1084 @@ func TestPlanCampaignBeamPruningAndNoExplosion(t *testing.T) {
 		t.Fatalf("unexpected campaign explosion: %d", len(wide))
 	}
 }
+
+func TestPlanCampaignExcludesExecutedActions(t *testing.T) {
+	const foothold reasoning.NodeType = "foothold"
+	eng := reasoning.NewEngine(nil)
+	eng.BindActionClasses([]reasoning.ActionClass{
+		{ID: "AC-R", Name: "recon", Phase: state.PhaseRecon, Preconditions: []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{reasoning.NodeTypeEvidence}}}, ProducesNodes: []reasoning.NodeType{foothold}, RiskWeight: 0.1, ConfidenceBoost: 0.2},
+		{ID: "AC-D", Name: "data", Phase: state.PhaseRecon, Preconditions: []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{reasoning.NodeTypeEvidence}}}, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeDataExposure}, RiskWeight: 0.2, ConfidenceBoost: 0.3},
+	})
+	eng.RegisterTechniqueEffect(reasoning.TechniqueEffect{TechniqueID: "T-1", Impact: 0.9, Risk: 0.1, Stealth: 0.8})
+	eng.Graph().UpsertNode(&reasoning.Node{ID: "seed", Type: reasoning.NodeTypeEvidence, Label: "seed"})
+	eng.ConfigureCycle(reasoning.CycleConfig{Target: "host-1", AllowedTechniques: []string{"T-1"}, Executor: &executorStub{}})
+
+	st, _ := state.New("executed-history")
+	if _, err := eng.RunCycle(st); err != nil {
+		t.Fatalf("run cycle: %v", err)
+	}
+	st.RecordActionMemory("AC-R", true, true)
+
+	opts := reasoning.CampaignOptions{MaxDepth: 3, RiskTolerance: 1.0, ConfidenceThreshold: 0.4, BeamWidth: 6, TopN: 10}
+	all, err := eng.PlanCampaign(reasoning.NodeTypeDataExposure, opts)
+	if err != nil {
+		t.Fatalf("plan campaign: %v", err)
+	}
+	if !campaignsContainAction(all, "AC-R") {
+		t.Fatalf("expected AC-R in unfiltered campaigns")
+	}
+
+	opts.ExcludeExecuted = true
+	filtered, err := eng.PlanCampaign(reasoning.NodeTypeDataExposure, opts)
+	if err != nil {
+		t.Fatalf("plan campaign excluding executed: %v", err)
+	}
+	if len(filtered) == 0 {
+		t.Fatalf("expected campaigns after excluding executed actions")
+	}
+	if campaignsContainAction(filtered, "AC-R") {
+		t.Fatalf("expected executed AC-R to be excluded")
+	}
+}
+
+func campaignsContainAction(campaigns []reasoning.Campaign, id string) bool {
+	for _, c := range campaigns {
+		for _, step := range c.Steps {
+			if step.ActionClassID == id {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func TestPlanCampaignMinRiskBeamSurfacesSaferCampaigns(t *testing.T) {
+	eng := reasoning.NewEngine(nil)
+	eng.BindActionClasses([]reasoning.ActionClass{
+		{ID: "AC-HI", Name: "loud", Phase: state.PhaseRecon, Preconditions: []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{reasoning.NodeTypeEvidence}}}, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeHypothesis}, RiskWeight: 0.6, ConfidenceBoost: 0.45},
+		{ID: "AC-LO", Name: "quiet", Phase: state.PhaseRecon, Preconditions: []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{reasoning.NodeTypeEvidence}}}, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeHypothesis}, RiskWeight: 0.05, ConfidenceBoost: 0.1},
+		{ID: "AC-OBJ", Name: "objective", Phase: state.PhaseRecon, Preconditions: []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{reasoning.NodeTypeHypothesis}}}, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeDataExposure}, RiskWeight: 0.1, ConfidenceBoost: 0.2},
+	})
+	eng.Graph().UpsertNode(&reasoning.Node{ID: "seed", Type: reasoning.NodeTypeEvidence, Label: "seed"})
+
+	opts := reasoning.CampaignOptions{MaxDepth: 2, BeamWidth: 1, RiskTolerance: 2, ConfidenceThreshold: 0.4, TopN: 5}
+	byScore, err := eng.PlanCampaign(reasoning.NodeTypeDataExposure, opts)
+	if err != nil {
+		t.Fatalf("max score plan: %v", err)
+	}
+	opts.BeamObjective = reasoning.BeamObjectiveMinRisk
+	byRisk, err := eng.PlanCampaign(reasoning.NodeTypeDataExposure, opts)
+	if err != nil {
+		t.Fatalf("min risk plan: %v", err)
+	}
+	if len(byScore) == 0 || len(byRisk) == 0 {
+		t.Fatalf("expected campaigns for both objectives, score=%d risk=%d", len(byScore), len(byRisk))
+	}
+	if byRisk[0].Risk >= byScore[0].Risk {
+		t.Fatalf("expected min-risk beam to surface lower risk: min_risk=%.2f max_score=%.2f", byRisk[0].Risk, byScore[0].Risk)
+	}
+}
+
+func TestPlanCampaignAllowGapsReportsMissingPrecondition(t *testing.T) {
+	const credential reasoning.NodeType = "credential"
+	eng := reasoning.NewEngine(nil)
+	eng.BindActionClasses([]reasoning.ActionClass{
+		{ID: "AC-D", Name: "data", Phase: state.PhaseRecon, Preconditions: []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{credential}}}, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeDataExposure}, RiskWeight: 0.2, ConfidenceBoost: 0.3},
+	})
+	eng.Graph().UpsertNode(&reasoning.Node{ID: "seed", Type: reasoning.NodeTypeEvidence, Label: "seed"})
+
+	opts := reasoning.CampaignOptions{MaxDepth: 2, RiskTolerance: 1.0, ConfidenceThreshold: 0.4, BeamWidth: 6, TopN: 5}
+	strict, err := eng.PlanCampaign(reasoning.NodeTypeDataExposure, opts)
+	if err != nil {
+		t.Fatalf("strict plan: %v", err)
+	}
+	if len(strict) != 0 {
+		t.Fatalf("expected no strict campaigns, got %d", len(strict))
+	}
+
+	opts.AllowGaps = true
+	gapped, err := eng.PlanCampaign(reasoning.NodeTypeDataExposure, opts)
+	if err != nil {
+		t.Fatalf("gapped plan: %v", err)
+	}
+	if len(gapped) == 0 {
+		t.Fatalf("expected gapped campaign")
+	}
+	if len(gapped[0].Gaps) != 1 || gapped[0].Gaps[0] != "AC-D requires node credential" {
+		t.Fatalf("unexpected gaps: %v", gapped[0].Gaps)
+	}
+}
+
+func TestParetoFrontierExcludesDominatedCampaigns(t *testing.T) {
+	campaigns := []reasoning.Campaign{
+		{Steps: []reasoning.AttackStep{{ActionClassID: "SAFE"}}, Risk: 0.2, Impact: 0.5, Confidence: 0.7},
+		{Steps: []reasoning.AttackStep{{ActionClassID: "BOLD"}}, Risk: 0.8, Impact: 1.4, Confidence: 0.6},
+		{Steps: []reasoning.AttackStep{{ActionClassID: "WORSE"}}, Risk: 0.9, Impact: 0.4, Confidence: 0.5},
+	}
+	frontier := reasoning.ParetoFrontier(campaigns)
+	if len(frontier) != 2 {
+		t.Fatalf("expected 2 frontier campaigns, got %d", len(frontier))
+	}
+	for _, c := range frontier {
+		if c.Steps[0].ActionClassID == "WORSE" {
+			t.Fatalf("dominated campaign retained in frontier")
+		}
+	}
+}
+
+func TestNormalizeCampaignScoresRescalesAndPreservesOrder(t *testing.T) {
+	campaigns := []reasoning.Campaign{
+		{Steps: []reasoning.AttackStep{{ActionClassID: "HIGH"}}, Score: 4.5},
+		{Steps: []reasoning.AttackStep{{ActionClassID: "MID"}}, Score: 3.0},
+		{Steps: []reasoning.AttackStep{{ActionClassID: "LOW"}}, Score: 1.5},
+	}
+	normalized := reasoning.NormalizeCampaignScores(campaigns)
+	if len(normalized) != len(campaigns) {
+		t.Fatalf("expected %d campaigns, got %d", len(campaigns), len(normalized))
+	}
+	want := []float64{1, 0.5, 0}
+	for i, c := range normalized {
+		if c.Steps[0].ActionClassID != campaigns[i].Steps[0].ActionClassID {
+			t.Fatalf("order not preserved at %d", i)
+		}
+		if math.Abs(c.Score-want[i]) > 1e-9 {
+			t.Fatalf("campaign %d: expected score %.2f, got %.4f", i, want[i], c.Score)
+		}
+	}
+	if campaigns[0].Score != 4.5 {
+		t.Fatalf("input campaigns were mutated")
+	}
+}
+
+func TestParetoCampaignsReturnsNonDominatedPlans(t *testing.T) {
+	eng := reasoning.NewEngine(nil)
+	eng.BindActionClasses([]reasoning.ActionClass{
+		{ID: "AC-SAFE", Name: "safe", Phase: state.PhaseRecon, Preconditions: []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{reasoning.NodeTypeEvidence}}}, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeDataExposure}, RiskWeight: 0.1, ImpactWeight: 0.4, ConfidenceBoost: 0.2},
+		{ID: "AC-BOLD", Name: "bold", Phase: state.PhaseRecon, Preconditions: []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{reasoning.NodeTypeEvidence}}}, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeDataExposure}, RiskWeight: 0.6, ImpactWeight: 1.2, ConfidenceBoost: 0.2},
+		{ID: "AC-BAD", Name: "bad", Phase: state.PhaseRecon, Preconditions: []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{reasoning.NodeTypeEvidence}}}, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeDataExposure}, RiskWeight: 0.7, ImpactWeight: 0.3, ConfidenceBoost: 0.1},
+	})
+	eng.Graph().UpsertNode(&reasoning.Node{ID: "seed", Type: reasoning.NodeTypeEvidence, Label: "seed"})
+
+	frontier, err := eng.ParetoCampaigns(reasoning.NodeTypeDataExposure, reasoning.CampaignOptions{MaxDepth: 1, RiskTolerance: 2, ConfidenceThreshold: 0.4, BeamWidth: 10, TopN: 10})
+	if err != nil {
+		t.Fatalf("pareto campaigns: %v", err)
+	}
+	if campaignsContainAction(frontier, "AC-BAD") {
+		t.Fatalf("expected dominated AC-BAD campaign to be excluded")
+	}
+	if !campaignsContainAction(frontier, "AC-SAFE") || !campaignsContainAction(frontier, "AC-BOLD") {
+		t.Fatalf("expected safe and bold campaigns on the frontier")
+	}
+}
+
+func TestReplanIncrementalMatchesFullReplanAfterEvidenceAddition(t *testing.T) {
+	const foothold reasoning.NodeType = "foothold"
+	eng := reasoning.NewEngine(nil)
+	eng.BindActionClasses([]reasoning.ActionClass{
+		{ID: "AC-R", Name: "recon", Phase: state.PhaseRecon, Preconditions: []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{reasoning.NodeTypeEvidence}}}, ProducesNodes: []reasoning.NodeType{foothold}, RiskWeight: 0.1, ConfidenceBoost: 0.2},
+		{ID: "AC-D", Name: "data", Phase: state.PhaseRecon, Preconditions: []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{foothold}}}, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeDataExposure}, RiskWeight: 0.2, ConfidenceBoost: 0.3},
+	})
+	eng.Graph().UpsertNode(&reasoning.Node{ID: "seed", Type: reasoning.NodeTypeEvidence, Label: "seed"})
+	opts := reasoning.CampaignOptions{MaxDepth: 3, RiskTolerance: 1.0, ConfidenceThreshold: 0.4, BeamWidth: 6, TopN: 5}
+
+	prev, err := eng.PlanCampaign(reasoning.NodeTypeDataExposure, opts)
+	if err != nil {
+		t.Fatalf("initial plan: %v", err)
+	}
+
+	eng.Graph().UpsertNode(&reasoning.Node{ID: "seed-2", Type: reasoning.NodeTypeEvidence, Label: "seed-2"})
+	incremental, err := eng.ReplanIncremental(prev, reasoning.NodeTypeDataExposure, opts)
+	if err != nil {
+		t.Fatalf("incremental plan: %v", err)
+	}
+	full, err := eng.PlanCampaign(reasoning.NodeTypeDataExposure, opts)
+	if err != nil {
+		t.Fatalf("full plan: %v", err)
+	}
+	if got, want := campaignSignature(incremental), campaignSignature(full); got != want {
+		t.Fatalf("incremental re-plan diverged: got %s want %s", got, want)
+	}
+
+	eng.Graph().UpsertNode(&reasoning.Node{ID: "foothold-1", Type: foothold, Label: "foothold"})
+	incremental, err = eng.ReplanIncremental(full, reasoning.NodeTypeDataExposure, opts)
+	if err != nil {
+		t.Fatalf("incremental plan after new type: %v", err)
+	}
+	full, err = eng.PlanCampaign(reasoning.NodeTypeDataExposure, opts)
+	if err != nil {
+		t.Fatalf("full plan after new type: %v", err)
+	}
+	if got, want := campaignSignature(incremental), campaignSignature(full); got != want {
+		t.Fatalf("incremental re-plan diverged after new type: got %s want %s", got, want)
+	}
+}
+
+func TestPlanningReusesSnapshotUntilGraphMutates(t *testing.T) {
+	eng := reasoning.NewEngine(nil)
+	eng.BindActionClasses([]reasoning.ActionClass{
+		{ID: "AC-D", Name: "data", Phase: state.PhaseRecon, Preconditions: []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{reasoning.NodeTypeEvidence}}}, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeDataExposure}, RiskWeight: 0.2, ConfidenceBoost: 0.3},
+	})
+	eng.Graph().UpsertNode(&reasoning.Node{ID: "seed", Type: reasoning.NodeTypeEvidence, Label: "seed"})
+	opts := reasoning.CampaignOptions{MaxDepth: 2, RiskTolerance: 1.0, ConfidenceThreshold: 0.4, BeamWidth: 4, TopN: 3}
+	st, _ := state.New("snapshot-cache")
+
+	if _, err := eng.PlanCampaign(reasoning.NodeTypeDataExposure, opts); err != nil {
+		t.Fatalf("plan campaign: %v", err)
+	}
+	if _, err := eng.ExpandAttackPaths(st); err != nil {
+		t.Fatalf("expand attack paths: %v", err)
+	}
+	if got := eng.SnapshotBuilds(); got != 1 {
+		t.Fatalf("expected one snapshot build across unchanged planning calls, got %d", got)
+	}
+
+	eng.Graph().UpsertNode(&reasoning.Node{ID: "seed-2", Type: reasoning.NodeTypeEvidence, Label: "seed-2"})
+	if _, err := eng.PlanCampaign(reasoning.NodeTypeDataExposure, opts); err != nil {
+		t.Fatalf("plan campaign after mutation: %v", err)
+	}
+	if got := eng.SnapshotBuilds(); got != 2 {
+		t.Fatalf("expected graph mutation to invalidate the snapshot, got %d builds", got)
+	}
+}
+
+func TestGeometricConfidenceAggregationPenalizesWeakStep(t *testing.T) {
+	const foothold reasoning.NodeType = "foothold"
+	eng := reasoning.NewEngine(nil)
+	eng.BindActionClasses([]reasoning.ActionClass{
+		{ID: "AC-STRONG", Name: "strong", Phase: state.PhaseRecon, Preconditions: []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{reasoning.NodeTypeEvidence}}}, ProducesNodes: []reasoning.NodeType{foothold}, RiskWeight: 0.1, ConfidenceBoost: 0.45},
+		{ID: "AC-WEAK", Name: "weak", Phase: state.PhaseRecon, Preconditions: []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{foothold}}}, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeDataExposure}, RiskWeight: 0.1, ConfidenceBoost: -0.2},
+	})
+	eng.Graph().UpsertNode(&reasoning.Node{ID: "seed", Type: reasoning.NodeTypeEvidence, Label: "seed"})
+	plan := func(aggregation reasoning.ConfidenceAggregation, threshold float64) []reasoning.Campaign {
+		t.Helper()
+		campaigns, err := eng.PlanCampaign(reasoning.NodeTypeDataExposure, reasoning.CampaignOptions{MaxDepth: 2, RiskTolerance: 1, ConfidenceThreshold: threshold, BeamWidth: 5, TopN: 5, ConfidenceAggregation: aggregation})
+		if err != nil {
+			t.Fatalf("plan campaign (%s): %v", aggregation, err)
+		}
+		return campaigns
+	}
+
+	mean := plan(reasoning.ConfidenceAggregationMean, 0.1)
+	geometric := plan(reasoning.ConfidenceAggregationGeometric, 0.1)
+	if len(mean) == 0 || len(geometric) == 0 {
+		t.Fatalf("expected campaigns under a permissive threshold")
+	}
+	if geometric[0].Confidence >= mean[0].Confidence {
+		t.Fatalf("expected geometric confidence below mean: %.4f >= %.4f", geometric[0].Confidence, mean[0].Confidence)
+	}
+
+	if !campaignsContainAction(plan(reasoning.ConfidenceAggregationMean, 0.6), "AC-WEAK") {
+		t.Fatalf("expected mean aggregation to pass the 0.6 threshold")
+	}
+	if campaignsContainAction(plan(reasoning.ConfidenceAggregationGeometric, 0.6), "AC-WEAK") {
+		t.Fatalf("expected geometric aggregation to fail the 0.6 threshold")
+	}
+}
+
+func TestShortestCampaignPrefersFewestSteps(t *testing.T) {
+	node := func(n reasoning.NodeType) []reasoning.GraphPattern {
+		return []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{n}}}
+	}
+	short := []reasoning.ActionClass{
+		{ID: "AC-S1", Name: "short-1", Phase: state.PhaseRecon, Preconditions: node(reasoning.NodeTypeEvidence), ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeAttackPath}, RiskWeight: 0.3, ConfidenceBoost: 0.3},
+		{ID: "AC-S2", Name: "short-2", Phase: state.PhaseRecon, Preconditions: node(reasoning.NodeTypeAttackPath), ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeDataExposure}, RiskWeight: 0.3, ConfidenceBoost: 0.3},
+	}
+	long := []reasoning.ActionClass{
+		{ID: "AC-L1", Name: "long-1", Phase: state.PhaseRecon, Preconditions: node(reasoning.NodeTypeEvidence), ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeHypothesis}, RiskWeight: 0.05, ConfidenceBoost: 0.3},
+		{ID: "AC-L2", Name: "long-2", Phase: state.PhaseRecon, Preconditions: node(reasoning.NodeTypeHypothesis), ProducesNodes: []reasoning.NodeType{reasoning.NodeTypePrivEsc}, RiskWeight: 0.05, ConfidenceBoost: 0.3},
+		{ID: "AC-L3", Name: "long-3", Phase: state.PhaseRecon, Preconditions: node(reasoning.NodeTypePrivEsc), ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeLateralReachability}, RiskWeight: 0.05, ConfidenceBoost: 0.3},
+		{ID: "AC-L4", Name: "long-4", Phase: state.PhaseRecon, Preconditions: node(reasoning.NodeTypeLateralReachability), ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeDataExposure}, RiskWeight: 0.05, ConfidenceBoost: 0.3},
+	}
+	opts := reasoning.CampaignOptions{MaxDepth: 5, RiskTolerance: 2.0, ConfidenceThreshold: 0.2, BeamWidth: 10, TopN: 10}
+	shortestWith := func(classes []reasoning.ActionClass) *reasoning.Campaign {
+		eng := reasoning.NewEngine(nil)
+		eng.BindActionClasses(classes)
+		eng.Graph().UpsertNode(&reasoning.Node{ID: "seed", Type: reasoning.NodeTypeEvidence, Label: "seed"})
+		c, err := eng.ShortestCampaign(reasoning.NodeTypeDataExposure, opts)
+		if err != nil {
+			t.Fatalf("shortest campaign: %v", err)
+		}
+		return c
+	}
+
+	if c := shortestWith(long); c == nil || len(c.Steps) != 4 {
+		t.Fatalf("expected the long chain alone to yield a 4-step campaign, got %+v", c)
+	}
+	c := shortestWith(append(append([]reasoning.ActionClass(nil), long...), short...))
+	if c == nil || len(c.Steps) != 2 {
+		t.Fatalf("expected a 2-step campaign, got %+v", c)
+	}
+	if c.Steps[0].ActionClassID != "AC-S1" || c.Steps[1].ActionClassID != "AC-S2" {
+		t.Fatalf("unexpected shortest campaign steps %+v", c.Steps)
+	}
+}
+
+func TestShortestCampaignKeepsConfidentPrefixOverLowerRisk(t *testing.T) {
+	node := func(n reasoning.NodeType) []reasoning.GraphPattern {
+		return []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{n}}}
+	}
+	eng := reasoning.NewEngine(nil)
+	// Both first steps reach the same projected state; the lower-risk one leaves too little confidence for
+	// the low-confidence final step to clear the threshold.
+	eng.BindActionClasses([]reasoning.ActionClass{
+		{ID: "AC-SAFE", Name: "safe", Phase: state.PhaseRecon, Preconditions: node(reasoning.NodeTypeEvidence), ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeAttackPath}, RiskWeight: 0.1, ConfidenceBoost: 0.1},
+		{ID: "AC-SURE", Name: "sure", Phase: state.PhaseRecon, Preconditions: node(reasoning.NodeTypeEvidence), ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeAttackPath}, RiskWeight: 0.3, ConfidenceBoost: 0.5},
+		{ID: "AC-OBJ", Name: "objective", Phase: state.PhaseRecon, Preconditions: node(reasoning.NodeTypeAttackPath), ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeDataExposure}, RiskWeight: 0.1},
+	})
+	eng.Graph().UpsertNode(&reasoning.Node{ID: "seed", Type: reasoning.NodeTypeEvidence, Label: "seed"})
+
+	opts := reasoning.CampaignOptions{MaxDepth: 2, RiskTolerance: 2.0, ConfidenceThreshold: 0.6, BeamWidth: 10, TopN: 10}
+	c, err := eng.ShortestCampaign(reasoning.NodeTypeDataExposure, opts)
+	if err != nil {
+		t.Fatalf("shortest campaign: %v", err)
+	}
+	if c == nil || len(c.Steps) != 2 || c.Steps[0].ActionClassID != "AC-SURE" || c.Confidence < opts.ConfidenceThreshold {
+		t.Fatalf("expected the riskier, more confident AC-SURE prefix to reach the objective, got %+v", c)
+	}
+}
+
+func TestSolveCampaignFindsChainPrunedByNarrowBeam(t *testing.T) {
+	node := func(n reasoning.NodeType) []reasoning.GraphPattern {
+		return []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{n}}}
+	}
+	eng := reasoning.NewEngine(nil)
+	eng.BindActionClasses([]reasoning.ActionClass{
+		// AC-LURE scores best at depth one and unlocks several dead ends, so a width-1 beam commits to it.
+		{ID: "AC-LURE", Name: "lure", Phase: state.PhaseRecon, Preconditions: node(reasoning.NodeTypeEvidence), ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeHypothesis}, RiskWeight: 0.01, ConfidenceBoost: 0.45},
+		{ID: "AC-DEAD-1", Name: "dead-1", Phase: state.PhaseRecon, Preconditions: node(reasoning.NodeTypeHypothesis), ProducesNodes: []reasoning.NodeType{reasoning.NodeTypePrivEsc}, RiskWeight: 0.01, ConfidenceBoost: 0.45},
+		{ID: "AC-DEAD-2", Name: "dead-2", Phase: state.PhaseRecon, Preconditions: node(reasoning.NodeTypeHypothesis), ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeLateralReachability}, RiskWeight: 0.01, ConfidenceBoost: 0.45},
+		{ID: "AC-PIVOT", Name: "pivot", Phase: state.PhaseRecon, Preconditions: node(reasoning.NodeTypeEvidence), ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeAttackPath}, RiskWeight: 0.4, ConfidenceBoost: 0.05},
+		{ID: "AC-GOAL", Name: "goal", Phase: state.PhaseRecon, Preconditions: node(reasoning.NodeTypeAttackPath), ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeDataExposure}, RiskWeight: 0.4, ConfidenceBoost: 0.05},
+	})
+	eng.Graph().UpsertNode(&reasoning.Node{ID: "seed", Type: reasoning.NodeTypeEvidence, Label: "seed"})
+
+	campaigns, err := eng.PlanCampaign(reasoning.NodeTypeDataExposure, reasoning.CampaignOptions{MaxDepth: 2, RiskTolerance: 2.0, ConfidenceThreshold: 0.1, BeamWidth: 1, TopN: 5})
+	if err != nil {
+		t.Fatalf("plan campaign: %v", err)
+	}
+	if len(campaigns) != 0 {
+		t.Fatalf("expected width-1 beam to miss the chain, got %+v", campaigns)
+	}
+
+	solved, err := eng.SolveCampaign(reasoning.NodeTypeDataExposure, 2)
+	if err != nil {
+		t.Fatalf("solve campaign: %v", err)
+	}
+	if solved == nil || len(solved.Steps) != 2 || solved.Steps[0].ActionClassID != "AC-PIVOT" || solved.Steps[1].ActionClassID != "AC-GOAL" {
+		t.Fatalf("expected solver to find AC-PIVOT -> AC-GOAL, got %+v", solved)
+	}
+}
+
+func TestPlannedCampaignsAreFeasibleForPathExpansion(t *testing.T) {
+	node := func(n reasoning.NodeType) []reasoning.GraphPattern {
+		return []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{n}}}
+	}
+	classes := []reasoning.ActionClass{
+		{ID: "AC-R", Name: "recon", Phase: state.PhaseRecon, Preconditions: node(reasoning.NodeTypeEvidence), ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeHypothesis}, RiskWeight: 0.1, ConfidenceBoost: 0.3},
+		{ID: "AC-I", Name: "access", Phase: state.PhaseInitialAccess, Preconditions: node(reasoning.NodeTypeHypothesis), ProducesNodes: []reasoning.NodeType{reasoning.NodeTypePrivEsc}, RiskWeight: 0.1, ConfidenceBoost: 0.3},
+		{ID: "AC-L", Name: "persist", Phase: state.PhasePersistence, Preconditions: node(reasoning.NodeTypePrivEsc), ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeDataExposure}, RiskWeight: 0.1, ConfidenceBoost: 0.3},
+	}
+	eng := reasoning.NewEngine(nil)
+	eng.BindActionClasses(classes)
+	eng.Graph().UpsertNode(&reasoning.Node{ID: "seed", Type: reasoning.NodeTypeEvidence, Label: "seed"})
+	cfg := reasoning.DefaultAttackPathConfig()
+	cfg.ObjectiveNodeTypes = []reasoning.NodeType{reasoning.NodeTypeDataExposure}
+	eng.ConfigureAttackPathExpansion(cfg)
+
+	campaigns, err := eng.PlanCampaign(reasoning.NodeTypeDataExposure, reasoning.CampaignOptions{MaxDepth: 5, RiskTolerance: 2.0, ConfidenceThreshold: 0.2, BeamWidth: 10, TopN: 10})
+	if err != nil {
+		t.Fatalf("plan campaign: %v", err)
+	}
+	if len(campaigns) == 0 {
+		t.Fatalf("expected campaigns")
+	}
+	for _, c := range campaigns {
+		seq := make([]string, 0, len(c.Steps))
+		for _, step := range c.Steps {
+			seq = append(seq, step.ActionClassID)
+		}
+		ok, err := reasoning.VerifyFeasible(classes, seq)
+		if err != nil || !ok {
+			t.Fatalf("planned campaign %v is not feasible (err=%v)", seq, err)
+		}
+	}
+
+	st, _ := state.New("consistency")
+	paths, err := eng.ExpandAttackPaths(st)
+	if err != nil {
+		t.Fatalf("expand attack paths: %v", err)
+	}
+	found := false
+	for _, p := range paths {
+		if len(p.Steps) == 3 && p.Steps[2].ActionClassID == "AC-L" {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatalf("expected path expansion to accept the phase-advancing chain the planner returns, got %+v", paths)
+	}
+
+	if ok, _ := reasoning.VerifyFeasible(classes, []string{"AC-R", "AC-R", "AC-I"}); ok {
+		t.Fatalf("expected a repeated step to be infeasible")
+	}
+	if _, err := reasoning.VerifyFeasible(classes, []string{"AC-X"}); err == nil {
+		t.Fatalf("expected unknown action class to error")
+	}
+}
+
+func TestCampaignMetaCorpusHashTracksBoundCorpus(t *testing.T) {
+	classes := []reasoning.ActionClass{
+		{ID: "AC-R", Name: "recon", Phase: state.PhaseRecon, Preconditions: []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{reasoning.NodeTypeEvidence}}}, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeHypothesis}, RiskWeight: 0.1, ConfidenceBoost: 0.2},
+		{ID: "AC-D", Name: "data", Phase: state.PhaseRecon, Preconditions: []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{reasoning.NodeTypeHypothesis}}}, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeDataExposure}, RiskWeight: 0.2, ConfidenceBoost: 0.3},
+	}
+	opts := reasoning.CampaignOptions{MaxDepth: 3, RiskTolerance: 1.0, ConfidenceThreshold: 0.4, BeamWidth: 6, TopN: 5}
+	plan := func(classes []reasoning.ActionClass) []reasoning.Campaign {
+		eng := reasoning.NewEngine(nil)
+		eng.BindActionClasses(classes)
+		eng.Graph().UpsertNode(&reasoning.Node{ID: "seed", Type: reasoning.NodeTypeEvidence, Label: "seed"})
+		campaigns, err := eng.PlanCampaign(reasoning.NodeTypeDataExposure, opts)
+		if err != nil {
+			t.Fatalf("plan campaign: %v", err)
+		}
+		if len(campaigns) == 0 {
+			t.Fatalf("expected campaigns")
+		}
+		return campaigns
+	}
+
+	first := plan(classes)
+	second := plan([]reasoning.ActionClass{classes[1], classes[0]})
+	meta := first[0].Meta
+	if meta.CorpusHash == "" || meta.PlannedAt.IsZero() || meta.Options.MaxDepth != opts.MaxDepth {
+		t.Fatalf("expected populated campaign meta, got %+v", meta)
+	}
+	if second[0].Meta.CorpusHash != meta.CorpusHash {
+		t.Fatalf("expected identical corpora to share a corpus hash")
+	}
+
+	changed := append([]reasoning.ActionClass(nil), classes...)
+	changed[1].RiskWeight = 0.3
+	if plan(changed)[0].Meta.CorpusHash == meta.CorpusHash {
+		t.Fatalf("expected a different corpus to report a different corpus hash")
+	}
+}
+
+func TestEvaluateCampaignRescoresEditedSequence(t *testing.T) {
+	eng := reasoning.NewEngine(nil)
+	eng.BindActionClasses([]reasoning.ActionClass{
+		{ID: "AC-R", Name: "recon", Phase: state.PhaseRecon, Preconditions: []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{reasoning.NodeTypeEvidence}}}, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeHypothesis}, RiskWeight: 0.1, ConfidenceBoost: 0.2},
+		{ID: "AC-P", Name: "priv", Phase: state.PhaseRecon, Preconditions: []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{reasoning.NodeTypeHypothesis}}}, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypePrivEsc}, RiskWeight: 0.25, ConfidenceBoost: 0.25},
+		{ID: "AC-D", Name: "data", Phase: state.PhaseRecon, Preconditions: []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{reasoning.NodeTypeHypothesis}}}, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeDataExposure}, RiskWeight: 0.2, ConfidenceBoost: 0.3},
+	})
+	eng.Graph().UpsertNode(&reasoning.Node{ID: "seed", Type: reasoning.NodeTypeEvidence, Label: "seed"})
+	opts := reasoning.CampaignOptions{MaxDepth: 4, RiskTolerance: 1.0, ConfidenceThreshold: 0.4, BeamWidth: 6, TopN: 5}
+
+	campaign, err := eng.EvaluateCampaign([]string{"AC-R", "AC-D"}, reasoning.NodeTypeDataExposure, opts)
+	if err != nil {
+		t.Fatalf("evaluate feasible campaign: %v", err)
+	}
+	if len(campaign.Steps) != 2 || campaign.Steps[1].ActionClassID != "AC-D" || math.Abs(campaign.Risk-0.3) > 1e-9 {
+		t.Fatalf("unexpected evaluated campaign %+v", campaign)
+	}
+	planned, err := eng.PlanCampaign(reasoning.NodeTypeDataExposure, opts)
+	if err != nil {
+		t.Fatalf("plan campaign: %v", err)
+	}
+	for _, p := range planned {
+		if len(p.Steps) == 2 && p.Steps[0].ActionClassID == "AC-R" && p.Steps[1].ActionClassID == "AC-D" && p.Score != campaign.Score {
+			t.Fatalf("expected evaluation to score like planning, got %.4f vs %.4f", campaign.Score, p.Score)
+		}
+	}
+
+	// Dropping the recon step leaves AC-D without the hypothesis it requires.
+	_, err = eng.EvaluateCampaign([]string{"AC-D"}, reasoning.NodeTypeDataExposure, opts)
+	var infeasible *reasoning.CampaignInfeasibleError
+	if !errors.As(err, &infeasible) {
+		t.Fatalf("expected infeasibility error, got %v", err)
+	}
+	if infeasible.Step != 1 || infeasible.ActionClassID != "AC-D" || infeasible.Reason != reasoning.RejectionPreconditionUnmet {
+		t.Fatalf("expected step 1 AC-D to break its precondition, got %+v", infeasible)
+	}
+}
+
+func TestPlanCampaignMaxStepRiskRejectsNoisySingleStep(t *testing.T) {
+	eng := reasoning.NewEngine(nil)
+	eng.BindActionClasses([]reasoning.ActionClass{
+		{ID: "AC-LOUD", Name: "loud", Phase: state.PhaseRecon, Preconditions: []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{reasoning.NodeTypeEvidence}}}, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeDataExposure}, RiskWeight: 0.6, ConfidenceBoost: 0.3},
+		{ID: "AC-M1", Name: "moderate-1", Phase: state.PhaseRecon, Preconditions: []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{reasoning.NodeTypeEvidence}}}, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeHypothesis}, RiskWeight: 0.3, ConfidenceBoost: 0.3},
+		{ID: "AC-M2", Name: "moderate-2", Phase: state.PhaseRecon, Preconditions: []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{reasoning.NodeTypeHypothesis}}}, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeDataExposure}, RiskWeight: 0.3, ConfidenceBoost: 0.3},
+	})
+	eng.Graph().UpsertNode(&reasoning.Node{ID: "seed", Type: reasoning.NodeTypeEvidence, Label: "seed"})
+	opts := reasoning.CampaignOptions{MaxDepth: 3, RiskTolerance: 1.0, ConfidenceThreshold: 0.4, BeamWidth: 6, TopN: 5}
+
+	uncapped, err := eng.PlanCampaign(reasoning.NodeTypeDataExposure, opts)
+	if err != nil {
+		t.Fatalf("plan uncapped: %v", err)
+	}
+	if !campaignsContainAction(uncapped, "AC-LOUD") {
+		t.Fatalf("expected the loud step to fit the cumulative budget without a step cap")
+	}
+
+	opts.MaxStepRisk = 0.4
+	capped, err := eng.PlanCampaign(reasoning.NodeTypeDataExposure, opts)
+	if err != nil {
+		t.Fatalf("plan capped: %v", err)
+	}
+	if campaignsContainAction(capped, "AC-LOUD") {
+		t.Fatalf("expected MaxStepRisk to reject the loud step")
+	}
+	found := false
+	for _, c := range capped {
+		if len(c.Steps) == 2 && c.Steps[0].ActionClassID == "AC-M1" && c.Steps[1].ActionClassID == "AC-M2" && math.Abs(c.Risk-0.6) < 1e-9 {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatalf("expected moderate steps with the same total risk to be accepted, got %+v", capped)
+	}
+}
+
+func TestCampaignDeadlineViolationsFlagOverrunPhases(t *testing.T) {
+	eng := reasoning.NewEngine(nil)
+	eng.BindActionClasses([]reasoning.ActionClass{
+		{ID: "AC-R1", Name: "sweep", Phase: state.PhaseRecon, Preconditions: []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{reasoning.NodeTypeEvidence}}}, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeHypothesis}, RiskWeight: 0.1, ConfidenceBoost: 0.2, Duration: 3 * time.Hour},
+		{ID: "AC-R2", Name: "deep scan", Phase: state.PhaseRecon, Preconditions: []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{reasoning.NodeTypeHypothesis}}}, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeHypothesis}, RiskWeight: 0.1, ConfidenceBoost: 0.2, Duration: 3 * time.Hour},
+		{ID: "AC-D", Name: "data", Phase: state.PhaseInitialAccess, Preconditions: []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{reasoning.NodeTypeHypothesis}}}, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeDataExposure}, RiskWeight: 0.2, ConfidenceBoost: 0.3, Duration: time.Hour},
+	})
+	eng.Graph().UpsertNode(&reasoning.Node{ID: "seed", Type: reasoning.NodeTypeEvidence, Label: "seed"})
+	opts := reasoning.CampaignOptions{MaxDepth: 4, RiskTolerance: 1.0, ConfidenceThreshold: 0.4, BeamWidth: 6, TopN: 5,
+		PhaseDeadlines: []reasoning.PhaseDeadline{{Phase: state.PhaseRecon, Deadline: 4 * time.Hour}, {Phase: state.PhaseInitialAccess, Deadline: 2 * time.Hour}}}
+
+	heavy, err := eng.EvaluateCampaign([]string{"AC-R1", "AC-R2", "AC-D"}, reasoning.NodeTypeDataExposure, opts)
+	if err != nil {
+		t.Fatalf("evaluate recon-heavy campaign: %v", err)
+	}
+	if len(heavy.DeadlineViolations) != 1 || heavy.DeadlineViolations[0] != state.PhaseRecon {
+		t.Fatalf("expected recon deadline violation, got %v", heavy.DeadlineViolations)
+	}
+	balanced, err := eng.EvaluateCampaign([]string{"AC-R1", "AC-D"}, reasoning.NodeTypeDataExposure, opts)
+	if err != nil {
+		t.Fatalf("evaluate balanced campaign: %v", err)
+	}
+	if len(balanced.DeadlineViolations) != 0 {
+		t.Fatalf("expected no deadline violations, got %v", balanced.DeadlineViolations)
+	}
+
+	planned, err := eng.PlanCampaign(reasoning.NodeTypeDataExposure, opts)
+	if err != nil {
+		t.Fatalf("plan campaign: %v", err)
+	}
+	for _, c := range planned {
+		if len(c.Steps) == 3 && len(c.DeadlineViolations) == 0 {
+			t.Fatalf("expected planned recon-heavy campaign to be flagged, got %+v", c)
+		}
+	}
+}
+
+func TestRecheckCampaignReportsStepsBrokenByGraphMutation(t *testing.T) {
+	eng := reasoning.NewEngine(nil)
+	eng.BindActionClasses([]reasoning.ActionClass{
+		{ID: "AC-R", Name: "recon", Phase: state.PhaseRecon, Preconditions: []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{reasoning.NodeTypeEvidence}}}, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeHypothesis}, RiskWeight: 0.1, ConfidenceBoost: 0.2},
+		{ID: "AC-D", Name: "data", Phase: state.PhaseRecon, Preconditions: []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{reasoning.NodeTypeHypothesis}}}, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeDataExposure}, RiskWeight: 0.2, ConfidenceBoost: 0.3},
+	})
+	eng.Graph().UpsertNode(&reasoning.Node{ID: "seed", Type: reasoning.NodeTypeEvidence, Label: "seed"})
+	campaign, err := eng.EvaluateCampaign([]string{"AC-R", "AC-D"}, reasoning.NodeTypeDataExposure, reasoning.CampaignOptions{MaxDepth: 4, RiskTolerance: 1.0, ConfidenceThreshold: 0.4, BeamWidth: 6, TopN: 5})
+	if err != nil {
+		t.Fatalf("evaluate campaign: %v", err)
+	}
+	if ok, broken := eng.RecheckCampaign(*campaign); !ok || len(broken) != 0 {
+		t.Fatalf("expected fresh campaign to recheck clean, got ok=%v broken=%v", ok, broken)
+	}
+
+	// Evicting the only evidence node invalidates the recon step; the data step still follows from its projection.
+	eng.Graph().PruneNodes(0)
+	ok, broken := eng.RecheckCampaign(*campaign)
+	if ok || len(broken) != 1 || broken[0] != "AC-R" {
+		t.Fatalf("expected only AC-R to break, got ok=%v broken=%v", ok, broken)
+	}
+}
+
+func TestObjectiveBiasRampFavorsLateObjectiveApproach(t *testing.T) {
+	eng := reasoning.NewEngine(nil)
+	evidence := []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{reasoning.NodeTypeEvidence}}}
+	hypothesis := []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{reasoning.NodeTypeHypothesis}}}
+	eng.BindActionClasses([]reasoning.ActionClass{
+		{ID: "AC-DIRECT", Name: "direct", Phase: state.PhaseRecon, Preconditions: evidence, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeDataExposure}, RiskWeight: 0.2, ConfidenceBoost: 0.3},
+		{ID: "AC-R1", Name: "sweep", Phase: state.PhaseRecon, Preconditions: evidence, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeHypothesis}, RiskWeight: 0.1, ConfidenceBoost: 0.3},
+		{ID: "AC-R2", Name: "deep scan", Phase: state.PhaseRecon, Preconditions: hypothesis, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeHypothesis}, RiskWeight: 0.1, ConfidenceBoost: 0.3},
+		{ID: "AC-D", Name: "data", Phase: state.PhaseRecon, Preconditions: hypothesis, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeDataExposure}, RiskWeight: 0.2, ConfidenceBoost: 0.3},
+	})
+	eng.Graph().UpsertNode(&reasoning.Node{ID: "seed", Type: reasoning.NodeTypeEvidence, Label: "seed"})
+
+	scores := func(decay reasoning.ObjectiveBiasDecay) (early, late float64) {
+		opts := reasoning.CampaignOptions{MaxDepth: 4, RiskTolerance: 1.0, ConfidenceThreshold: 0.4, BeamWidth: 6, TopN: 5, ObjectiveBiasWeight: 1.0, ObjectiveBiasDecay: decay}
+		short, err := eng.EvaluateCampaign([]string{"AC-DIRECT"}, reasoning.NodeTypeDataExposure, opts)
+		if err != nil {
+			t.Fatalf("evaluate early campaign: %v", err)
+		}
+		long, err := eng.EvaluateCampaign([]string{"AC-R1", "AC-R2", "AC-D"}, reasoning.NodeTypeDataExposure, opts)
+		if err != nil {
+			t.Fatalf("evaluate late campaign: %v", err)
+		}
+		return short.Score, long.Score
+	}
+
+	uniformEarly, uniformLate := scores(reasoning.ObjectiveBiasDecayUniform)
+	rampEarly, rampLate := scores(reasoning.ObjectiveBiasDecayRamp)
+	if math.Abs(rampLate-uniformLate) < 1e-9 {
+		t.Fatalf("expected ramped bias to change the late campaign's score, both %.4f", rampLate)
+	}
+	if rampLate-rampEarly <= uniformLate-uniformEarly {
+		t.Fatalf("expected ramp to favor the late approach relative to uniform: ramp gap %.4f, uniform gap %.4f", rampLate-rampEarly, uniformLate-uniformEarly)
+	}
+}
+
+func TestUpdateActionClassReplacesOnlyThatClass(t *testing.T) {
+	eng := reasoning.NewEngine(nil)
+	evidence := []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{reasoning.NodeTypeEvidence}}}
+	original := []reasoning.ActionClass{
+		{ID: "AC-A", Name: "a", Phase: state.PhaseRecon, Preconditions: evidence, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeDataExposure}, RiskWeight: 0.2, ConfidenceBoost: 0.3},
+		{ID: "AC-B", Name: "b", Phase: state.PhaseRecon, Preconditions: evidence, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeDataExposure}, RiskWeight: 0.2, ConfidenceBoost: 0.3},
+		{ID: "AC-C", Name: "c", Phase: state.PhaseRecon, Preconditions: evidence, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeDataExposure}, RiskWeight: 0.2, ConfidenceBoost: 0.3},
+	}
+	eng.BindActionClasses(original)
+	eng.Graph().UpsertNode(&reasoning.Node{ID: "seed", Type: reasoning.NodeTypeEvidence, Label: "seed"})
+	opts := reasoning.CampaignOptions{MaxDepth: 1, RiskTolerance: 1.0, ConfidenceThreshold: 0.4, BeamWidth: 6, TopN: 5}
+	prev, err := eng.PlanCampaign(reasoning.NodeTypeDataExposure, opts)
+	if err != nil {
+		t.Fatalf("plan campaign: %v", err)
+	}
+
+	updated := original[1]
+	updated.RiskWeight = 0.7
+	if err := eng.UpdateActionClass(updated); err != nil {
+		t.Fatalf("update action class: %v", err)
+	}
+	if err := eng.UpdateActionClass(reasoning.ActionClass{Name: "unnamed"}); err == nil {
+		t.Fatal("expected an action class without an id to be rejected")
+	}
+
+	classes := eng.ActionClasses()
+	if len(classes) != 3 || !reflect.DeepEqual(classes[0], original[0]) || !reflect.DeepEqual(classes[2], original[2]) {
+		t.Fatalf("expected untouched classes to survive the update, got %+v", classes)
+	}
+	if classes[1].RiskWeight != 0.7 {
+		t.Fatalf("expected AC-B risk weight 0.7, got %.2f", classes[1].RiskWeight)
+	}
+
+	replanned, err := eng.ReplanIncremental(prev, reasoning.NodeTypeDataExposure, opts)
+	if err != nil {
+		t.Fatalf("replan campaign: %v", err)
+	}
+	for _, c := range replanned {
+		want := 0.2
+		if c.Steps[0].ActionClassID == "AC-B" {
+			want = 0.7
+		}
+		if math.Abs(c.Risk-want) > 1e-9 {
+			t.Fatalf("expected %s campaign risk %.2f after update, got %.2f", c.Steps[0].ActionClassID, want, c.Risk)
+		}
+	}
+}
+
+func TestCampaignThresholdSweepCountsNeverRiseWithThreshold(t *testing.T) {
+	eng := reasoning.NewEngine(nil)
+	evidence := []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{reasoning.NodeTypeEvidence}}}
+	hypothesis := []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{reasoning.NodeTypeHypothesis}}}
+	data := []reasoning.NodeType{reasoning.NodeTypeDataExposure}
+	eng.BindActionClasses([]reasoning.ActionClass{
+		{ID: "AC-D1", Name: "d1", Phase: state.PhaseRecon, Preconditions: evidence, ProducesNodes: data, RiskWeight: 0.1, ConfidenceBoost: 0.1},
+		{ID: "AC-D2", Name: "d2", Phase: state.PhaseRecon, Preconditions: evidence, ProducesNodes: data, RiskWeight: 0.1, ConfidenceBoost: 0.2},
+		{ID: "AC-D3", Name: "d3", Phase: state.PhaseRecon, Preconditions: evidence, ProducesNodes: data, RiskWeight: 0.1, ConfidenceBoost: 0.35},
+		{ID: "AC-R", Name: "r", Phase: state.PhaseRecon, Preconditions: evidence, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeHypothesis}, RiskWeight: 0.1, ConfidenceBoost: 0.05},
+		{ID: "AC-D4", Name: "d4", Phase: state.PhaseRecon, Preconditions: hypothesis, ProducesNodes: data, RiskWeight: 0.1, ConfidenceBoost: 0.45},
+	})
+	eng.Graph().UpsertNode(&reasoning.Node{ID: "seed", Type: reasoning.NodeTypeEvidence, Label: "seed"})
+	opts := reasoning.CampaignOptions{MaxDepth: 2, RiskTolerance: 1.0, BeamWidth: 10, TopN: 20}
+
+	thresholds := []float64{0.9, 0.5, 0.6, 0.7, 0.8}
+	counts := eng.CampaignThresholdSweep(reasoning.NodeTypeDataExposure, opts, thresholds)
+	if len(counts) != len(thresholds) {
+		t.Fatalf("expected a count per threshold, got %v", counts)
+	}
+	ordered := []float64{0.5, 0.6, 0.7, 0.8, 0.9}
+	for i := 1; i < len(ordered); i++ {
+		if counts[ordered[i]] > counts[ordered[i-1]] {
+			t.Fatalf("expected non-increasing counts, got %v", counts)
+		}
+	}
+	if counts[0.5] == 0 || counts[0.5] == counts[0.9] {
+		t.Fatalf("expected the sweep to separate thresholds, got %v", counts)
+	}
+}
+
+func TestCampaignIndicatorBundleOrdersOneIndicatorPerStep(t *testing.T) {
+	campaign := reasoning.Campaign{Objective: reasoning.NodeTypeDataExposure, Steps: []reasoning.AttackStep{
+		{ActionClassID: "AC-01", Statement: "observe", Confidence: 0.6, Phase: state.PhaseRecon},
+		{ActionClassID: "AC-08", Statement: "validate credentials", Confidence: 0.7, Phase: state.PhaseInitialAccess},
+		{ActionClassID: "AC-13", Statement: "expose data", Confidence: 0.8, Phase: state.PhaseObjective},
+	}}
+	raw, err := campaign.ToIndicatorBundle()
+	if err != nil {
+		t.Fatalf("indicator bundle: %v", err)
+	}
+	var bundle struct {
+		Type    string `json:"type"`
+		Objects []struct {
+			Type       string   `json:"type"`
+			ID         string   `json:"id"`
+			SourceRef  string   `json:"source_ref"`
+			TargetRef  string   `json:"target_ref"`
+			Techniques []string `json:"x_vantage_techniques"`
+		} `json:"objects"`
+	}
+	if err := json.Unmarshal(raw, &bundle); err != nil {
+		t.Fatalf("decode bundle: %v", err)
+	}
+	var indicators, relationships []string
+	for _, obj := range bundle.Objects {
+		switch obj.Type {
+		case "indicator":
+			indicators = append(indicators, obj.ID)
+			if len(obj.Techniques) == 0 {
+				t.Fatalf("expected %s to list its action class techniques", obj.ID)
+			}
+		case "relationship":
+			relationships = append(relationships, obj.SourceRef+">"+obj.TargetRef)
+		}
+	}
+	if bundle.Type != "bundle" || len(indicators) != len(campaign.Steps) {
+		t.Fatalf("expected a bundle with %d indicators, got %s with %v", len(campaign.Steps), bundle.Type, indicators)
+	}
+	want := []string{indicators[0] + ">" + indicators[1], indicators[1] + ">" + indicators[2]}
+	if !reflect.DeepEqual(relationships, want) {
+		t.Fatalf("expected relationships in step order %v, got %v", want, relationships)
+	}
+}
+
+func TestCampaignIndicatorBundleIDsAreUniqueAcrossCampaigns(t *testing.T) {
+	shared := reasoning.AttackStep{ActionClassID: "AC-01", Statement: "observe", Confidence: 0.6, Phase: state.PhaseRecon}
+	campaigns := []reasoning.Campaign{
+		{Objective: reasoning.NodeTypeDataExposure, Steps: []reasoning.AttackStep{shared, {ActionClassID: "AC-08", Phase: state.PhaseInitialAccess}}},
+		{Objective: reasoning.NodeTypeDataExposure, Steps: []reasoning.AttackStep{shared, {ActionClassID: "AC-13", Phase: state.PhaseObjective}}},
+		{Objective: reasoning.NodeTypeDataExposure, Steps: []reasoning.AttackStep{shared, shared}},
+	}
+	seen := map[string]int{}
+	for i, campaign := range campaigns {
+		raw, err := campaign.ToIndicatorBundle()
+		if err != nil {
+			t.Fatalf("indicator bundle %d: %v", i, err)
+		}
+		var bundle struct {
+			Objects []struct {
+				ID string `json:"id"`
+			} `json:"objects"`
+		}
+		if err := json.Unmarshal(raw, &bundle); err != nil {
+			t.Fatalf("decode bundle %d: %v", i, err)
+		}
+		for _, obj := range bundle.Objects {
+			if prev, dup := seen[obj.ID]; dup {
+				t.Fatalf("object %s of campaign %d collides with campaign %d", obj.ID, i, prev)
+			}
+			seen[obj.ID] = i
+		}
+	}
+}
+
+func TestMinEvidenceForImpactGatesObjectivePhaseSteps(t *testing.T) {
+	eng := reasoning.NewEngine(nil)
+	// One class per phase from recon to objective, each unlocking the next.
+	var classes []reasoning.ActionClass
+	prev := reasoning.NodeTypeEvidence
+	for i, phase := range state.Phases() {
+		produced := reasoning.NodeType(fmt.Sprintf("stage-%d", i))
+		if phase == state.PhaseObjective {
+			produced = reasoning.NodeTypeDataExposure
+		}
+		classes = append(classes, reasoning.ActionClass{ID: fmt.Sprintf("AC-%02d", i), Name: string(phase), Phase: phase, Preconditions: []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{prev}}}, ProducesNodes: []reasoning.NodeType{produced}, RiskWeight: 0.1, ConfidenceBoost: 0.3})
+		if phase == state.PhaseObjective {
+			break
+		}
+		prev = produced
+	}
+	impactID := classes[len(classes)-1].ID
+	eng.BindActionClasses(classes)
+	eng.SetMinEvidenceForImpact(2)
+	eng.Graph().UpsertNode(&reasoning.Node{ID: "seed", Type: reasoning.NodeTypeEvidence, Label: "seed"})
+	opts := reasoning.CampaignOptions{MaxDepth: len(classes), RiskTolerance: 1.0, ConfidenceThreshold: 0.01, BeamWidth: 6, TopN: 5}
+
+	campaigns, report, err := eng.PlanCampaignWithReport(reasoning.NodeTypeDataExposure, opts)
+	if err != nil {
+		t.Fatalf("plan gated campaign: %v", err)
+	}
+	if len(campaigns) != 0 {
+		t.Fatalf("expected no campaigns on a single evidence node, got %+v", campaigns)
+	}
+	gated := false
+	for _, r := range report.Rejections {
+		if r.ActionClassID == impactID && r.Reason == reasoning.RejectionInsufficientEvidence {
+			gated = true
+		}
+	}
+	if !gated {
+		t.Fatalf("expected %s rejected for insufficient evidence, got %+v", impactID, report.Rejections)
+	}
+
+	eng.Graph().UpsertNode(&reasoning.Node{ID: "corroboration", Type: reasoning.NodeTypeEvidence, Label: "corroboration"})
+	campaigns, err = eng.PlanCampaign(reasoning.NodeTypeDataExposure, opts)
+	if err != nil {
+		t.Fatalf("plan corroborated campaign: %v", err)
+	}
+	if len(campaigns) == 0 || campaigns[0].Steps[len(campaigns[0].Steps)-1].ActionClassID != impactID {
+		t.Fatalf("expected campaign ending in %s once evidence threshold is met, got %+v", impactID, campaigns)
+	}
+
+	// Consolidating duplicate observations must not re-close the gate: merged nodes count by weight.
+	g := eng.Graph()
+	g.UpsertNode(&reasoning.Node{ID: "seed", Type: reasoning.NodeTypeEvidence, Label: "seed", Metadata: map[string]string{"technique": "T-1", "target": "host", "success": "true"}})
+	g.UpsertNode(&reasoning.Node{ID: "corroboration", Type: reasoning.NodeTypeEvidence, Label: "corroboration", Metadata: map[string]string{"technique": "T-1", "target": "host", "success": "true"}})
+	if removed := g.ConsolidateEvidence(); removed != 1 {
+		t.Fatalf("expected the duplicate observation to be merged, got %d", removed)
+	}
+	campaigns, err = eng.PlanCampaign(reasoning.NodeTypeDataExposure, opts)
+	if err != nil || len(campaigns) == 0 || campaigns[0].Steps[len(campaigns[0].Steps)-1].ActionClassID != impactID {
+		t.Fatalf("expected consolidation to keep the impact gate open, got %+v (%v)", campaigns, err)
+	}
+}
+
+func TestObjectiveScoreNormalizationLevelsTopScoresAcrossObjectives(t *testing.T) {
+	eng := reasoning.NewEngine(nil)
+	eng.BindActionClasses([]reasoning.ActionClass{
+		{ID: "AC-R", Name: "recon", Phase: state.PhaseRecon, Preconditions: []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{reasoning.NodeTypeEvidence}}}, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeHypothesis}, RiskWeight: 0.1, ConfidenceBoost: 0.2},
+		{ID: "AC-D", Name: "data", Phase: state.PhaseRecon, Preconditions: []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{reasoning.NodeTypeHypothesis}}}, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeDataExposure}, RiskWeight: 0.1, ImpactWeight: 3.0, ConfidenceBoost: 0.9},
+		{ID: "AC-P", Name: "priv", Phase: state.PhaseRecon, Preconditions: []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{reasoning.NodeTypeHypothesis}}}, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypePrivEsc}, RiskWeight: 0.6, ImpactWeight: 0.1, ConfidenceBoost: 0.3},
+	})
+	eng.Graph().UpsertNode(&reasoning.Node{ID: "seed", Type: reasoning.NodeTypeEvidence, Label: "seed"})
+	opts := reasoning.CampaignOptions{MaxDepth: 3, RiskTolerance: 2.0, ConfidenceThreshold: 0.1, BeamWidth: 6, TopN: 5}
+	top := func(objective reasoning.NodeType) float64 {
+		t.Helper()
+		campaigns, err := eng.PlanCampaign(objective, opts)
+		if err != nil || len(campaigns) == 0 {
+			t.Fatalf("plan %s: %v (%d campaigns)", objective, err, len(campaigns))
+		}
+		return campaigns[0].Score
+	}
+
+	data, priv := top(reasoning.NodeTypeDataExposure), top(reasoning.NodeTypePrivEsc)
+	if data <= 0 || priv <= 0 || math.Abs(data-priv) < 0.1*math.Max(data, priv) {
+		t.Fatalf("expected raw top scores on different scales, got data=%.4f priv=%.4f", data, priv)
+	}
+	if err := eng.SetObjectiveScoreNormalization(map[reasoning.NodeType]float64{reasoning.NodeTypeDataExposure: 1 / data, reasoning.NodeTypePrivEsc: 1 / priv}); err != nil {
+		t.Fatalf("set normalization: %v", err)
+	}
+	normData, normPriv := top(reasoning.NodeTypeDataExposure), top(reasoning.NodeTypePrivEsc)
+	if math.Abs(normData-1) > 1e-9 || math.Abs(normPriv-1) > 1e-9 {
+		t.Fatalf("expected normalized top scores of 1, got data=%.4f priv=%.4f", normData, normPriv)
+	}
+	shortest, err := eng.ShortestCampaign(reasoning.NodeTypePrivEsc, opts)
+	if err != nil || math.Abs(shortest.Score-1) > 1e-9 {
+		t.Fatalf("expected shortest campaign scaled like planning, got %+v err=%v", shortest, err)
+	}
+
+	if err := eng.SetObjectiveScoreNormalization(map[reasoning.NodeType]float64{reasoning.NodeTypePrivEsc: 0}); err == nil {
+		t.Fatalf("expected non-positive factor to be rejected")
+	}
+}
+
+func TestReachableObjectivesListsOnlyAttainableObjectives(t *testing.T) {
+	eng := reasoning.NewEngine(nil)
+	eng.BindActionClasses([]reasoning.ActionClass{
+		{ID: "AC-R", Name: "recon", Phase: state.PhaseRecon, Preconditions: []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{reasoning.NodeTypeEvidence}}}, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeHypothesis}, RiskWeight: 0.1, ConfidenceBoost: 0.2},
+		{ID: "AC-D", Name: "data", Phase: state.PhaseRecon, Preconditions: []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{reasoning.NodeTypeHypothesis}}}, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeDataExposure}, RiskWeight: 0.2, ConfidenceBoost: 0.3},
+		{ID: "AC-P", Name: "priv", Phase: state.PhaseRecon, Preconditions: []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{"credential"}}}, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypePrivEsc}, RiskWeight: 0.25, ConfidenceBoost: 0.25},
+	})
+	eng.Graph().UpsertNode(&reasoning.Node{ID: "seed", Type: reasoning.NodeTypeEvidence, Label: "seed"})
+	opts := reasoning.CampaignOptions{MaxDepth: 4, RiskTolerance: 1.0, ConfidenceThreshold: 0.4, BeamWidth: 6, TopN: 5}
+
+	reachable := eng.ReachableObjectives(opts)
+	if !reflect.DeepEqual(reachable, []reasoning.NodeType{reasoning.NodeTypeDataExposure}) {
+		t.Fatalf("expected only DATA_EXPOSURE reachable, got %v", reachable)
+	}
+
+	eng.Graph().UpsertNode(&reasoning.Node{ID: "cred", Type: "credential", Label: "harvested credential"})
+	reachable = eng.ReachableObjectives(opts)
+	if !reflect.DeepEqual(reachable, []reasoning.NodeType{reasoning.NodeTypeDataExposure, reasoning.NodeTypePrivEsc}) {
+		t.Fatalf("expected PRIV_ESC to become reachable once a credential is held, got %v", reachable)
+	}
+}
+
+func TestSuggestDeRiskRanksLowRiskEquivalentSwapFirst(t *testing.T) {
+	const credential reasoning.NodeType = "credential"
+	eng := reasoning.NewEngine(nil)
+	eng.BindActionClasses([]reasoning.ActionClass{
+		{ID: "AC-R", Name: "recon", Phase: state.PhaseRecon, Preconditions: []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{reasoning.NodeTypeEvidence}}}, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeHypothesis}, RiskWeight: 0.1, ConfidenceBoost: 0.2},
+		{ID: "AC-LOUD", Name: "spray", Phase: state.PhaseRecon, Preconditions: []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{reasoning.NodeTypeHypothesis}}}, ProducesNodes: []reasoning.NodeType{credential}, RiskWeight: 0.7, ConfidenceBoost: 0.3},
+		{ID: "AC-MID", Name: "reuse", Phase: state.PhaseRecon, Preconditions: []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{reasoning.NodeTypeHypothesis}}}, ProducesNodes: []reasoning.NodeType{credential}, RiskWeight: 0.4, ConfidenceBoost: 0.3},
+		{ID: "AC-QUIET", Name: "harvest", Phase: state.PhaseRecon, Preconditions: []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{reasoning.NodeTypeHypothesis}}}, ProducesNodes: []reasoning.NodeType{credential}, RiskWeight: 0.1, ConfidenceBoost: 0.3},
+		{ID: "AC-SIDE", Name: "side", Phase: state.PhaseRecon, Preconditions: []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{reasoning.NodeTypeHypothesis}}}, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeTechnique}, RiskWeight: 0.05, ConfidenceBoost: 0.3},
+		{ID: "AC-D", Name: "data", Phase: state.PhaseRecon, Preconditions: []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{credential}}}, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeDataExposure}, RiskWeight: 0.2, ConfidenceBoost: 0.3},
+	})
+	eng.Graph().UpsertNode(&reasoning.Node{ID: "seed", Type: reasoning.NodeTypeEvidence, Label: "seed"})
+	opts := reasoning.CampaignOptions{MaxDepth: 4, RiskTolerance: 2.0, ConfidenceThreshold: 0.1, BeamWidth: 6, TopN: 5}
+	campaign, err := eng.EvaluateCampaign([]string{"AC-R", "AC-LOUD", "AC-D"}, reasoning.NodeTypeDataExposure, opts)
+	if err != nil {
+		t.Fatalf("evaluate campaign: %v", err)
+	}
+
+	suggestions := eng.SuggestDeRisk(*campaign)
+	if len(suggestions) != 2 {
+		t.Fatalf("expected two credential-producing swaps, got %+v", suggestions)
+	}
+	top := suggestions[0]
+	if top.Step != 2 || top.ActionClassID != "AC-LOUD" || top.Replacement != "AC-QUIET" || math.Abs(top.RiskReduction-0.6) > 1e-9 {
+		t.Fatalf("expected swapping AC-LOUD for AC-QUIET first, got %+v", top)
+	}
+	if last := top.Campaign.Steps[len(top.Campaign.Steps)-1]; last.ActionClassID != "AC-D" || top.Campaign.Objective != reasoning.NodeTypeDataExposure {
+		t.Fatalf("expected de-risked campaign to still reach the objective, got %+v", top.Campaign)
+	}
+	if suggestions[1].Replacement != "AC-MID" || suggestions[1].RiskReduction >= top.RiskReduction {
+		t.Fatalf("expected AC-MID as the smaller reduction, got %+v", suggestions[1])
+	}
+}
+
+func TestPlanCampaignMetricsReportsSearchWork(t *testing.T) {
+	eng := reasoning.NewEngine(nil)
+	eng.BindActionClasses([]reasoning.ActionClass{
+		{ID: "AC-R", Name: "recon", Phase: state.PhaseRecon, Preconditions: []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{reasoning.NodeTypeEvidence}}}, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeHypothesis}, RiskWeight: 0.1, ConfidenceBoost: 0.2},
+		{ID: "AC-S", Name: "survey", Phase: state.PhaseRecon, Preconditions: []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{reasoning.NodeTypeEvidence}}}, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeTechnique}, RiskWeight: 0.1, ConfidenceBoost: 0.2},
+		{ID: "AC-D", Name: "data", Phase: state.PhaseRecon, Preconditions: []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{reasoning.NodeTypeHypothesis}}}, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeDataExposure}, RiskWeight: 0.1, ConfidenceBoost: 0.2},
+	})
+	eng.Graph().UpsertNode(&reasoning.Node{ID: "seed", Type: reasoning.NodeTypeEvidence, Label: "seed"})
+	opts := reasoning.CampaignOptions{MaxDepth: 3, RiskTolerance: 1, ConfidenceThreshold: 0.1, BeamWidth: 2, TopN: 5}
+
+	campaigns, metrics, err := eng.PlanCampaignMetrics(reasoning.NodeTypeDataExposure, opts)
+	if err != nil {
+		t.Fatalf("plan campaign metrics: %v", err)
+	}
+	plain, err := eng.PlanCampaign(reasoning.NodeTypeDataExposure, opts)
+	if err != nil || len(plain) != len(campaigns) {
+		t.Fatalf("expected metrics collection not to change the plan, got %d vs %d (%v)", len(campaigns), len(plain), err)
+	}
+	if metrics.CandidatesProjected <= 0 || len(metrics.PrunedPerDepth) == 0 || metrics.WallTime <= 0 {
+		t.Fatalf("expected positive search work, got %+v", metrics)
+	}
+	if metrics.UnlockCacheHitRate < 0 || metrics.UnlockCacheHitRate > 1 || metrics.UnlockCacheHits+metrics.UnlockCacheMisses == 0 {
+		t.Fatalf("expected an unlock cache hit rate within [0,1], got %+v", metrics)
+	}
+}
+
+func TestPlanCampaignFlagsRedundantSubChains(t *testing.T) {
+	eng := reasoning.NewEngine(nil)
+	evidence := []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{reasoning.NodeTypeEvidence}}}
+	eng.BindActionClasses([]reasoning.ActionClass{
+		{ID: "AC-A", Name: "probe a", Phase: state.PhaseRecon, Preconditions: evidence, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeHypothesis}, RiskWeight: 0.1, ConfidenceBoost: 0.3},
+		{ID: "AC-B", Name: "probe b", Phase: state.PhaseRecon, Preconditions: evidence, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeHypothesis}, RiskWeight: 0.1, ConfidenceBoost: 0.3},
+		{ID: "AC-D", Name: "data", Phase: state.PhaseRecon, Preconditions: []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{reasoning.NodeTypeHypothesis}}}, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeDataExposure}, RiskWeight: 0.1, ConfidenceBoost: 0.3},
+	})
+	eng.Graph().UpsertNode(&reasoning.Node{ID: "seed", Type: reasoning.NodeTypeEvidence, Label: "seed"})
+
+	campaigns, err := eng.PlanCampaign(reasoning.NodeTypeDataExposure, reasoning.CampaignOptions{MaxDepth: 3, RiskTolerance: 1, ConfidenceThreshold: 0.1, BeamWidth: 10, TopN: 10})
+	if err != nil {
+		t.Fatalf("plan campaign: %v", err)
+	}
+	sawRedundant, sawClean := false, false
+	for i, c := range campaigns {
+		ids := make([]string, 0, len(c.Steps))
+		for _, step := range c.Steps {
+			ids = append(ids, step.ActionClassID)
+		}
+		seq := strings.Join(ids, ",")
+		wantRedundant := strings.HasPrefix(seq, "AC-A,AC-B") || strings.HasPrefix(seq, "AC-B,AC-A")
+		if c.Redundant != wantRedundant {
+			t.Fatalf("campaign %s: expected redundant=%t, got %t", seq, wantRedundant, c.Redundant)
+		}
+		if i > 0 && campaigns[i-1].Redundant && !c.Redundant {
+			t.Fatalf("expected non-redundant campaigns ranked ahead of redundant ones")
+		}
+		sawRedundant = sawRedundant || c.Redundant
+		sawClean = sawClean || !c.Redundant
+	}
+	if !sawRedundant || !sawClean {
+		t.Fatalf("expected both redundant and clean campaigns, got %+v", campaigns)
+	}
+}
+
+func TestPlanCampaignRedundancyWithCorpusShapedClasses(t *testing.T) {
+	// Like the shipped corpus, every class produces evidence and a hypothesis joined by a supports edge.
+	corpus := func(id string, preconditions ...reasoning.GraphPattern) reasoning.ActionClass {
+		return reasoning.ActionClass{ID: id, Name: id, Phase: state.PhaseRecon, Preconditions: preconditions, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeEvidence, reasoning.NodeTypeHypothesis}, ProducesEdges: []reasoning.EdgeType{reasoning.EdgeTypeSupports}, RiskWeight: 0.1, ConfidenceBoost: 0.3}
+	}
+	reachability := reasoning.GraphPattern{RequiredNodeTypes: []reasoning.NodeType{reasoning.NodeTypeEvidence}}
+	access := reasoning.GraphPattern{RequiredNodeTypes: []reasoning.NodeType{reasoning.NodeTypeEvidence, reasoning.NodeTypeHypothesis}}
+	eng := reasoning.NewEngine(nil)
+	eng.BindActionClasses([]reasoning.ActionClass{
+		corpus("AC-01"), corpus("AC-02"), corpus("AC-04", reachability),
+		{ID: "AC-Z", Name: "collect", Phase: state.PhaseRecon, Preconditions: []reasoning.GraphPattern{access}, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeDataExposure}, RiskWeight: 0.1, ConfidenceBoost: 0.3},
+	})
+
+	campaigns, err := eng.PlanCampaign(reasoning.NodeTypeDataExposure, reasoning.CampaignOptions{MaxDepth: 4, RiskTolerance: 1, ConfidenceThreshold: 0.1, BeamWidth: 50, TopN: 50})
+	if err != nil {
+		t.Fatalf("plan campaign: %v", err)
+	}
+	sawConsumerChain := false
+	for _, c := range campaigns {
+		ids := make([]string, 0, len(c.Steps))
+		for _, step := range c.Steps {
+			ids = append(ids, step.ActionClassID)
+		}
+		seq := strings.Join(ids, ",")
+		// Only a precondition-free class re-produces an earlier step's output without consuming it.
+		wantRedundant := strings.Contains(seq, ",AC-01") || strings.Contains(seq, ",AC-02")
+		if c.Redundant != wantRedundant {
+			t.Fatalf("campaign %s: expected redundant=%t, got %t", seq, wantRedundant, c.Redundant)
+		}
+		sawConsumerChain = sawConsumerChain || strings.Contains(seq, ",AC-04")
+	}
+	if !sawConsumerChain {
+		t.Fatalf("expected a campaign whose later step consumes the earlier step's output, got %+v", campaigns)
+	}
+}
+
+func TestPlanCampaignMultiGroupsCampaignsByAttainedObjective(t *testing.T) {
+	eng := reasoning.NewEngine(nil)
+	hypothesis := []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{reasoning.NodeTypeHypothesis}}}
+	eng.BindActionClasses([]reasoning.ActionClass{
+		{ID: "AC-R", Name: "recon", Phase: state.PhaseRecon, Preconditions: []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{reasoning.NodeTypeEvidence}}}, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeHypothesis}, RiskWeight: 0.1, ConfidenceBoost: 0.3},
+		{ID: "AC-D", Name: "data", Phase: state.PhaseRecon, Preconditions: hypothesis, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeDataExposure}, RiskWeight: 0.1, ConfidenceBoost: 0.3},
+		{ID: "AC-P", Name: "privesc", Phase: state.PhaseRecon, Preconditions: hypothesis, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypePrivEsc}, RiskWeight: 0.2, ConfidenceBoost: 0.3},
+	})
+	eng.Graph().UpsertNode(&reasoning.Node{ID: "seed", Type: reasoning.NodeTypeEvidence, Label: "seed"})
+	opts := reasoning.CampaignOptions{MaxDepth: 3, RiskTolerance: 1, ConfidenceThreshold: 0.1, BeamWidth: 10, TopN: 5}
+
+	objectives := []reasoning.NodeType{reasoning.NodeTypeDataExposure, reasoning.NodeTypePrivEsc, reasoning.NodeTypeLateralReachability}
+	groups, err := eng.PlanCampaignMulti(objectives, opts)
+	if err != nil {
+		t.Fatalf("plan campaign multi: %v", err)
+	}
+	if _, ok := groups[reasoning.NodeTypeLateralReachability]; ok {
+		t.Fatalf("expected unattained objectives to be absent, got %v", groups[reasoning.NodeTypeLateralReachability])
+	}
+	for _, objective := range objectives[:2] {
+		group := groups[objective]
+		if len(group) == 0 {
+			t.Fatalf("expected campaigns for %s, got %v", objective, groups)
+		}
+		for _, c := range group {
+			if c.Objective != objective || len(c.Steps) == 0 {
+				t.Fatalf("expected %s campaigns to attain %s, got %+v", objective, objective, c)
+			}
+		}
+		single, err := eng.PlanCampaign(objective, opts)
+		if err != nil || len(single) == 0 {
+			t.Fatalf("plan campaign %s: %v", objective, err)
+		}
+		last := func(c reasoning.Campaign) string { return c.Steps[len(c.Steps)-1].ActionClassID }
+		if last(group[0]) != last(single[0]) {
+			t.Fatalf("expected %s group to end like the single-objective plan, got %s vs %s", objective, last(group[0]), last(single[0]))
+		}
+	}
+	if _, err := eng.PlanCampaignMulti(nil, opts); err == nil {
+		t.Fatalf("expected an empty objective list to be rejected")
+	}
+}
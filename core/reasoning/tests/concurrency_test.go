@@ -0,0 +1,168 @@
+package tests
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"vantage/core/reasoning"
+	"vantage/core/state"
+)
+
+func TestPlanCampaignConcurrentWithRunCycle(t *testing.T) {
+	const foothold reasoning.NodeType = "foothold"
+	eng := reasoning.NewEngine(nil)
+	eng.BindActionClasses([]reasoning.ActionClass{
+		{ID: "AC-R", Name: "recon", Phase: state.PhaseRecon, Preconditions: []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{reasoning.NodeTypeEvidence}}}, ProducesNodes: []reasoning.NodeType{foothold}, RiskWeight: 0.1, ConfidenceBoost: 0.2},
+		{ID: "AC-D", Name: "data", Phase: state.PhaseRecon, Preconditions: []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{foothold}}}, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeDataExposure}, RiskWeight: 0.2, ConfidenceBoost: 0.3},
+	})
+	eng.RegisterTechniqueEffect(reasoning.TechniqueEffect{TechniqueID: "T-1", Impact: 0.9, Risk: 0.1, Stealth: 0.8})
+	eng.Graph().UpsertNode(&reasoning.Node{ID: "seed", Type: reasoning.NodeTypeEvidence, Label: "seed"})
+	eng.ConfigureCycle(reasoning.CycleConfig{Target: "host-1", AllowedTechniques: []string{"T-1"}, Executor: &executorStub{}})
+
+	opts := reasoning.CampaignOptions{MaxDepth: 3, RiskTolerance: 1.0, ConfidenceThreshold: 0.4, BeamWidth: 6, TopN: 5}
+	baseline, err := eng.PlanCampaign(reasoning.NodeTypeDataExposure, opts)
+	if err != nil {
+		t.Fatalf("baseline plan: %v", err)
+	}
+	if len(baseline) == 0 {
+		t.Fatalf("expected baseline campaigns")
+	}
+	want := campaignSignature(baseline)
+
+	st, err := state.New("concurrent-plan")
+	if err != nil {
+		t.Fatalf("state new: %v", err)
+	}
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 64)
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 10; i++ {
+			if _, err := eng.RunCycle(st); err != nil {
+				errs <- err
+				return
+			}
+		}
+	}()
+	for w := 0; w < 4; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 5; i++ {
+				campaigns, err := eng.PlanCampaign(reasoning.NodeTypeDataExposure, opts)
+				if err != nil {
+					errs <- err
+					return
+				}
+				if got := campaignSignature(campaigns); got != want {
+					errs <- fmt.Errorf("unstable campaigns: got %s want %s", got, want)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Fatal(err)
+	}
+}
+
+func campaignSignature(campaigns []reasoning.Campaign) string {
+	out := ""
+	for _, c := range campaigns {
+		for _, step := range c.Steps {
+			out += step.ActionClassID + ","
+		}
+		out += fmt.Sprintf("%.6f;", c.Score)
+	}
+	return out
+}
+
+func TestGraphConcurrentUpsertAndReadsAreRaceFree(t *testing.T) {
+	g := reasoning.NewGraph()
+	const writers, perWriter = 4, 200
+
+	var wg sync.WaitGroup
+	for w := 0; w < writers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			prev := ""
+			for i := 0; i < perWriter; i++ {
+				id := fmt.Sprintf("n-%d-%d", w, i)
+				nodeType := reasoning.NodeTypeEvidence
+				if i%10 == 9 {
+					nodeType = reasoning.NodeTypeDataExposure
+				}
+				g.UpsertNode(&reasoning.Node{ID: id, Type: nodeType, Label: id, Metadata: map[string]string{"writer": fmt.Sprint(w)}})
+				if prev != "" {
+					_ = g.AddEdge(&reasoning.Edge{From: prev, To: id, Type: reasoning.EdgeTypeEnables, Weight: 1})
+				}
+				prev = id
+				if i%25 == 0 {
+					g.PinObjectivePaths([]reasoning.NodeType{reasoning.NodeTypeDataExposure})
+				}
+			}
+		}(w)
+	}
+	for r := 0; r < 4; r++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < perWriter; i++ {
+				for _, n := range g.NodesByType(reasoning.NodeTypeEvidence) {
+					_ = n.Metadata["writer"]
+					_ = n.Pinned
+				}
+				if n, ok := g.Node("n-0-0"); ok {
+					_, _ = n.Label, n.Pinned
+				}
+				_, _ = g.FindPath("n-0-0", "n-0-9")
+				_ = g.ToDOT()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := len(g.NodesByType(reasoning.NodeTypeEvidence)) + len(g.NodesByType(reasoning.NodeTypeDataExposure)); got != writers*perWriter {
+		t.Fatalf("expected %d nodes after concurrent upserts, got %d", writers*perWriter, got)
+	}
+}
+
+func TestEngineConcurrentPlanningAndIngestionAreRaceFree(t *testing.T) {
+	eng := reasoning.NewEngine(nil)
+	eng.RegisterTechniqueEffect(reasoning.TechniqueEffect{TechniqueID: "T-1", Impact: 0.9, Risk: 0.1, Stealth: 0.8})
+	eng.RegisterTechniqueEffect(reasoning.TechniqueEffect{TechniqueID: "T-2", Impact: 0.5, Risk: 0.3, Stealth: 0.6})
+	query := reasoning.PlannerQuery{Target: "host-1", AllowedTechniques: []string{"T-1", "T-2"}}
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 16)
+	for w := 0; w < 4; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < 50; i++ {
+				if err := eng.IngestEvidence(reasoning.EvidenceEvent{TechniqueID: fmt.Sprintf("T-%d", w%2+1), Target: "host-1", Success: i%2 == 0}); err != nil {
+					errs <- err
+					return
+				}
+				if _, err := eng.PlanNextAction(query); err != nil {
+					errs <- err
+					return
+				}
+				if i%10 == 0 {
+					eng.SetTechniqueScoreWeights(reasoning.DefaultTechniqueScoreWeights())
+				}
+			}
+		}(w)
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,52 @@
+package tests
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"vantage/core/reasoning"
+)
+
+func TestDecisionLogRoundTripPreservesSelections(t *testing.T) {
+	re := reasoning.NewEngine(nil)
+	re.RegisterTechniqueEffect(reasoning.TechniqueEffect{TechniqueID: "T-A", Impact: 0.9, Risk: 0.2, Stealth: 0.7})
+	re.RegisterTechniqueEffect(reasoning.TechniqueEffect{TechniqueID: "T-B", Impact: 0.5, Risk: 0.1, Stealth: 0.8})
+	var decisions []reasoning.Decision
+	for _, allowed := range [][]string{{"T-A", "T-B"}, {"T-B"}} {
+		decision, err := re.PlanNextAction(reasoning.PlannerQuery{Target: "host-1", AllowedTechniques: allowed})
+		if err != nil {
+			t.Fatalf("plan next action: %v", err)
+		}
+		decisions = append(decisions, *decision)
+	}
+
+	var buf bytes.Buffer
+	if err := reasoning.WriteDecisionLog(&buf, decisions); err != nil {
+		t.Fatalf("write decision log: %v", err)
+	}
+	if lines := strings.Count(buf.String(), "\n"); lines != len(decisions)+1 {
+		t.Fatalf("expected a header plus one line per decision, got %d lines", lines)
+	}
+	loaded, err := reasoning.ReadDecisionLog(&buf)
+	if err != nil {
+		t.Fatalf("read decision log: %v", err)
+	}
+	if len(loaded) != len(decisions) {
+		t.Fatalf("expected %d decisions, got %d", len(decisions), len(loaded))
+	}
+	for i := range decisions {
+		if loaded[i].Selected != decisions[i].Selected || len(loaded[i].Ranked) != len(decisions[i].Ranked) || !loaded[i].CreatedAt.Equal(decisions[i].CreatedAt) {
+			t.Fatalf("decision %d changed across round trip: %+v vs %+v", i, loaded[i], decisions[i])
+		}
+		for j := range decisions[i].Ranked {
+			if loaded[i].Ranked[j] != decisions[i].Ranked[j] {
+				t.Fatalf("decision %d ranked %d changed: %+v vs %+v", i, j, loaded[i].Ranked[j], decisions[i].Ranked[j])
+			}
+		}
+	}
+
+	if _, err := reasoning.ReadDecisionLog(strings.NewReader(`{"format":"vantage-decision-log","version":99}` + "\n")); err == nil {
+		t.Fatalf("expected an unsupported version to be rejected")
+	}
+}
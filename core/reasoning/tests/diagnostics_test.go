@@ -0,0 +1,138 @@
+package tests
+
+import (
+	"testing"
+
+	"vantage/core/reasoning"
+	"vantage/core/state"
+)
+
+func TestPhaseReachabilityReconOnlyCorpus(t *testing.T) {
+	eng := reasoning.NewEngine(nil)
+	eng.BindActionClasses([]reasoning.ActionClass{
+		{ID: "AC-R1", Name: "recon", Phase: state.PhaseRecon, Preconditions: []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{reasoning.NodeTypeEvidence}}}, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeHypothesis}},
+		{ID: "AC-R2", Name: "enum", Phase: state.PhaseRecon, Preconditions: []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{reasoning.NodeTypeHypothesis}}}, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeTechnique}},
+	})
+	eng.Graph().UpsertNode(&reasoning.Node{ID: "seed", Type: reasoning.NodeTypeEvidence, Label: "seed"})
+
+	reach := eng.PhaseReachability()
+	if !reach[state.PhaseRecon] {
+		t.Fatalf("expected recon phase to be reachable")
+	}
+	if reach[state.PhaseObjective] {
+		t.Fatalf("expected objective phase to be unreachable for recon-only corpus")
+	}
+}
+
+func TestPhaseReachabilityFollowsProducesClosure(t *testing.T) {
+	eng := reasoning.NewEngine(nil)
+	eng.BindActionClasses([]reasoning.ActionClass{
+		{ID: "AC-R", Name: "recon", Phase: state.PhaseRecon, Preconditions: []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{reasoning.NodeTypeEvidence}}}, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeHypothesis}},
+		{ID: "AC-O", Name: "impact", Phase: state.PhaseObjective, Preconditions: []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{reasoning.NodeTypeHypothesis}}}, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeDataExposure}},
+	})
+	eng.Graph().UpsertNode(&reasoning.Node{ID: "seed", Type: reasoning.NodeTypeEvidence, Label: "seed"})
+
+	if !eng.PhaseReachability()[state.PhaseObjective] {
+		t.Fatalf("expected objective phase reachable through recon-produced hypothesis")
+	}
+}
+
+func TestPlanCampaignWithReportAttributesRejections(t *testing.T) {
+	const credential reasoning.NodeType = "credential"
+	evidence := []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{reasoning.NodeTypeEvidence}}}
+	eng := reasoning.NewEngine(nil)
+	eng.BindActionClasses([]reasoning.ActionClass{
+		{ID: "AC-OK", Name: "ok", Phase: state.PhaseRecon, Preconditions: evidence, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeDataExposure}, RiskWeight: 0.1, ConfidenceBoost: 0.2},
+		{ID: "AC-PHASE", Name: "late", Phase: state.PhaseObjective, Preconditions: evidence, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeDataExposure}, RiskWeight: 0.1, ConfidenceBoost: 0.2},
+		{ID: "AC-PRE", Name: "needs-cred", Phase: state.PhaseRecon, Preconditions: []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{credential}}}, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeDataExposure}, RiskWeight: 0.1, ConfidenceBoost: 0.2},
+		{ID: "AC-RISK", Name: "loud", Phase: state.PhaseRecon, Preconditions: evidence, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeDataExposure}, RiskWeight: 5, ConfidenceBoost: 0.2},
+		{ID: "AC-CONF", Name: "shaky", Phase: state.PhaseRecon, Preconditions: evidence, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeDataExposure}, RiskWeight: 0.1, ConfidenceBoost: -0.3},
+	})
+	eng.Graph().UpsertNode(&reasoning.Node{ID: "seed", Type: reasoning.NodeTypeEvidence, Label: "seed"})
+
+	campaigns, report, err := eng.PlanCampaignWithReport(reasoning.NodeTypeDataExposure, reasoning.CampaignOptions{MaxDepth: 1, RiskTolerance: 1.0, ConfidenceThreshold: 0.4, BeamWidth: 10, TopN: 10})
+	if err != nil {
+		t.Fatalf("plan campaign with report: %v", err)
+	}
+	if !campaignsContainAction(campaigns, "AC-OK") {
+		t.Fatalf("expected AC-OK campaign")
+	}
+	want := map[string]reasoning.RejectionReason{
+		"AC-PHASE": reasoning.RejectionPhaseDisallowed,
+		"AC-PRE":   reasoning.RejectionPreconditionUnmet,
+		"AC-RISK":  reasoning.RejectionRiskOverTolerance,
+		"AC-CONF":  reasoning.RejectionConfidenceBelowThreshold,
+	}
+	for id, reason := range want {
+		reasons := report.Reasons(id)
+		if len(reasons) != 1 || reasons[0] != reason {
+			t.Fatalf("%s: expected rejection %s, got %v", id, reason, reasons)
+		}
+	}
+	if reasons := report.Reasons("AC-OK"); len(reasons) != 0 {
+		t.Fatalf("expected AC-OK to have no rejections, got %v", reasons)
+	}
+}
+
+func TestExpandAttackPathsWithReportAttributesROEDenial(t *testing.T) {
+	cfg := reasoning.DefaultAttackPathConfig()
+	cfg.ROEPolicy = func(ac reasoning.ActionClass, _ *reasoning.Graph, _ *state.State) bool { return ac.ID != "AC-DENIED" }
+	eng := reasoning.NewEngine(nil)
+	eng.ConfigureAttackPathExpansion(cfg)
+	eng.BindActionClasses([]reasoning.ActionClass{
+		{ID: "AC-OK", Name: "ok", Phase: state.PhaseRecon, Preconditions: []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{reasoning.NodeTypeEvidence}}}, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeTechnique}, RiskWeight: 0.1},
+		{ID: "AC-DENIED", Name: "denied", Phase: state.PhaseRecon, Preconditions: []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{reasoning.NodeTypeEvidence}}}, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeTechnique}, RiskWeight: 0.1},
+	})
+	eng.Graph().UpsertNode(&reasoning.Node{ID: "seed", Type: reasoning.NodeTypeEvidence, Label: "seed"})
+	st, _ := state.New("report-roe")
+
+	_, report, err := eng.ExpandAttackPathsWithReport(st)
+	if err != nil {
+		t.Fatalf("expand with report: %v", err)
+	}
+	reasons := report.Reasons("AC-DENIED")
+	if len(reasons) != 1 || reasons[0] != reasoning.RejectionROEDenied {
+		t.Fatalf("expected AC-DENIED to be attributed to roe denial, got %v", reasons)
+	}
+}
+
+func TestPanickingROEPolicyFailsClosed(t *testing.T) {
+	cfg := reasoning.DefaultAttackPathConfig()
+	var denied map[string]bool
+	cfg.ROEPolicy = func(ac reasoning.ActionClass, _ *reasoning.Graph, _ *state.State) bool {
+		if ac.ID == "AC-PANIC" {
+			denied["x"] = true
+		}
+		return true
+	}
+	eng := reasoning.NewEngine(nil)
+	eng.ConfigureAttackPathExpansion(cfg)
+	eng.BindActionClasses([]reasoning.ActionClass{
+		{ID: "AC-OK", Name: "ok", Phase: state.PhaseRecon, Preconditions: []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{reasoning.NodeTypeEvidence}}}, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeTechnique}, RiskWeight: 0.1},
+		{ID: "AC-PANIC", Name: "panic", Phase: state.PhaseRecon, Preconditions: []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{reasoning.NodeTypeEvidence}}}, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeTechnique}, RiskWeight: 0.1},
+	})
+	eng.Graph().UpsertNode(&reasoning.Node{ID: "seed", Type: reasoning.NodeTypeEvidence, Label: "seed"})
+	st, _ := state.New("report-roe-panic")
+
+	paths, report, err := eng.ExpandAttackPathsWithReport(st)
+	if err != nil {
+		t.Fatalf("expand with report: %v", err)
+	}
+	if len(paths) == 0 {
+		t.Fatalf("expected expansion to complete with the non-panicking class")
+	}
+	for _, p := range paths {
+		for _, step := range p.Steps {
+			if step.ActionClassID == "AC-PANIC" {
+				t.Fatalf("expected panicking policy to deny AC-PANIC, got path %v", p.Steps)
+			}
+		}
+	}
+	reasons := report.Reasons("AC-PANIC")
+	if len(reasons) != 1 || reasons[0] != reasoning.RejectionROEPolicyPanic {
+		t.Fatalf("expected AC-PANIC to be attributed to a policy panic, got %v", reasons)
+	}
+	if eng.ROEPolicyPanics() == 0 {
+		t.Fatalf("expected the policy panic to be recorded")
+	}
+}
@@ -3,10 +3,13 @@ package tests
 import (
 	"context"
 	"errors"
+	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"vantage/core/evidence"
+	"vantage/core/exposure"
 	"vantage/core/reasoning"
 	"vantage/core/state"
 )
@@ -171,3 +174,591 @@ func TestRunCycleReturnsDecisionAndExecutorError(t *testing.T) {
 		t.Fatalf("expected executor call count 1, got %d", s.calls)
 	}
 }
+
+func TestPlanNextActionHonorsPinnedTechnique(t *testing.T) {
+	re := reasoning.NewEngine(nil)
+	re.RegisterTechniqueEffect(reasoning.TechniqueEffect{TechniqueID: "T-A", Impact: 0.9, Risk: 0.2, Stealth: 0.7})
+	re.RegisterTechniqueEffect(reasoning.TechniqueEffect{TechniqueID: "T-B", Impact: 0.5, Risk: 0.1, Stealth: 0.8})
+	_ = re.IngestEvidence(reasoning.EvidenceEvent{TechniqueID: "T-A", Target: "host-1", Success: true})
+
+	decision, err := re.PlanNextAction(reasoning.PlannerQuery{Target: "host-1", AllowedTechniques: []string{"T-A", "T-B"}, PinnedTechniqueID: "T-B"})
+	if err != nil {
+		t.Fatalf("plan next action: %v", err)
+	}
+	if decision.Selected.TechniqueID != "T-B" {
+		t.Fatalf("expected pinned T-B to be selected, got %s", decision.Selected.TechniqueID)
+	}
+	if len(decision.Ranked) != 2 || decision.Ranked[0].TechniqueID != "T-A" {
+		t.Fatalf("expected full score-ordered ranking to be preserved, got %+v", decision.Ranked)
+	}
+}
+
+// lowestRiskOfTopTwo prefers the safer of the two best-scoring actions.
+type lowestRiskOfTopTwo struct{}
+
+func (lowestRiskOfTopTwo) Select(ranked []reasoning.RankedAction) reasoning.RankedAction {
+	if len(ranked) > 1 && ranked[1].Risk < ranked[0].Risk {
+		return ranked[1]
+	}
+	return ranked[0]
+}
+
+func TestPlanNextActionUsesSelectionPolicy(t *testing.T) {
+	re := reasoning.NewEngine(nil)
+	re.RegisterTechniqueEffect(reasoning.TechniqueEffect{TechniqueID: "T-A", Impact: 0.9, Risk: 0.2, Stealth: 0.7})
+	re.RegisterTechniqueEffect(reasoning.TechniqueEffect{TechniqueID: "T-B", Impact: 0.5, Risk: 0.1, Stealth: 0.8})
+	_ = re.IngestEvidence(reasoning.EvidenceEvent{TechniqueID: "T-A", Target: "host-1", Success: true})
+	re.SetSelectionPolicy(lowestRiskOfTopTwo{})
+
+	decision, err := re.PlanNextAction(reasoning.PlannerQuery{Target: "host-1", AllowedTechniques: []string{"T-A", "T-B"}})
+	if err != nil {
+		t.Fatalf("plan next action: %v", err)
+	}
+	if decision.Ranked[0].TechniqueID != "T-A" {
+		t.Fatalf("expected T-A to remain the top-ranked action, got %+v", decision.Ranked)
+	}
+	if decision.Selected.TechniqueID != "T-B" {
+		t.Fatalf("expected policy to select lower-risk T-B, got %s", decision.Selected.TechniqueID)
+	}
+}
+
+// offRankingPolicy returns an action the ranking never offered.
+type offRankingPolicy struct{}
+
+func (offRankingPolicy) Select([]reasoning.RankedAction) reasoning.RankedAction {
+	return reasoning.RankedAction{TechniqueID: "T-UNRANKED"}
+}
+
+func TestPlanNextActionRejectsSelectionOutsideRanking(t *testing.T) {
+	re := reasoning.NewEngine(nil)
+	re.RegisterTechniqueEffect(reasoning.TechniqueEffect{TechniqueID: "T-A", Impact: 0.9, Risk: 0.2, Stealth: 0.7})
+	re.SetSelectionPolicy(offRankingPolicy{})
+
+	_, err := re.PlanNextAction(reasoning.PlannerQuery{Target: "host-1", AllowedTechniques: []string{"T-A"}})
+	if err == nil || !strings.Contains(err.Error(), "T-UNRANKED") {
+		t.Fatalf("expected selection outside the ranking to be rejected, got %v", err)
+	}
+}
+
+func TestPlanNextActionRejectsDisallowedPin(t *testing.T) {
+	re := reasoning.NewEngine(nil)
+	re.RegisterTechniqueEffect(reasoning.TechniqueEffect{TechniqueID: "T-A", Impact: 0.9, Risk: 0.2, Stealth: 0.7})
+	re.RegisterTechniqueEffect(reasoning.TechniqueEffect{TechniqueID: "T-B", Impact: 0.5, Risk: 0.1, Stealth: 0.8})
+
+	_, err := re.PlanNextAction(reasoning.PlannerQuery{Target: "host-1", AllowedTechniques: []string{"T-A"}, PinnedTechniqueID: "T-B"})
+	var pinErr *reasoning.PinRejectedError
+	if !errors.As(err, &pinErr) {
+		t.Fatalf("expected PinRejectedError, got %v", err)
+	}
+	if pinErr.TechniqueID != "T-B" {
+		t.Fatalf("unexpected rejected technique: %s", pinErr.TechniqueID)
+	}
+}
+
+func TestPlanNextActionPinHonorsROEAndImpactGate(t *testing.T) {
+	re := reasoning.NewEngine(nil)
+	re.BindActionClasses([]reasoning.ActionClass{
+		{ID: "AC-R", Name: "recon", Phase: state.PhaseRecon},
+		{ID: "AC-L", Name: "lateral", Phase: state.PhaseLateralMovement},
+		{ID: "AC-O", Name: "impact", Phase: state.PhaseObjective},
+	})
+	re.RegisterTechniqueEffect(reasoning.TechniqueEffect{TechniqueID: "T-R", ActionClassID: "AC-R", Impact: 0.9, Risk: 0.1, Stealth: 0.9})
+	re.RegisterTechniqueEffect(reasoning.TechniqueEffect{TechniqueID: "T-L", ActionClassID: "AC-L", Impact: 0.5, Risk: 0.3, Stealth: 0.6})
+	re.RegisterTechniqueEffect(reasoning.TechniqueEffect{TechniqueID: "T-O", ActionClassID: "AC-O", Impact: 0.5, Risk: 0.3, Stealth: 0.6})
+	allowed := []string{"T-R", "T-L", "T-O"}
+
+	cfg := reasoning.DefaultAttackPathConfig()
+	cfg.ROEPolicy, _ = reasoning.ResolveROEPreset(reasoning.ROEPresetReconOnly)
+	cfg.ROEPreset = reasoning.ROEPresetReconOnly
+	re.ConfigureAttackPathExpansion(cfg)
+	var pinErr *reasoning.PinRejectedError
+	if _, err := re.PlanNextAction(reasoning.PlannerQuery{Target: "host-1", AllowedTechniques: allowed, TopN: 1, PinnedTechniqueID: "T-L"}); !errors.As(err, &pinErr) {
+		t.Fatalf("expected a pin denied by ROE to be rejected, got %v", err)
+	}
+	re.ConfigureAttackPathExpansion(reasoning.DefaultAttackPathConfig())
+	if decision, err := re.PlanNextAction(reasoning.PlannerQuery{Target: "host-1", AllowedTechniques: allowed, TopN: 1, PinnedTechniqueID: "T-L"}); err != nil || decision.Selected.TechniqueID != "T-L" {
+		t.Fatalf("expected the truncated pin to be selected once ROE admits it, got %+v (%v)", decision, err)
+	}
+
+	re.SetMinEvidenceForImpact(5)
+	if _, err := re.PlanNextAction(reasoning.PlannerQuery{Target: "host-1", AllowedTechniques: allowed, TopN: 1, PinnedTechniqueID: "T-O"}); !errors.As(err, &pinErr) {
+		t.Fatalf("expected an impact-phase pin to be rejected while gated, got %v", err)
+	}
+}
+
+func TestAddExpanderMergesWeightedConfidences(t *testing.T) {
+	re := reasoning.NewEngine(nil)
+	re.AddExpander(fixedExpander{hypotheses: []reasoning.Hypothesis{{ID: "hyp-rule", Statement: "rule", Confidence: 0.6}}}, 0.5)
+	re.AddExpander(fixedExpander{hypotheses: []reasoning.Hypothesis{{ID: "hyp-llm", Statement: "llm", Confidence: 0.3}}}, 2.0)
+	re.AddExpander(failingExpander{}, 1.0)
+	re.RegisterTechniqueEffect(reasoning.TechniqueEffect{TechniqueID: "T-1", Impact: 0.8, Risk: 0.2, Stealth: 0.7})
+
+	if _, err := re.PlanNextAction(reasoning.PlannerQuery{Target: "host-1", AllowedTechniques: []string{"T-1"}}); err != nil {
+		t.Fatalf("plan next action: %v", err)
+	}
+	want := map[string]string{"hyp-rule": "0.30", "hyp-llm": "0.60"}
+	for id, conf := range want {
+		n, ok := re.Graph().Node(id)
+		if !ok {
+			t.Fatalf("expected merged hypothesis %s", id)
+		}
+		if n.Metadata["confidence"] != conf {
+			t.Fatalf("expected %s confidence %s, got %s", id, conf, n.Metadata["confidence"])
+		}
+	}
+}
+
+func TestDetectStagnationAfterRepeatedUnproductiveCycles(t *testing.T) {
+	re := reasoning.NewEngine(nil)
+	re.RegisterTechniqueEffect(reasoning.TechniqueEffect{TechniqueID: "T-1", Impact: 0.9, Risk: 0.1, Stealth: 0.8})
+	s := &executorStub{artifact: &evidence.Artifact{TechniqueID: "T-1", Target: "host-1", Success: true}}
+	re.ConfigureCycle(reasoning.CycleConfig{Target: "host-1", AllowedTechniques: []string{"T-1"}, Executor: s})
+	st, err := state.New("stagnation")
+	if err != nil {
+		t.Fatalf("state new: %v", err)
+	}
+
+	const window = 3
+	for i := 1; i <= window+1; i++ {
+		if _, err := re.RunCycle(st); err != nil {
+			t.Fatalf("run cycle %d: %v", i, err)
+		}
+		// The first cycle introduces the evidence node type, so stagnation is only
+		// reported once a full window of cycles has passed after it.
+		want := i > window
+		if got := re.DetectStagnation(window); got != want {
+			t.Fatalf("cycle %d: expected stagnation=%t, got %t", i, want, got)
+		}
+	}
+}
+
+// producingExecutor upserts a node of the scheduled type into graph on the matching call.
+type producingExecutor struct {
+	graph    *reasoning.Graph
+	schedule map[int]reasoning.NodeType
+	calls    int
+}
+
+func (p *producingExecutor) Run(_ context.Context, techniqueID string, target string) (*evidence.Artifact, error) {
+	p.calls++
+	if nodeType, ok := p.schedule[p.calls]; ok {
+		p.graph.UpsertNode(&reasoning.Node{ID: fmt.Sprintf("produced-%d", p.calls), Type: nodeType, Label: string(nodeType)})
+	}
+	return &evidence.Artifact{TechniqueID: techniqueID, Target: target, Success: true}, nil
+}
+
+func TestCyclesSinceObjectiveProgressResetsOnAdvancingNode(t *testing.T) {
+	re := reasoning.NewEngine(nil)
+	re.RegisterTechniqueEffect(reasoning.TechniqueEffect{TechniqueID: "T-1", Impact: 0.9, Risk: 0.1, Stealth: 0.8})
+	re.BindActionClasses([]reasoning.ActionClass{{
+		ID:            "AC-EXPOSE",
+		Phase:         state.PhasePrivEsc,
+		Preconditions: []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{reasoning.NodeTypePrivEsc}}},
+		ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeDataExposure},
+	}})
+	cfg := reasoning.DefaultAttackPathConfig()
+	cfg.ObjectiveNodeTypes = []reasoning.NodeType{reasoning.NodeTypeDataExposure}
+	re.ConfigureAttackPathExpansion(cfg)
+	exec := &producingExecutor{graph: re.Graph(), schedule: map[int]reasoning.NodeType{
+		3: reasoning.NodeTypePrivEsc,
+		5: reasoning.NodeTypeDataExposure,
+		7: reasoning.NodeTypeDataExposure,
+	}}
+	re.ConfigureCycle(reasoning.CycleConfig{Target: "host-1", AllowedTechniques: []string{"T-1"}, Executor: exec})
+	st, err := state.New("progress")
+	if err != nil {
+		t.Fatalf("state new: %v", err)
+	}
+
+	// Evidence appears in cycle 1 but leads to no objective; PRIV_ESC feeds the objective class and
+	// DATA_EXPOSURE is the objective itself. A second DATA_EXPOSURE node in cycle 7 is progress too.
+	for i, want := range []int{1, 2, 0, 1, 0, 1, 0, 1} {
+		if _, err := re.RunCycle(st); err != nil {
+			t.Fatalf("run cycle %d: %v", i+1, err)
+		}
+		if got := re.CyclesSinceObjectiveProgress(); got != want {
+			t.Fatalf("cycle %d: expected %d cycles since objective progress, got %d", i+1, want, got)
+		}
+	}
+}
+
+func TestIngestEvidenceUsesCustomLabeler(t *testing.T) {
+	re := reasoning.NewEngine(nil)
+	re.SetEvidenceLabeler(func(ev reasoning.EvidenceEvent) string {
+		return fmt.Sprintf("%s on %s success=%t", ev.TechniqueID, ev.Target, ev.Success)
+	})
+	if err := re.IngestEvidence(reasoning.EvidenceEvent{TechniqueID: "T-1", Target: "host-1", Success: true}); err != nil {
+		t.Fatalf("ingest evidence: %v", err)
+	}
+	nodes := re.Graph().NodesByType(reasoning.NodeTypeEvidence)
+	if len(nodes) != 1 || nodes[0].Label != "T-1 on host-1 success=true" {
+		t.Fatalf("expected custom evidence label, got %+v", nodes)
+	}
+
+	re.SetEvidenceLabeler(nil)
+	if got := reasoning.DefaultEvidenceLabel(reasoning.EvidenceEvent{TechniqueID: "T-2", Target: "host-2"}); got != "T-2@host-2" {
+		t.Fatalf("unexpected default label %q", got)
+	}
+}
+
+func TestRunCycleTracedRecordsConsistentTrace(t *testing.T) {
+	re := reasoning.NewEngine(nil)
+	re.RegisterTechniqueEffect(reasoning.TechniqueEffect{TechniqueID: "T-1", Impact: 0.9, Risk: 0.1, Stealth: 0.8})
+	s := &executorStub{artifact: &evidence.Artifact{TechniqueID: "T-1", Target: "host-1", Success: true}}
+	re.ConfigureCycle(reasoning.CycleConfig{Target: "host-1", AllowedTechniques: []string{"T-1"}, Executor: s})
+	st, err := state.New("traced")
+	if err != nil {
+		t.Fatalf("state new: %v", err)
+	}
+
+	decision, trace, err := re.RunCycleTraced(st)
+	if err != nil {
+		t.Fatalf("run cycle traced: %v", err)
+	}
+	if trace == nil || trace.Decision != decision {
+		t.Fatalf("expected trace to carry the returned decision")
+	}
+	if len(trace.Decision.Ranked) == 0 || trace.Decision.Ranked[0].TechniqueID != decision.Selected.TechniqueID {
+		t.Fatalf("expected ranked list in trace, got %+v", trace.Decision.Ranked)
+	}
+	if trace.Artifact == nil || trace.Artifact.TechniqueID != decision.Selected.TechniqueID {
+		t.Fatalf("artifact technique does not match decision: %+v", trace.Artifact)
+	}
+	if trace.ExposureAfter < trace.ExposureBefore {
+		t.Fatalf("exposure decreased: before=%.2f after=%.2f", trace.ExposureBefore, trace.ExposureAfter)
+	}
+	if trace.CampaignStatus != st.Status() || trace.ExecutionError != "" {
+		t.Fatalf("unexpected campaign status %s or error %q", trace.CampaignStatus, trace.ExecutionError)
+	}
+	evidenceAdded := false
+	for _, n := range trace.Delta.AddedNodes {
+		if _, ok := re.Graph().Node(n.ID); !ok {
+			t.Fatalf("delta node %s missing from graph", n.ID)
+		}
+		evidenceAdded = evidenceAdded || n.Type == reasoning.NodeTypeEvidence
+	}
+	if !evidenceAdded {
+		t.Fatalf("expected graph delta to include the ingested evidence node, got %+v", trace.Delta.AddedNodes)
+	}
+}
+
+func TestPlanNextActionDOTIsByteStable(t *testing.T) {
+	dot := func() string {
+		re := reasoning.NewEngine(nil)
+		re.RegisterTechniqueEffect(reasoning.TechniqueEffect{TechniqueID: "T-1", Impact: 0.9, Risk: 0.1, Stealth: 0.8})
+		classes := make([]reasoning.ActionClass, 0, 8)
+		for i := 1; i <= 8; i++ {
+			classes = append(classes, reasoning.ActionClass{ID: fmt.Sprintf("AC-EQ-%d", i), Name: "equal", Phase: state.PhaseRecon, Preconditions: []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{reasoning.NodeTypeEvidence}}}})
+		}
+		re.BindActionClasses(classes)
+		re.Graph().UpsertNode(&reasoning.Node{ID: "ev-seed", Type: reasoning.NodeTypeEvidence, Label: "seed", Metadata: map[string]string{"success": "true"}})
+		re.ConfigureCycle(reasoning.CycleConfig{Target: "host-1", AllowedTechniques: []string{"T-1"}, Executor: &executorStub{}})
+		st, err := state.New("dot-stable")
+		if err != nil {
+			t.Fatalf("state new: %v", err)
+		}
+		if _, err := re.RunCycle(st); err != nil {
+			t.Fatalf("run cycle: %v", err)
+		}
+		return re.DOT()
+	}
+
+	first := dot()
+	for i := 0; i < 5; i++ {
+		if got := dot(); got != first {
+			t.Fatalf("expected identical DOT across repeated planning:\n%s\nvs\n%s", first, got)
+		}
+	}
+}
+
+// fixedGauge reports a constant exposure score and remaining budget.
+type fixedGauge struct{ score, remaining uint64 }
+
+func (g fixedGauge) Score() uint64     { return g.score }
+func (g fixedGauge) Remaining() uint64 { return g.remaining }
+
+func TestPlanNextActionPenalizesRiskNearExposureHalt(t *testing.T) {
+	re := reasoning.NewEngine(nil)
+	re.RegisterTechniqueEffect(reasoning.TechniqueEffect{TechniqueID: "T-HIGH", Impact: 1.0, Risk: 0.6, Stealth: 0.9})
+	re.RegisterTechniqueEffect(reasoning.TechniqueEffect{TechniqueID: "T-LOW", Impact: 0.4, Risk: 0.1, Stealth: 0.5})
+	query := reasoning.PlannerQuery{Target: "host-1", AllowedTechniques: []string{"T-HIGH", "T-LOW"}}
+
+	decision, err := re.PlanNextAction(query)
+	if err != nil {
+		t.Fatalf("plan next action: %v", err)
+	}
+	if decision.Selected.TechniqueID != "T-HIGH" {
+		t.Fatalf("expected T-HIGH without exposure pressure, got %s", decision.Selected.TechniqueID)
+	}
+
+	re.SetExposureGauge(fixedGauge{score: 95, remaining: 5})
+	decision, err = re.PlanNextAction(query)
+	if err != nil {
+		t.Fatalf("plan next action: %v", err)
+	}
+	if decision.Selected.TechniqueID != "T-LOW" {
+		t.Fatalf("expected T-LOW near the exposure halt, got %s (ranked %+v)", decision.Selected.TechniqueID, decision.Ranked)
+	}
+}
+
+func TestRunCycleConsolidatesEvidenceAboveNodeThreshold(t *testing.T) {
+	run := func(threshold int) int {
+		re := reasoning.NewEngine(nil)
+		re.RegisterTechniqueEffect(reasoning.TechniqueEffect{TechniqueID: "T-1", Impact: 0.9, Risk: 0.1, Stealth: 0.8})
+		re.ConfigureCycle(reasoning.CycleConfig{Target: "host-1", AllowedTechniques: []string{"T-1"}, Executor: &executorStub{artifact: &evidence.Artifact{TechniqueID: "T-1", Target: "host-1", Success: true}}})
+		re.SetGraphConsolidationThreshold(threshold)
+		st, _ := state.New("campaign-consolidate")
+		for i := 0; i < 20; i++ {
+			if _, err := re.RunCycle(st); err != nil {
+				t.Fatalf("run cycle %d: %v", i, err)
+			}
+		}
+		return len(re.Graph().NodesByType(reasoning.NodeTypeEvidence))
+	}
+	unbounded, bounded := run(0), run(10)
+	if bounded >= unbounded {
+		t.Fatalf("expected consolidation to shrink evidence, got %d with threshold vs %d without", bounded, unbounded)
+	}
+}
+
+func BenchmarkRunCycleGraphConsolidation(b *testing.B) {
+	for _, bench := range []struct {
+		name      string
+		threshold int
+	}{{"unbounded", 0}, {"threshold-64", 64}} {
+		b.Run(bench.name, func(b *testing.B) {
+			re := reasoning.NewEngine(nil)
+			re.RegisterTechniqueEffect(reasoning.TechniqueEffect{TechniqueID: "T-1", Impact: 0.9, Risk: 0.1, Stealth: 0.8})
+			re.ConfigureCycle(reasoning.CycleConfig{Target: "host-1", AllowedTechniques: []string{"T-1"}, Executor: &executorStub{artifact: &evidence.Artifact{TechniqueID: "T-1", Target: "host-1", Success: true}}})
+			re.SetGraphConsolidationThreshold(bench.threshold)
+			st, _ := state.New("campaign-bench")
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if _, err := re.RunCycle(st); err != nil {
+					b.Fatalf("run cycle: %v", err)
+				}
+			}
+		})
+	}
+}
+
+// chargingExecutor charges a fixed exposure cost per run, like the production executor.
+type chargingExecutor struct {
+	tracker *exposure.Tracker
+	calls   int
+}
+
+func (c *chargingExecutor) Run(_ context.Context, techniqueID string, target string) (*evidence.Artifact, error) {
+	c.calls++
+	if err := c.tracker.Add(10); err != nil {
+		return nil, err
+	}
+	return &evidence.Artifact{TechniqueID: techniqueID, Target: target, Success: true}, nil
+}
+
+// signingExecutor returns signed artifacts, as the production executor does.
+type signingExecutor struct {
+	last *evidence.Artifact
+}
+
+func (s *signingExecutor) Run(_ context.Context, techniqueID string, target string) (*evidence.Artifact, error) {
+	artifact := &evidence.Artifact{ArtifactID: "art-" + techniqueID, CampaignID: "camp-1", TechniqueID: techniqueID, Target: target, ExecutedAt: time.Now().UTC(), Success: true}
+	if err := artifact.Sign(); err != nil {
+		return nil, err
+	}
+	s.last = artifact
+	return artifact, nil
+}
+
+func TestRunCycleSealsTieBreakReasonIntoArtifact(t *testing.T) {
+	re := reasoning.NewEngine(nil)
+	re.RegisterTechniqueEffect(reasoning.TechniqueEffect{TechniqueID: "T-B", Impact: 0.6, Risk: 0.2, Stealth: 0.7})
+	re.RegisterTechniqueEffect(reasoning.TechniqueEffect{TechniqueID: "T-A", Impact: 0.6, Risk: 0.2, Stealth: 0.7})
+	exec := &signingExecutor{}
+	re.ConfigureCycle(reasoning.CycleConfig{Target: "host-1", AllowedTechniques: []string{"T-B", "T-A"}, Executor: exec})
+
+	st, _ := state.New("tie-break-audit")
+	decision, err := re.RunCycle(st)
+	if err != nil {
+		t.Fatalf("run cycle: %v", err)
+	}
+	if decision.Selected.TechniqueID != "T-A" || exec.last == nil {
+		t.Fatalf("expected T-A to run on its technique ID tie-break, got %+v", decision.Selected)
+	}
+	if got := exec.last.Addendum["tie_break_reason"]; got != reasoning.TieBreakTechniqueID {
+		t.Fatalf("expected the artifact to carry tie_break_reason %q, got %q", reasoning.TieBreakTechniqueID, got)
+	}
+	if ok, err := exec.last.VerifyAddendum(); err != nil || !ok {
+		t.Fatalf("expected the tie-break addendum to be sealed, got %t (%v)", ok, err)
+	}
+	if ok, err := exec.last.Verify(); err != nil || !ok {
+		t.Fatalf("expected the original signature to stay valid, got %t (%v)", ok, err)
+	}
+}
+
+func TestRunCycleSkipsNoProgressSelectionWithoutExposure(t *testing.T) {
+	re := reasoning.NewEngine(nil)
+	re.BindActionClasses([]reasoning.ActionClass{{ID: "AC-SAT", Name: "saturated", Phase: state.PhaseRecon, Preconditions: []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{reasoning.NodeTypeEvidence}}}, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeHypothesis}, ProducesEdges: []reasoning.EdgeType{reasoning.EdgeTypeSupports}}})
+	re.RegisterTechniqueEffect(reasoning.TechniqueEffect{TechniqueID: "T-1", ActionClassID: "AC-SAT", Impact: 0.9, Risk: 0.1, Stealth: 0.8})
+	tracker, err := exposure.New(100)
+	if err != nil {
+		t.Fatalf("exposure tracker: %v", err)
+	}
+	exec := &chargingExecutor{tracker: tracker}
+	re.ConfigureCycle(reasoning.CycleConfig{Target: "host-1", AllowedTechniques: []string{"T-1"}, Executor: exec})
+	g := re.Graph()
+	g.UpsertNode(&reasoning.Node{ID: "ev-seed", Type: reasoning.NodeTypeEvidence, Label: "seed"})
+	g.UpsertNode(&reasoning.Node{ID: "hyp-seed", Type: reasoning.NodeTypeHypothesis, Label: "seed"})
+	if err := g.AddEdge(&reasoning.Edge{From: "ev-seed", To: "hyp-seed", Type: reasoning.EdgeTypeSupports, Weight: 1}); err != nil {
+		t.Fatalf("add edge: %v", err)
+	}
+	st, _ := state.New("campaign-no-progress")
+
+	if _, err := re.RunCycle(st); err != nil {
+		t.Fatalf("expected the first run of a class to count as progress despite its types being present, got %v", err)
+	}
+	if exec.calls != 1 || tracker.Score() != 10 {
+		t.Fatalf("expected one charged execution, got %d calls and score %d", exec.calls, tracker.Score())
+	}
+
+	decision, err := re.RunCycle(st)
+	if !errors.Is(err, reasoning.ErrNoProgress) {
+		t.Fatalf("expected ErrNoProgress, got %v", err)
+	}
+	if decision == nil || decision.Selected.ActionClassID != "AC-SAT" {
+		t.Fatalf("expected the skipped decision to be returned, got %+v", decision)
+	}
+	if exec.calls != 1 || tracker.Score() != 10 {
+		t.Fatalf("expected no further execution or exposure, got %d calls and score %d", exec.calls, tracker.Score())
+	}
+
+	re.SetAllowRedundantCycles(true)
+	if _, err := re.RunCycle(st); err != nil {
+		t.Fatalf("expected redundant run once opted in, got %v", err)
+	}
+	if exec.calls != 2 || tracker.Score() != 20 {
+		t.Fatalf("expected a second charged execution, got %d calls and score %d", exec.calls, tracker.Score())
+	}
+}
+
+func TestRunCycleWithShippedCorpusMakesProgress(t *testing.T) {
+	classes, err := reasoning.LoadActionClassesFromDir(resolveActionClassesDir(t))
+	if err != nil {
+		t.Fatalf("load action classes: %v", err)
+	}
+	re := reasoning.NewEngine(nil)
+	re.BindActionClasses(classes)
+	tracker, err := exposure.New(100)
+	if err != nil {
+		t.Fatalf("exposure tracker: %v", err)
+	}
+	exec := &chargingExecutor{tracker: tracker}
+	re.ConfigureCycle(reasoning.CycleConfig{Target: "host-1", Executor: exec})
+	re.Graph().UpsertNode(&reasoning.Node{ID: "ev-seed", Type: reasoning.NodeTypeEvidence, Label: "seed"})
+	st, _ := state.New("campaign-corpus")
+
+	first, err := re.RunCycle(st)
+	if err != nil {
+		t.Fatalf("expected the first corpus cycle to make progress, got %v", err)
+	}
+	if exec.calls != 1 {
+		t.Fatalf("expected one execution, got %d", exec.calls)
+	}
+
+	repeat, err := re.RunCycle(st)
+	if err != nil {
+		if !errors.Is(err, reasoning.ErrNoProgress) || repeat.Selected.ActionClassID != first.Selected.ActionClassID {
+			t.Fatalf("expected only a repeat of %s against host-1 to be refused, got %v", first.Selected.ActionClassID, err)
+		}
+	}
+	re.ConfigureCycle(reasoning.CycleConfig{Target: "host-2", Executor: exec})
+	if _, err := re.RunCycle(st); err != nil {
+		t.Fatalf("expected the same class against a new target to make progress, got %v", err)
+	}
+}
+
+func TestPreferPathEnablingSelectsFirstCampaignStep(t *testing.T) {
+	re := reasoning.NewEngine(nil)
+	re.BindActionClasses([]reasoning.ActionClass{
+		{ID: "AC-R", Name: "recon", Phase: state.PhaseRecon, Preconditions: []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{reasoning.NodeTypeEvidence}}}, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeHypothesis}, RiskWeight: 0.1, ConfidenceBoost: 0.2},
+		{ID: "AC-D", Name: "data", Phase: state.PhaseRecon, Preconditions: []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{reasoning.NodeTypeHypothesis}}}, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeDataExposure}, RiskWeight: 0.1, ConfidenceBoost: 0.2},
+		{ID: "AC-X", Name: "detour", Phase: state.PhaseRecon, Preconditions: []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{reasoning.NodeTypeEvidence}}}, ProducesNodes: []reasoning.NodeType{reasoning.NodeTypeTechnique}, RiskWeight: 0.1, ConfidenceBoost: 0.2},
+	})
+	re.RegisterTechniqueEffect(reasoning.TechniqueEffect{TechniqueID: "T-R", ActionClassID: "AC-R", Impact: 0.3, Risk: 0.2, Stealth: 0.6})
+	re.RegisterTechniqueEffect(reasoning.TechniqueEffect{TechniqueID: "T-X", ActionClassID: "AC-X", Impact: 0.9, Risk: 0.1, Stealth: 0.9})
+	re.Graph().UpsertNode(&reasoning.Node{ID: "seed", Type: reasoning.NodeTypeEvidence, Label: "seed"})
+	query := reasoning.PlannerQuery{Target: "host-1", AllowedTechniques: []string{"T-R", "T-X"}, TopN: 5}
+
+	decision, err := re.PlanNextAction(query)
+	if err != nil {
+		t.Fatalf("plan next action: %v", err)
+	}
+	if decision.Selected.TechniqueID != "T-X" {
+		t.Fatalf("expected higher-impact T-X without a path preference, got %s (ranked %+v)", decision.Selected.TechniqueID, decision.Ranked)
+	}
+
+	campaigns, err := re.PlanCampaign(reasoning.NodeTypeDataExposure, reasoning.DefaultCampaignOptions())
+	if err != nil || len(campaigns) == 0 {
+		t.Fatalf("expected a DATA_EXPOSURE campaign, got %d (%v)", len(campaigns), err)
+	}
+	query.PreferPathEnabling = reasoning.NodeTypeDataExposure
+	decision, err = re.PlanNextAction(query)
+	if err != nil {
+		t.Fatalf("plan next action with path preference: %v", err)
+	}
+	if decision.Selected.ActionClassID != campaigns[0].Steps[0].ActionClassID || decision.Selected.TechniqueID != "T-R" {
+		t.Fatalf("expected technique of first campaign step %s, got %+v", campaigns[0].Steps[0].ActionClassID, decision.Selected)
+	}
+
+	// The preference's internal plan must not replace the caller's plan as ReplanIncremental's baseline.
+	opts := reasoning.DefaultCampaignOptions()
+	opts.MaxDepth = 3
+	if _, err := re.PlanCampaign(reasoning.NodeTypeDataExposure, opts); err != nil {
+		t.Fatalf("plan campaign: %v", err)
+	}
+	if _, err := re.PlanNextAction(query); err != nil {
+		t.Fatalf("plan next action with path preference: %v", err)
+	}
+	sentinel := []reasoning.Campaign{{Objective: "SENTINEL"}}
+	reused, err := re.ReplanIncremental(sentinel, reasoning.NodeTypeDataExposure, opts)
+	if err != nil || len(reused) != 1 || reused[0].Objective != "SENTINEL" {
+		t.Fatalf("expected the caller's plan to stay current, got %+v (%v)", reused, err)
+	}
+}
+
+func TestEpsilonGreedyPolicyMixesExploreAndExploit(t *testing.T) {
+	ranked := []reasoning.RankedAction{{TechniqueID: "T-1", Score: 0.9}, {TechniqueID: "T-2", Score: 0.7}, {TechniqueID: "T-3", Score: 0.5}, {TechniqueID: "T-4", Score: 0.3}}
+	run := func() []string {
+		policy, err := reasoning.NewEpsilonGreedyPolicy(0.25, 3, 42)
+		if err != nil {
+			t.Fatalf("new policy: %v", err)
+		}
+		picks := make([]string, 0, 400)
+		for i := 0; i < 400; i++ {
+			picks = append(picks, policy.Select(ranked).TechniqueID)
+		}
+		return picks
+	}
+	picks := run()
+	counts := map[string]int{}
+	for _, id := range picks {
+		counts[id]++
+	}
+	explored := counts["T-2"] + counts["T-3"]
+	if counts["T-4"] != 0 {
+		t.Fatalf("expected exploration limited to the top 3, got %v", counts)
+	}
+	if explored < 70 || explored > 130 || counts["T-2"] == 0 || counts["T-3"] == 0 {
+		t.Fatalf("expected about a quarter of 400 cycles to explore T-2 and T-3, got %v", counts)
+	}
+	again := run()
+	for i := range picks {
+		if picks[i] != again[i] {
+			t.Fatalf("expected identical selections for the same seed, cycle %d differs", i)
+		}
+	}
+	if _, err := reasoning.NewEpsilonGreedyPolicy(1.5, 3, 1); err == nil {
+		t.Fatalf("expected epsilon above 1 to be rejected")
+	}
+}
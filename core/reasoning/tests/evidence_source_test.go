@@ -0,0 +1,44 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"vantage/core/reasoning"
+)
+
+type sliceSource struct {
+	events []reasoning.EvidenceEvent
+	next   int
+}
+
+func (s *sliceSource) Next(_ context.Context) (reasoning.EvidenceEvent, bool, error) {
+	if s.next >= len(s.events) {
+		return reasoning.EvidenceEvent{}, false, nil
+	}
+	ev := s.events[s.next]
+	s.next++
+	return ev, true, nil
+}
+
+func TestIngestFromDrainsSource(t *testing.T) {
+	eng := reasoning.NewEngine(nil)
+	src := &sliceSource{events: []reasoning.EvidenceEvent{
+		{TechniqueID: "T-1", Target: "host-1", Success: true},
+		{TechniqueID: "T-2", Target: "host-1", Success: false},
+		{TechniqueID: "T-3", Target: "host-2", Success: true},
+	}}
+
+	before := len(eng.Graph().NodesByType(reasoning.NodeTypeEvidence))
+	n, err := eng.IngestFrom(context.Background(), src)
+	if err != nil {
+		t.Fatalf("ingest from: %v", err)
+	}
+	if n != 3 {
+		t.Fatalf("expected 3 ingested events, got %d", n)
+	}
+	after := len(eng.Graph().NodesByType(reasoning.NodeTypeEvidence))
+	if after-before != 3 {
+		t.Fatalf("expected graph to grow by 3 evidence nodes, grew by %d", after-before)
+	}
+}
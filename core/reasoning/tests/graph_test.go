@@ -0,0 +1,358 @@
+package tests
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"vantage/core/reasoning"
+	"vantage/core/state"
+)
+
+func TestGraphCanonicalJSONIsStable(t *testing.T) {
+	g := reasoning.NewGraph()
+	g.UpsertNode(&reasoning.Node{ID: "b", Type: reasoning.NodeTypeHypothesis, Label: "hyp", Metadata: map[string]string{"confidence": "0.60"}})
+	g.UpsertNode(&reasoning.Node{ID: "a", Type: reasoning.NodeTypeEvidence, Label: "ev"})
+	g.UpsertNode(&reasoning.Node{ID: "c", Type: reasoning.NodeTypeTechnique, Label: "tech"})
+	_ = g.AddEdge(&reasoning.Edge{From: "b", To: "c", Type: reasoning.EdgeTypeEnables, Weight: 0.6})
+	_ = g.AddEdge(&reasoning.Edge{From: "a", To: "b", Type: reasoning.EdgeTypeSupports, Weight: 1})
+
+	first, err := g.CanonicalJSON()
+	if err != nil {
+		t.Fatalf("canonical json: %v", err)
+	}
+	second, err := g.CanonicalJSON()
+	if err != nil {
+		t.Fatalf("canonical json: %v", err)
+	}
+	if !bytes.Equal(first, second) {
+		t.Fatalf("expected identical renders")
+	}
+
+	var doc reasoning.GraphExport
+	if err := json.Unmarshal(first, &doc); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if len(doc.Nodes) != 3 || doc.Nodes[0].ID != "a" || doc.Nodes[2].ID != "c" {
+		t.Fatalf("expected nodes sorted by id, got %+v", doc.Nodes)
+	}
+	if len(doc.Edges) != 2 || doc.Edges[0].From != "a" || doc.Edges[1].From != "b" {
+		t.Fatalf("expected edges sorted by from, got %+v", doc.Edges)
+	}
+}
+
+func TestGraphPruneEdgesRemovesOnlySubThresholdEdges(t *testing.T) {
+	g := reasoning.NewGraph()
+	g.UpsertNode(&reasoning.Node{ID: "ev", Type: reasoning.NodeTypeEvidence, Label: "ev"})
+	g.UpsertNode(&reasoning.Node{ID: "hyp", Type: reasoning.NodeTypeHypothesis, Label: "hyp"})
+	g.UpsertNode(&reasoning.Node{ID: "tech", Type: reasoning.NodeTypeTechnique, Label: "tech"})
+	for _, e := range []*reasoning.Edge{
+		{From: "ev", To: "hyp", Type: reasoning.EdgeTypeSupports, Weight: 0.9},
+		{From: "ev", To: "tech", Type: reasoning.EdgeTypeRefines, Weight: 0.1},
+		{From: "hyp", To: "tech", Type: reasoning.EdgeTypeEnables, Weight: 0.5},
+		{From: "hyp", To: "ev", Type: reasoning.EdgeTypeRefines, Weight: 0.2},
+	} {
+		if err := g.AddEdge(e); err != nil {
+			t.Fatalf("add edge: %v", err)
+		}
+	}
+
+	if removed := g.PruneEdges(0.5); removed != 2 {
+		t.Fatalf("expected 2 pruned edges, got %d", removed)
+	}
+	if g.HasEdgeType(reasoning.EdgeTypeRefines) {
+		t.Fatalf("expected sub-threshold refines edges to be removed")
+	}
+	if !g.HasEdgeType(reasoning.EdgeTypeSupports) || !g.HasEdgeType(reasoning.EdgeTypeEnables) {
+		t.Fatalf("expected edges at or above threshold to remain")
+	}
+	if len(g.EdgesFrom("ev")) != 1 || len(g.EdgesFrom("hyp")) != 1 {
+		t.Fatalf("unexpected remaining edges: ev=%d hyp=%d", len(g.EdgesFrom("ev")), len(g.EdgesFrom("hyp")))
+	}
+}
+
+func TestGraphAddEdgeEndpointRequirement(t *testing.T) {
+	g := reasoning.NewGraph()
+	g.UpsertNode(&reasoning.Node{ID: "a", Type: reasoning.NodeTypeEvidence})
+	if err := g.AddEdge(&reasoning.Edge{From: "a", To: "missing", Type: reasoning.EdgeTypeSupports, Weight: 1}); err == nil {
+		t.Fatalf("expected strict graph to reject an edge to a missing node")
+	}
+	if got := len(g.EdgesFrom("a")); got != 0 {
+		t.Fatalf("expected no edges after rejection, got %d", got)
+	}
+
+	g.SetRequireEndpoints(false)
+	if err := g.AddEdge(&reasoning.Edge{From: "a", To: "missing", Type: reasoning.EdgeTypeSupports, Weight: 1}); err != nil {
+		t.Fatalf("permissive graph rejected edge: %v", err)
+	}
+	if got := len(g.EdgesFrom("a")); got != 1 {
+		t.Fatalf("expected dangling edge to be created, got %d edges", got)
+	}
+}
+
+func TestPruneNodesKeepsPinnedObjectivePath(t *testing.T) {
+	g := reasoning.NewGraph()
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	// The objective evidence is the oldest node, so unpinned it would be evicted first.
+	g.UpsertNode(&reasoning.Node{ID: "ev-objective", Type: reasoning.NodeTypeEvidence, Label: "ev", CreatedAt: base})
+	g.UpsertNode(&reasoning.Node{ID: "exposure", Type: reasoning.NodeTypeDataExposure, Label: "exposure", CreatedAt: base.Add(time.Minute)})
+	for i := 0; i < 4; i++ {
+		g.UpsertNode(&reasoning.Node{ID: fmt.Sprintf("ev-noise-%d", i), Type: reasoning.NodeTypeEvidence, Label: "noise", CreatedAt: base.Add(time.Duration(2+i) * time.Minute)})
+	}
+	if err := g.AddEdge(&reasoning.Edge{From: "ev-objective", To: "exposure", Type: reasoning.EdgeTypeEnables, Weight: 1}); err != nil {
+		t.Fatalf("add edge: %v", err)
+	}
+	if err := g.AddEdge(&reasoning.Edge{From: "ev-noise-0", To: "ev-noise-1", Type: reasoning.EdgeTypeRefines, Weight: 1}); err != nil {
+		t.Fatalf("add edge: %v", err)
+	}
+
+	if pinned := g.PinObjectivePaths([]reasoning.NodeType{reasoning.NodeTypeDataExposure}); pinned != 2 {
+		t.Fatalf("expected objective and its evidence to be pinned, got %d", pinned)
+	}
+	g.UpsertNode(&reasoning.Node{ID: "ev-objective", Type: reasoning.NodeTypeEvidence, Label: "relabelled", CreatedAt: base})
+
+	if removed := g.PruneNodes(3); removed != 3 {
+		t.Fatalf("expected 3 evicted nodes, got %d", removed)
+	}
+	for _, id := range []string{"ev-objective", "exposure", "ev-noise-3"} {
+		if _, ok := g.Node(id); !ok {
+			t.Fatalf("expected %s to survive pruning", id)
+		}
+	}
+	if _, ok := g.Node("ev-noise-0"); ok {
+		t.Fatalf("expected oldest unpinned node to be evicted")
+	}
+	if g.HasEdgeType(reasoning.EdgeTypeRefines) {
+		t.Fatalf("expected edges touching evicted nodes to be removed")
+	}
+	if len(g.EdgesFrom("ev-objective")) != 1 {
+		t.Fatalf("expected objective path edge to remain")
+	}
+
+	if removed := g.PruneNodes(0); removed != 1 || len(g.NodesByType(reasoning.NodeTypeEvidence)) != 1 {
+		t.Fatalf("expected pruning to stop at pinned nodes, removed %d", removed)
+	}
+}
+
+func TestGraphFindPathReturnsShortestAllowedPath(t *testing.T) {
+	g := reasoning.NewGraph()
+	for _, id := range []string{"seed", "hyp-1", "hyp-2", "hyp-3", "tech"} {
+		g.UpsertNode(&reasoning.Node{ID: id, Type: reasoning.NodeTypeEvidence, Label: id})
+	}
+	edges := []reasoning.Edge{
+		{From: "seed", To: "hyp-1", Type: reasoning.EdgeTypeSupports},
+		{From: "hyp-1", To: "hyp-2", Type: reasoning.EdgeTypeSupports},
+		{From: "hyp-2", To: "tech", Type: reasoning.EdgeTypeEnables},
+		{From: "seed", To: "hyp-3", Type: reasoning.EdgeTypeSupports},
+		{From: "hyp-3", To: "tech", Type: reasoning.EdgeTypeEnables},
+		{From: "seed", To: "tech", Type: reasoning.EdgeTypeRefines},
+	}
+	for i := range edges {
+		if err := g.AddEdge(&edges[i]); err != nil {
+			t.Fatalf("add edge: %v", err)
+		}
+	}
+	hops := func(path []*reasoning.Edge) string {
+		out := ""
+		for _, e := range path {
+			out += fmt.Sprintf("%s>%s ", e.From, e.To)
+		}
+		return out
+	}
+
+	path, ok := g.FindPath("seed", "tech", reasoning.EdgeTypeSupports, reasoning.EdgeTypeEnables)
+	if !ok || hops(path) != "seed>hyp-3 hyp-3>tech " {
+		t.Fatalf("expected two-hop path through hyp-3, got %q ok=%v", hops(path), ok)
+	}
+	if path, ok := g.FindPath("seed", "tech"); !ok || len(path) != 1 || path[0].Type != reasoning.EdgeTypeRefines {
+		t.Fatalf("expected direct refines edge when all types are allowed, got %q", hops(path))
+	}
+	if _, ok := g.FindPath("seed", "tech", reasoning.EdgeTypeSupports); ok {
+		t.Fatalf("expected no path over supports edges alone")
+	}
+	if _, ok := g.FindPath("tech", "seed"); ok {
+		t.Fatalf("expected edges to be followed in their direction only")
+	}
+	if path, ok := g.FindPath("hyp-1", "hyp-1"); !ok || len(path) != 0 {
+		t.Fatalf("expected empty path from a node to itself")
+	}
+	if _, ok := g.FindPath("seed", "missing"); ok {
+		t.Fatalf("expected unknown endpoint to report no path")
+	}
+}
+
+func TestConsolidateEvidenceMergesDuplicatesAndKeepsPreconditions(t *testing.T) {
+	g := reasoning.NewGraph()
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	g.UpsertNode(&reasoning.Node{ID: "hyp", Type: reasoning.NodeTypeHypothesis, Label: "hyp"})
+	evidence := []struct {
+		id, technique, success string
+	}{{"ev-1", "T-1", "true"}, {"ev-2", "T-1", "true"}, {"ev-3", "T-1", "true"}, {"ev-4", "T-1", "false"}, {"ev-5", "T-2", "true"}}
+	for i, ev := range evidence {
+		g.UpsertNode(&reasoning.Node{ID: ev.id, Type: reasoning.NodeTypeEvidence, Label: ev.technique + "@host", CreatedAt: base.Add(time.Duration(i) * time.Minute), Metadata: map[string]string{"technique": ev.technique, "target": "host", "success": ev.success}})
+		if err := g.AddEdge(&reasoning.Edge{From: ev.id, To: "hyp", Type: reasoning.EdgeTypeSupports, Weight: 1}); err != nil {
+			t.Fatalf("add edge: %v", err)
+		}
+	}
+	preconditions := []reasoning.GraphPattern{{RequiredNodeTypes: []reasoning.NodeType{reasoning.NodeTypeEvidence, reasoning.NodeTypeHypothesis}, RequiredEdges: []reasoning.EdgeType{reasoning.EdgeTypeSupports}}}
+	if !reasoning.MatchPatterns(g, preconditions) {
+		t.Fatalf("expected preconditions to hold before consolidation")
+	}
+
+	if removed := g.ConsolidateEvidence(); removed != 2 {
+		t.Fatalf("expected 2 duplicate evidence nodes merged, got %d", removed)
+	}
+	if g.NodeCount() != 4 {
+		t.Fatalf("expected 4 nodes after consolidation, got %d", g.NodeCount())
+	}
+	if !reasoning.MatchPatterns(g, preconditions) {
+		t.Fatalf("expected preconditions to still hold after consolidation")
+	}
+	survivor, ok := g.Node("ev-1")
+	if !ok || survivor.Metadata["weight"] != "3" {
+		t.Fatalf("expected oldest duplicate to survive with weight 3, got %+v", survivor)
+	}
+	if _, ok := g.Node("ev-4"); !ok {
+		t.Fatalf("expected failed evidence to stay separate from successful duplicates")
+	}
+	if edges := g.EdgesFrom("ev-1"); len(edges) != 1 || edges[0].To != "hyp" {
+		t.Fatalf("expected merged edges deduplicated onto the survivor, got %d", len(edges))
+	}
+	if g.ConsolidateEvidence() != 0 {
+		t.Fatalf("expected consolidation to be idempotent")
+	}
+}
+
+func TestConsolidateEvidenceKeepsPinnedNodesAndDedupesRewiredEdges(t *testing.T) {
+	g := reasoning.NewGraph()
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	g.UpsertNode(&reasoning.Node{ID: "hyp", Type: reasoning.NodeTypeHypothesis, Label: "hyp"})
+	for i, id := range []string{"ev-1", "ev-2", "ev-3"} {
+		g.UpsertNode(&reasoning.Node{ID: id, Type: reasoning.NodeTypeEvidence, Label: "T-1@host", CreatedAt: base.Add(time.Duration(i) * time.Minute), Pinned: id == "ev-2", Metadata: map[string]string{"technique": "T-1", "target": "host", "success": "true"}})
+	}
+	// The duplicate's edge precedes the survivor's own, so rewiring it must not leave two identical edges.
+	for _, from := range []string{"ev-1", "ev-3", "ev-2"} {
+		if err := g.AddEdge(&reasoning.Edge{From: from, To: "hyp", Type: reasoning.EdgeTypeSupports, Weight: 1}); err != nil {
+			t.Fatalf("add edge: %v", err)
+		}
+	}
+
+	if removed := g.ConsolidateEvidence(); removed != 2 {
+		t.Fatalf("expected both unpinned duplicates merged, got %d", removed)
+	}
+	survivor, ok := g.Node("ev-2")
+	if !ok || !survivor.Pinned || survivor.Metadata["weight"] != "3" {
+		t.Fatalf("expected the pinned node to survive with weight 3, got %+v", survivor)
+	}
+	if edges := g.EdgesFrom("ev-2"); len(edges) != 1 {
+		t.Fatalf("expected rewired edges deduplicated onto the survivor, got %d", len(edges))
+	}
+}
+
+func TestUpsertModeMergeMetadataRetainsPriorKeys(t *testing.T) {
+	for _, tc := range []struct {
+		mode reasoning.UpsertMode
+		want map[string]string
+	}{
+		{reasoning.ReplaceNode, map[string]string{"cycle": "7", "confidence": "0.90"}},
+		{reasoning.MergeMetadata, map[string]string{"success": "true", "target": "host-1", "cycle": "7", "confidence": "0.90"}},
+	} {
+		g := reasoning.NewGraph()
+		g.SetUpsertMode(tc.mode)
+		g.UpsertNode(&reasoning.Node{ID: "n", Type: reasoning.NodeTypeEvidence, Label: "first", Metadata: map[string]string{"success": "true", "target": "host-1"}})
+		g.UpsertNode(&reasoning.Node{ID: "n", Type: reasoning.NodeTypeEvidence, Label: "second", Metadata: map[string]string{"cycle": "7", "confidence": "0.90"}})
+		n, ok := g.Node("n")
+		if !ok {
+			t.Fatalf("%s: expected node to exist", tc.mode)
+		}
+		if n.Label != "second" {
+			t.Fatalf("%s: expected latest label, got %q", tc.mode, n.Label)
+		}
+		if len(n.Metadata) != len(tc.want) {
+			t.Fatalf("%s: expected metadata %v, got %v", tc.mode, tc.want, n.Metadata)
+		}
+		for k, v := range tc.want {
+			if n.Metadata[k] != v {
+				t.Fatalf("%s: expected metadata %v, got %v", tc.mode, tc.want, n.Metadata)
+			}
+		}
+	}
+}
+
+func TestGraphJSONRoundTripPreservesDOT(t *testing.T) {
+	g := reasoning.NewGraph()
+	g.UpsertNode(&reasoning.Node{ID: "b", Type: reasoning.NodeTypeHypothesis, Label: "hyp \"quoted\"", Metadata: map[string]string{"confidence": "0.60"}})
+	g.UpsertNode(&reasoning.Node{ID: "a", Type: reasoning.NodeTypeEvidence, Label: "ev", Pinned: true})
+	g.UpsertNode(&reasoning.Node{ID: "c", Type: reasoning.NodeTypeTechnique, Label: "tech"})
+	_ = g.AddEdge(&reasoning.Edge{From: "b", To: "c", Type: reasoning.EdgeTypeEnables, Weight: 0.6})
+	_ = g.AddEdge(&reasoning.Edge{From: "a", To: "b", Type: reasoning.EdgeTypeSupports, Weight: 1})
+
+	raw, err := g.ToJSON()
+	if err != nil {
+		t.Fatalf("to json: %v", err)
+	}
+	loaded, err := reasoning.LoadGraphFromJSON(raw)
+	if err != nil {
+		t.Fatalf("load graph json: %v", err)
+	}
+	if loaded.ToDOT() != g.ToDOT() {
+		t.Fatalf("expected identical DOT after round trip:\n%s\nvs\n%s", g.ToDOT(), loaded.ToDOT())
+	}
+	node, ok := loaded.Node("b")
+	if !ok || node.Metadata["confidence"] != "0.60" {
+		t.Fatalf("expected metadata to survive the round trip, got %+v", node)
+	}
+	if pinned, _ := loaded.Node("a"); pinned == nil || !pinned.Pinned {
+		t.Fatalf("expected pin state to survive the round trip")
+	}
+	again, err := loaded.ToJSON()
+	if err != nil || !bytes.Equal(raw, again) {
+		t.Fatalf("expected re-serialized JSON to match, err=%v", err)
+	}
+
+	if _, err := reasoning.LoadGraphFromJSON([]byte(`{"nodes":[{"id":"a","type":"EVIDENCE"}],"edges":[{"from":"a","to":"missing","type":"SUPPORTS"}]}`)); err == nil {
+		t.Fatalf("expected an edge to an unknown node to be rejected")
+	}
+}
+
+func TestDOTForPhaseExcludesOtherPhaseTechniques(t *testing.T) {
+	eng := reasoning.NewEngine(nil)
+	eng.BindActionClasses([]reasoning.ActionClass{
+		{ID: "AC-R", Name: "recon", Phase: state.PhaseRecon},
+		{ID: "AC-O", Name: "impact", Phase: state.PhaseObjective},
+	})
+	g := eng.Graph()
+	g.UpsertNode(&reasoning.Node{ID: "ev-recon", Type: reasoning.NodeTypeEvidence, Label: "scan"})
+	g.UpsertNode(&reasoning.Node{ID: "hyp-recon", Type: reasoning.NodeTypeHypothesis, Label: "open service"})
+	g.UpsertNode(&reasoning.Node{ID: "hyp-impact", Type: reasoning.NodeTypeHypothesis, Label: "data reachable"})
+	g.UpsertNode(&reasoning.Node{ID: "tech-recon", Type: reasoning.NodeTypeTechnique, Label: "T-R", Metadata: map[string]string{"action_class": "AC-R"}})
+	g.UpsertNode(&reasoning.Node{ID: "tech-impact", Type: reasoning.NodeTypeTechnique, Label: "T-O", Metadata: map[string]string{"action_class": "AC-O"}})
+	for _, e := range []*reasoning.Edge{
+		{From: "ev-recon", To: "tech-recon", Type: reasoning.EdgeTypeSupports, Weight: 1},
+		{From: "hyp-recon", To: "tech-recon", Type: reasoning.EdgeTypeEnables, Weight: 0.6},
+		{From: "hyp-impact", To: "tech-impact", Type: reasoning.EdgeTypeEnables, Weight: 0.6},
+		{From: "tech-recon", To: "tech-impact", Type: reasoning.EdgeTypeEnables, Weight: 0.5},
+	} {
+		if err := g.AddEdge(e); err != nil {
+			t.Fatalf("add edge: %v", err)
+		}
+	}
+
+	dot := eng.DOTForPhase(state.PhaseRecon)
+	for _, want := range []string{`"tech-recon"`, `"ev-recon"`, `"hyp-recon"`, `"hyp-recon" -> "tech-recon"`} {
+		if !strings.Contains(dot, want) {
+			t.Fatalf("expected recon render to contain %s, got:\n%s", want, dot)
+		}
+	}
+	for _, unwanted := range []string{`"tech-impact"`, `"hyp-impact"`} {
+		if strings.Contains(dot, unwanted) {
+			t.Fatalf("expected recon render to exclude %s, got:\n%s", unwanted, dot)
+		}
+	}
+	if !strings.Contains(eng.DOTForPhase(state.PhaseObjective), `"tech-impact"`) {
+		t.Fatalf("expected objective render to contain the objective-phase technique")
+	}
+}
@@ -0,0 +1,56 @@
+package tests
+
+import (
+	"testing"
+
+	"vantage/core/reasoning"
+)
+
+func TestParseObjectiveResolvesBuiltinAndRegisteredNames(t *testing.T) {
+	for name, want := range map[string]reasoning.Objective{
+		"DATA_EXPOSURE":          {Name: "DATA_EXPOSURE", NodeType: reasoning.NodeTypeDataExposure},
+		" priv_esc ":             {Name: "PRIV_ESC", NodeType: reasoning.NodeTypePrivEsc},
+		"lateral_reachability":   {Name: "LATERAL_REACHABILITY", NodeType: reasoning.NodeTypeLateralReachability},
+		"enables":                {Name: "ENABLES", EdgeType: reasoning.EdgeTypeEnables},
+		"DATA_EXPOSURE\t":        {Name: "DATA_EXPOSURE", NodeType: reasoning.NodeTypeDataExposure},
+		"Lateral_Reachability  ": {Name: "LATERAL_REACHABILITY", NodeType: reasoning.NodeTypeLateralReachability},
+	} {
+		got, err := reasoning.ParseObjective(name)
+		if err != nil {
+			t.Fatalf("parse %q: %v", name, err)
+		}
+		if got != want {
+			t.Fatalf("parse %q: expected %+v, got %+v", name, want, got)
+		}
+	}
+	if got, _ := reasoning.ParseObjective("enables"); !got.IsEdge() {
+		t.Fatalf("expected ENABLES to be an edge objective")
+	}
+
+	const foothold reasoning.NodeType = "FOOTHOLD"
+	if err := reasoning.RegisterObjective("foothold", reasoning.Objective{NodeType: foothold}); err != nil {
+		t.Fatalf("register custom objective: %v", err)
+	}
+	t.Cleanup(func() {
+		if err := reasoning.UnregisterObjective("foothold"); err != nil {
+			t.Errorf("unregister custom objective: %v", err)
+		}
+	})
+	if got, err := reasoning.ParseObjective("FOOTHOLD"); err != nil || got.NodeType != foothold {
+		t.Fatalf("expected registered objective, got %+v err=%v", got, err)
+	}
+	if err := reasoning.RegisterObjective("PRIV_ESC", reasoning.Objective{NodeType: foothold}); err == nil {
+		t.Fatalf("expected redefining a built-in objective to fail")
+	}
+	if err := reasoning.RegisterObjective("ambiguous", reasoning.Objective{NodeType: foothold, EdgeType: reasoning.EdgeTypeEnables}); err == nil {
+		t.Fatalf("expected an objective with both node and edge types to be rejected")
+	}
+
+	if err := reasoning.UnregisterObjective("data_exposure"); err == nil {
+		t.Fatalf("expected unregistering a built-in objective to fail")
+	}
+
+	if _, err := reasoning.ParseObjective("ROOT_SHELL"); err == nil {
+		t.Fatalf("expected unknown objective name to fail")
+	}
+}
@@ -1,9 +1,13 @@
 package tests
 
 import (
+	"strings"
 	"testing"
 
+	"vantage/core/exposure"
 	"vantage/core/reasoning"
+	"vantage/core/state"
+	"vantage/techniques"
 )
 
 func TestPlannerRanksOnlyMatchingActionClassTechniques(t *testing.T) {
@@ -28,3 +32,171 @@ func TestPlannerRanksOnlyMatchingActionClassTechniques(t *testing.T) {
 		}
 	}
 }
+
+func TestEfficiencyModeRanksImpactPerRisk(t *testing.T) {
+	re := reasoning.NewEngine(nil)
+	re.RegisterTechniqueEffect(reasoning.TechniqueEffect{TechniqueID: "T-BIG", Impact: 0.9, Risk: 0.8, Stealth: 0.5})
+	re.RegisterTechniqueEffect(reasoning.TechniqueEffect{TechniqueID: "T-LEAN", Impact: 0.4, Risk: 0.05, Stealth: 0.5})
+	query := reasoning.PlannerQuery{Target: "host-1", AllowedTechniques: []string{"T-BIG", "T-LEAN"}}
+
+	decision, err := re.PlanNextAction(query)
+	if err != nil {
+		t.Fatalf("plan next action: %v", err)
+	}
+	if decision.Selected.TechniqueID != "T-BIG" {
+		t.Fatalf("expected weighted sum to prefer T-BIG, got %s", decision.Selected.TechniqueID)
+	}
+
+	weights := reasoning.DefaultTechniqueScoreWeights()
+	weights.EfficiencyMode = true
+	re.SetTechniqueScoreWeights(weights)
+	decision, err = re.PlanNextAction(query)
+	if err != nil {
+		t.Fatalf("plan next action: %v", err)
+	}
+	if decision.Selected.TechniqueID != "T-LEAN" {
+		t.Fatalf("expected efficiency mode to prefer T-LEAN, got %s (%+v)", decision.Selected.TechniqueID, decision.Ranked)
+	}
+}
+
+func TestTechniqueEffectPhaseOverrideChangesScoreByPhase(t *testing.T) {
+	re := reasoning.NewEngine(nil)
+	re.RegisterTechniqueEffect(reasoning.TechniqueEffect{TechniqueID: "T-CRED", Impact: 0.7, Risk: 0.1, Stealth: 0.8, PhaseOverrides: map[reasoning.OperationPhase]reasoning.TechniqueEffect{
+		state.PhaseObjective: {Impact: 0.7, Risk: 0.9, Stealth: 0.2},
+	}})
+	re.RegisterTechniqueEffect(reasoning.TechniqueEffect{TechniqueID: "T-STEADY", Impact: 0.5, Risk: 0.3, Stealth: 0.6})
+	query := reasoning.PlannerQuery{Target: "host-1", AllowedTechniques: []string{"T-CRED", "T-STEADY"}}
+	scoreOf := func(phase reasoning.OperationPhase) (string, float64) {
+		t.Helper()
+		q := query
+		q.Phase = phase
+		decision, err := re.PlanNextAction(q)
+		if err != nil {
+			t.Fatalf("plan next action in %s: %v", phase, err)
+		}
+		for _, ra := range decision.Ranked {
+			if ra.TechniqueID == "T-CRED" {
+				return decision.Selected.TechniqueID, ra.Score
+			}
+		}
+		t.Fatalf("expected T-CRED ranked in %s", phase)
+		return "", 0
+	}
+
+	reconPick, reconScore := scoreOf(state.PhaseRecon)
+	objectivePick, objectiveScore := scoreOf(state.PhaseObjective)
+	if reconScore <= objectiveScore {
+		t.Fatalf("expected objective-phase override to lower T-CRED's score, got recon=%.3f objective=%.3f", reconScore, objectiveScore)
+	}
+	if reconPick != "T-CRED" || objectivePick != "T-STEADY" {
+		t.Fatalf("expected T-CRED in recon and T-STEADY in objective, got %s and %s", reconPick, objectivePick)
+	}
+}
+
+func TestRankedActionsRecordTieBreakReason(t *testing.T) {
+	re := reasoning.NewEngine(nil)
+	re.RegisterTechniqueEffect(reasoning.TechniqueEffect{TechniqueID: "T-B", Impact: 0.6, Risk: 0.2, Stealth: 0.7})
+	re.RegisterTechniqueEffect(reasoning.TechniqueEffect{TechniqueID: "T-A", Impact: 0.6, Risk: 0.2, Stealth: 0.7})
+	re.RegisterTechniqueEffect(reasoning.TechniqueEffect{TechniqueID: "T-C", Impact: 0.1, Risk: 0.9, Stealth: 0.1})
+
+	allowed := []string{"T-C", "T-B", "T-A"}
+	decision, err := re.PlanNextAction(reasoning.PlannerQuery{Target: "host-1", AllowedTechniques: allowed})
+	if err != nil {
+		t.Fatalf("plan next action: %v", err)
+	}
+	ranked := decision.Ranked
+	if len(ranked) != 3 || ranked[0].TechniqueID != "T-A" || ranked[1].TechniqueID != "T-B" {
+		t.Fatalf("expected tied techniques ordered by ID, got %+v", ranked)
+	}
+	for _, want := range []struct {
+		id, reason string
+	}{{"T-A", reasoning.TieBreakTechniqueID}, {"T-B", reasoning.TieBreakTechniqueID}, {"T-C", reasoning.TieBreakScore}} {
+		for _, ra := range ranked {
+			if ra.TechniqueID == want.id && ra.TieBreakReason != want.reason {
+				t.Fatalf("expected %s tie-break reason %q, got %q", want.id, want.reason, ra.TieBreakReason)
+			}
+		}
+	}
+
+	top, err := re.PlanNextAction(reasoning.PlannerQuery{Target: "host-1", AllowedTechniques: allowed, TopN: 1})
+	if err != nil || len(top.Ranked) != 1 || top.Selected.TieBreakReason != reasoning.TieBreakTechniqueID {
+		t.Fatalf("expected the truncated winner to keep its technique ID tie-break, got %+v (%v)", top, err)
+	}
+}
+
+func TestPlannerDownWeightsTechniquesWithUnboundActionClass(t *testing.T) {
+	re := reasoning.NewEngine(nil)
+	re.BindActionClasses([]reasoning.ActionClass{{ID: "AC-BOUND", Name: "bound", Phase: state.PhaseRecon}})
+	re.RegisterTechniqueEffect(reasoning.TechniqueEffect{TechniqueID: "T-A-UNBOUND", ActionClassID: "AC-MISSING", Impact: 0.6, Risk: 0.2, Stealth: 0.7})
+	re.RegisterTechniqueEffect(reasoning.TechniqueEffect{TechniqueID: "T-B-BOUND", ActionClassID: "AC-BOUND", Impact: 0.6, Risk: 0.2, Stealth: 0.7})
+
+	decision, err := re.PlanNextAction(reasoning.PlannerQuery{Target: "host-1", AllowedTechniques: []string{"T-A-UNBOUND", "T-B-BOUND"}})
+	if err != nil {
+		t.Fatalf("plan next action: %v", err)
+	}
+	if decision.Selected.TechniqueID != "T-B-BOUND" || len(decision.Ranked) != 2 {
+		t.Fatalf("expected the bound technique to outrank its unbound equivalent, got %+v", decision.Ranked)
+	}
+	bound, unbound := decision.Ranked[0], decision.Ranked[1]
+	if unbound.Score != bound.Score*reasoning.UnboundClassDiscount || !strings.Contains(unbound.Reason, "action_class=unbound") {
+		t.Fatalf("expected the unbound technique to be discounted, got %+v vs %+v", unbound, bound)
+	}
+
+	// Repeated failures drive both scores negative; the discount must still push the unbound one lower.
+	st, _ := state.New("unbound-negative")
+	for i := 0; i < 5; i++ {
+		st.RecordActionMemory("AC-BOUND", false, false)
+		st.RecordActionMemory("AC-MISSING", false, false)
+	}
+	tracker, err := exposure.New(100)
+	if err != nil {
+		t.Fatalf("exposure tracker: %v", err)
+	}
+	re.ConfigureCycle(reasoning.CycleConfig{Target: "host-1", AllowedTechniques: []string{"T-A-UNBOUND", "T-B-BOUND"}, Executor: &chargingExecutor{tracker: tracker}})
+	if _, err := re.RunCycle(st); err != nil {
+		t.Fatalf("run cycle: %v", err)
+	}
+	decision, err = re.PlanNextAction(reasoning.PlannerQuery{Target: "host-1", AllowedTechniques: []string{"T-A-UNBOUND", "T-B-BOUND"}})
+	if err != nil {
+		t.Fatalf("plan next action: %v", err)
+	}
+	if len(decision.Ranked) != 2 || decision.Selected.TechniqueID != "T-B-BOUND" || decision.Ranked[0].Score >= 0 || decision.Ranked[1].Score >= decision.Ranked[0].Score {
+		t.Fatalf("expected the negatively scored unbound technique to stay below the bound one, got %+v", decision.Ranked)
+	}
+}
+
+func TestRankedActionsForHypothesesAppliesTechniquePreference(t *testing.T) {
+	g := reasoning.NewGraph()
+	for _, n := range []*reasoning.Node{
+		{ID: "ev-1", Type: reasoning.NodeTypeEvidence, Label: "ev"}, {ID: "ev-2", Type: reasoning.NodeTypeEvidence, Label: "ev"},
+		{ID: "hyp-1", Type: reasoning.NodeTypeHypothesis, Label: "hyp"}, {ID: "hyp-2", Type: reasoning.NodeTypeHypothesis, Label: "hyp"},
+		{ID: "tech-1", Type: reasoning.NodeTypeTechnique, Label: "tech"},
+	} {
+		g.UpsertNode(n)
+	}
+	_ = g.AddEdge(&reasoning.Edge{From: "ev-1", To: "hyp-1", Type: reasoning.EdgeTypeSupports, Weight: 1})
+	_ = g.AddEdge(&reasoning.Edge{From: "hyp-1", To: "tech-1", Type: reasoning.EdgeTypeEnables, Weight: 1})
+	snapshot := &techniques.Graph{EvidenceNodes: 2, HypothesisNodes: 2, TechniqueNodes: 1, HasSupportsEdge: true, HasEnablesEdge: true}
+	lookup := func(id string) (reasoning.ActionClass, bool) {
+		return reasoning.ActionClass{ID: id, ImpactWeight: 0.6, RiskWeight: 0.4}, id == "AC-13"
+	}
+	hypotheses := []reasoning.Hypothesis{{ID: "h1", ActionClassID: "AC-13"}}
+
+	p := reasoning.NewPlanner(nil, reasoning.DefaultTechniqueScoreWeights())
+	picked := map[string]bool{}
+	for _, policy := range []techniques.PreferencePolicy{{}, {ByClass: map[string]techniques.Preference{"AC-13": techniques.PreferHigherImpact}}} {
+		p.SetPreferencePolicy(policy)
+		want, ok := policy.Select("AC-13", snapshot)
+		if !ok {
+			t.Fatalf("expected an admissible AC-13 technique")
+		}
+		ranked := p.RankedActionsForHypotheses(g, "target", hypotheses, lookup, 0)
+		if len(ranked) != 1 || ranked[0].TechniqueID != want.ID() {
+			t.Fatalf("expected %s preference to rank %s, got %+v", policy.For("AC-13"), want.ID(), ranked)
+		}
+		picked[want.ID()] = true
+	}
+	if len(picked) != 2 {
+		t.Fatalf("expected the two preferences to pick different techniques, got %v", picked)
+	}
+}
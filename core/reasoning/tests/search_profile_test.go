@@ -0,0 +1,138 @@
+package tests
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"vantage/core/reasoning"
+	"vantage/core/state"
+)
+
+func TestLoadAttackPathConfigResolvesROEPreset(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "attack.json")
+	profile := `{"max_depth": 3, "beam_width": 8, "risk_threshold": 1.5, "stagnation_penalty": 0.1, "objective_node_types": ["DATA_EXPOSURE"], "roe_preset": "recon_only", "beam_objective": "min_risk"}`
+	if err := os.WriteFile(path, []byte(profile), 0o644); err != nil {
+		t.Fatalf("write profile: %v", err)
+	}
+
+	cfg, err := reasoning.LoadAttackPathConfig(path)
+	if err != nil {
+		t.Fatalf("load attack path config: %v", err)
+	}
+	if cfg.MaxDepth != 3 || cfg.BeamWidth != 8 || cfg.RiskThreshold != 1.5 || cfg.StagnationPenalty != 0.1 {
+		t.Fatalf("unexpected parsed values: %+v", cfg)
+	}
+	if cfg.DepthPenalty != reasoning.DefaultAttackPathConfig().DepthPenalty {
+		t.Fatalf("expected omitted depth penalty to keep default, got %.2f", cfg.DepthPenalty)
+	}
+	if len(cfg.ObjectiveNodeTypes) != 1 || cfg.ObjectiveNodeTypes[0] != reasoning.NodeTypeDataExposure {
+		t.Fatalf("unexpected objective node types: %v", cfg.ObjectiveNodeTypes)
+	}
+	if cfg.BeamObjective != reasoning.BeamObjectiveMinRisk || cfg.ROEPreset != reasoning.ROEPresetReconOnly {
+		t.Fatalf("unexpected beam objective or preset: %s %s", cfg.BeamObjective, cfg.ROEPreset)
+	}
+	if !cfg.ROEPolicy(reasoning.ActionClass{ID: "AC-R", Phase: state.PhaseRecon}, nil, nil) {
+		t.Fatalf("expected recon_only preset to admit recon action")
+	}
+	if cfg.ROEPolicy(reasoning.ActionClass{ID: "AC-L", Phase: state.PhaseLateralMovement}, nil, nil) {
+		t.Fatalf("expected recon_only preset to reject lateral action")
+	}
+}
+
+func TestLoadAttackPathConfigRejectsUnknownPreset(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "attack.json")
+	if err := os.WriteFile(path, []byte(`{"roe_preset": "anything_goes"}`), 0o644); err != nil {
+		t.Fatalf("write profile: %v", err)
+	}
+	if _, err := reasoning.LoadAttackPathConfig(path); err == nil {
+		t.Fatalf("expected unknown roe preset to be rejected")
+	}
+}
+
+func TestCampaignOptionsRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "campaign.json")
+	opts := reasoning.DefaultCampaignOptions()
+	opts.MaxDepth = 4
+	opts.AllowGaps = true
+	opts.BeamObjective = reasoning.BeamObjectiveMaxConfidence
+	opts.PhaseDeadlines = []reasoning.PhaseDeadline{{Phase: state.PhaseRecon, Deadline: 48 * time.Hour}, {Phase: state.PhaseInitialAccess, Deadline: 90 * time.Minute}}
+	if err := reasoning.SaveCampaignOptions(path, opts); err != nil {
+		t.Fatalf("save campaign options: %v", err)
+	}
+	if raw, err := os.ReadFile(path); err != nil || !strings.Contains(string(raw), `"deadline": "48h0m0s"`) {
+		t.Fatalf("expected deadlines written as duration strings, got %s (%v)", raw, err)
+	}
+	loaded, err := reasoning.LoadCampaignOptions(path)
+	if err != nil {
+		t.Fatalf("load campaign options: %v", err)
+	}
+	if !reflect.DeepEqual(loaded, opts) {
+		t.Fatalf("round trip mismatch: got %+v want %+v", loaded, opts)
+	}
+}
+
+func TestSaveAttackPathConfigRejectsPolicyThatDiffersFromPreset(t *testing.T) {
+	dir := t.TempDir()
+	cfg := reasoning.DefaultAttackPathConfig()
+	if err := reasoning.SaveAttackPathConfig(filepath.Join(dir, "default.json"), cfg); err != nil {
+		t.Fatalf("expected the default config to save, got %v", err)
+	}
+	cfg.ROEPolicy = func(ac reasoning.ActionClass, _ *reasoning.Graph, _ *state.State) bool {
+		return ac.Phase == state.PhaseRecon
+	}
+	if err := reasoning.SaveAttackPathConfig(filepath.Join(dir, "widened.json"), cfg); err == nil {
+		t.Fatalf("expected a restrictive policy saved under allow_all to be rejected")
+	}
+	cfg.ROEPolicy, _ = reasoning.ResolveROEPreset(reasoning.ROEPresetReconOnly)
+	cfg.ROEPreset = reasoning.ROEPresetReconOnly
+	if err := reasoning.SaveAttackPathConfig(filepath.Join(dir, "recon.json"), cfg); err != nil {
+		t.Fatalf("expected a policy matching its preset to save, got %v", err)
+	}
+}
+
+func TestSearchProfilesRoundTripAsYAML(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "attack.yaml")
+	profile := "# recon sweep\nmax_depth: 3\nbeam_width: 8\nobjective_node_types: [DATA_EXPOSURE, PRIV_ESC]\nroe_preset: recon_only\nedge_importance:\n  supports: 1.5\n"
+	if err := os.WriteFile(path, []byte(profile), 0o644); err != nil {
+		t.Fatalf("write profile: %v", err)
+	}
+	cfg, err := reasoning.LoadAttackPathConfig(path)
+	if err != nil {
+		t.Fatalf("load yaml attack path config: %v", err)
+	}
+	if cfg.MaxDepth != 3 || cfg.BeamWidth != 8 || cfg.ROEPreset != reasoning.ROEPresetReconOnly || len(cfg.ObjectiveNodeTypes) != 2 || cfg.EdgeImportance[reasoning.EdgeTypeSupports] != 1.5 {
+		t.Fatalf("unexpected yaml values: %+v", cfg)
+	}
+	saved := filepath.Join(dir, "saved.yml")
+	if err := reasoning.SaveAttackPathConfig(saved, cfg); err != nil {
+		t.Fatalf("save yaml attack path config: %v", err)
+	}
+	reloaded, err := reasoning.LoadAttackPathConfig(saved)
+	if err != nil || reloaded.ROEPreset != cfg.ROEPreset || !reflect.DeepEqual(reloaded.EdgeImportance, cfg.EdgeImportance) || !reflect.DeepEqual(reloaded.ObjectiveNodeTypes, cfg.ObjectiveNodeTypes) {
+		t.Fatalf("yaml round trip mismatch: %+v (%v)", reloaded, err)
+	}
+
+	optsPath := filepath.Join(dir, "campaign.yaml")
+	opts := reasoning.DefaultCampaignOptions()
+	opts.TopN = 7
+	opts.PhaseDeadlines = []reasoning.PhaseDeadline{{Phase: state.PhaseRecon, Deadline: 36 * time.Hour}}
+	if err := reasoning.SaveCampaignOptions(optsPath, opts); err != nil {
+		t.Fatalf("save yaml campaign options: %v", err)
+	}
+	loaded, err := reasoning.LoadCampaignOptions(optsPath)
+	if err != nil || !reflect.DeepEqual(loaded, opts) {
+		t.Fatalf("yaml campaign options round trip mismatch: %+v (%v)", loaded, err)
+	}
+
+	if err := os.WriteFile(path, []byte("max_depth: 3\nunknown_field: 1\n"), 0o644); err != nil {
+		t.Fatalf("write profile: %v", err)
+	}
+	if _, err := reasoning.LoadAttackPathConfig(path); err == nil {
+		t.Fatalf("expected unknown yaml fields to be rejected")
+	}
+}
@@ -0,0 +1,49 @@
+package tests
+
+import (
+	"reflect"
+	"testing"
+
+	"vantage/core/reasoning"
+)
+
+func TestGenerateSyntheticClassesIsDeterministicAndReachable(t *testing.T) {
+	classes := reasoning.GenerateSyntheticClasses(200, 42)
+	if len(classes) != 200 {
+		t.Fatalf("expected 200 classes, got %d", len(classes))
+	}
+	if !reflect.DeepEqual(classes, reasoning.GenerateSyntheticClasses(200, 42)) {
+		t.Fatalf("expected identical corpus for identical seed")
+	}
+
+	available := map[reasoning.NodeType]bool{reasoning.NodeTypeEvidence: true}
+	enabled := map[string]bool{}
+	for changed := true; changed; {
+		changed = false
+		for _, ac := range classes {
+			if enabled[ac.ID] {
+				continue
+			}
+			satisfied := true
+			for _, p := range ac.Preconditions {
+				for _, n := range p.RequiredNodeTypes {
+					satisfied = satisfied && available[n]
+				}
+			}
+			if !satisfied {
+				continue
+			}
+			enabled[ac.ID] = true
+			for _, n := range ac.ProducesNodes {
+				available[n] = true
+			}
+			changed = true
+		}
+	}
+	if len(enabled) != len(classes) {
+		t.Fatalf("expected every class reachable from evidence, got %d of %d", len(enabled), len(classes))
+	}
+	if !available[reasoning.NodeTypeDataExposure] {
+		t.Fatalf("expected an objective-producing class to be reachable")
+	}
+}
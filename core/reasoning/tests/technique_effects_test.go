@@ -0,0 +1,86 @@
+package tests
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"vantage/core/reasoning"
+	"vantage/techniques"
+)
+
+func TestLoadTechniqueEffectsOverridesDerivedValues(t *testing.T) {
+	all := techniques.RegisterAll()
+	ids := make([]string, 0, len(all))
+	for id := range all {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	overridden, untouched := all[ids[0]], all[ids[1]]
+
+	eng := reasoning.NewEngine(nil)
+	derived, ok := eng.EffectForTechnique(overridden.ID())
+	if !ok || derived.Impact != overridden.ImpactModifier() || derived.Risk != overridden.RiskModifier() || derived.ActionClassID != overridden.ActionClassID() {
+		t.Fatalf("expected effect derived from technique modifiers, got %+v", derived)
+	}
+
+	path := filepath.Join(t.TempDir(), "effects.yaml")
+	overrides := "# operator tuning\n" + overridden.ID() + ":\n  impact: 0.95\n  stealth: 0.1\n  produces: [DATA_EXPOSURE]\n"
+	if err := os.WriteFile(path, []byte(overrides), 0o644); err != nil {
+		t.Fatalf("write effects: %v", err)
+	}
+	if err := eng.LoadTechniqueEffects(path); err != nil {
+		t.Fatalf("load effects: %v", err)
+	}
+
+	got, _ := eng.EffectForTechnique(overridden.ID())
+	if got.Impact != 0.95 || got.Stealth != 0.1 || !reflect.DeepEqual(got.Produces, []string{"DATA_EXPOSURE"}) {
+		t.Fatalf("expected overrides to replace derived values, got %+v", got)
+	}
+	if got.Risk != overridden.RiskModifier() {
+		t.Fatalf("expected omitted risk to keep the derived value, got %.2f", got.Risk)
+	}
+	if other, _ := eng.EffectForTechnique(untouched.ID()); other.Impact != untouched.ImpactModifier() {
+		t.Fatalf("expected unlisted technique to keep its derived effect, got %+v", other)
+	}
+
+	if err := os.WriteFile(path, []byte(overridden.ID()+":\n  risk: 1.5\n"), 0o644); err != nil {
+		t.Fatalf("write effects: %v", err)
+	}
+	if err := eng.LoadTechniqueEffects(path); err == nil {
+		t.Fatalf("expected out-of-range risk to be rejected")
+	}
+
+	if err := os.WriteFile(path, []byte(overridden.ID()+":\n  risk: 0.2\nT-TYPO:\n  impact: 0.5\n"), 0o644); err != nil {
+		t.Fatalf("write effects: %v", err)
+	}
+	err := eng.LoadTechniqueEffects(path)
+	if err == nil || !strings.Contains(err.Error(), path) || !strings.Contains(err.Error(), "T-TYPO") {
+		t.Fatalf("expected an unknown technique error naming the file and ID, got %v", err)
+	}
+	if got, _ := eng.EffectForTechnique(overridden.ID()); got.Risk != overridden.RiskModifier() {
+		t.Fatalf("expected a rejected file to register nothing, got risk %.2f", got.Risk)
+	}
+}
+
+func TestRequirementMatrixResolvesProductionFromBoundClasses(t *testing.T) {
+	classes, err := reasoning.LoadActionClassesFromDir(resolveActionClassesDir(t))
+	if err != nil {
+		t.Fatalf("load action classes: %v", err)
+	}
+	eng := reasoning.NewEngine(nil)
+	eng.BindActionClasses(classes)
+
+	want := []string{string(reasoning.NodeTypeEvidence), string(reasoning.NodeTypeHypothesis), string(reasoning.EdgeTypeSupports)}
+	for _, row := range techniques.RequirementMatrix(eng.ActionClassProduction) {
+		if !reflect.DeepEqual(row.Produces, want) {
+			t.Fatalf("%s: expected %s to produce %v, got %v", row.TechniqueID, row.ActionClassID, want, row.Produces)
+		}
+	}
+	if got := eng.ActionClassProduction("AC-UNKNOWN"); got != nil {
+		t.Fatalf("expected no production for an unbound class, got %v", got)
+	}
+}
@@ -0,0 +1,86 @@
+package tests
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"vantage/core/reasoning"
+	"vantage/core/state"
+)
+
+func syntheticPlanningEngine(t testing.TB, classes []reasoning.ActionClass, cacheSize int) *reasoning.Engine {
+	t.Helper()
+	eng := reasoning.NewEngine(nil)
+	eng.BindActionClasses(classes)
+	eng.SetUnlockCacheSize(cacheSize)
+	if err := eng.IngestEvidence(reasoning.EvidenceEvent{TechniqueID: "T-seed", Target: "host", Success: true}); err != nil {
+		t.Fatalf("ingest: %v", err)
+	}
+	return eng
+}
+
+func TestTinyUnlockCacheMatchesUnboundedResults(t *testing.T) {
+	classes := reasoning.GenerateSyntheticClasses(40, 7)
+	opts := reasoning.DefaultCampaignOptions()
+	opts.MaxDepth = 4
+
+	bounded := syntheticPlanningEngine(t, classes, 1)
+	unbounded := syntheticPlanningEngine(t, classes, 0)
+
+	boundedCampaigns, err := bounded.PlanCampaign(reasoning.NodeTypeDataExposure, opts)
+	if err != nil {
+		t.Fatalf("plan bounded: %v", err)
+	}
+	unboundedCampaigns, err := unbounded.PlanCampaign(reasoning.NodeTypeDataExposure, opts)
+	if err != nil {
+		t.Fatalf("plan unbounded: %v", err)
+	}
+	if len(unboundedCampaigns) == 0 {
+		t.Fatalf("expected the synthetic corpus to yield campaigns")
+	}
+	// Plans are stamped with their planning time; only the search results must match.
+	for i := range boundedCampaigns {
+		boundedCampaigns[i].Meta.PlannedAt = time.Time{}
+	}
+	for i := range unboundedCampaigns {
+		unboundedCampaigns[i].Meta.PlannedAt = time.Time{}
+	}
+	if !reflect.DeepEqual(boundedCampaigns, unboundedCampaigns) {
+		t.Fatalf("expected identical campaigns with a tiny unlock cache")
+	}
+
+	st, _ := state.New("camp")
+	boundedPaths, err := bounded.ExpandAttackPaths(st)
+	if err != nil {
+		t.Fatalf("expand bounded: %v", err)
+	}
+	unboundedPaths, err := unbounded.ExpandAttackPaths(st)
+	if err != nil {
+		t.Fatalf("expand unbounded: %v", err)
+	}
+	if !reflect.DeepEqual(boundedPaths, unboundedPaths) {
+		t.Fatalf("expected identical attack paths with a tiny unlock cache")
+	}
+}
+
+func BenchmarkPlanCampaignUnlockCache(b *testing.B) {
+	classes := reasoning.GenerateSyntheticClasses(200, 42)
+	opts := reasoning.DefaultCampaignOptions()
+	opts.MaxDepth = 6
+	for _, bench := range []struct {
+		name string
+		size int
+	}{{"unbounded", 0}, {"default", reasoning.DefaultUnlockCacheSize}, {"tiny", 16}} {
+		b.Run(bench.name, func(b *testing.B) {
+			eng := syntheticPlanningEngine(b, classes, bench.size)
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if _, err := eng.PlanCampaign(reasoning.NodeTypeDataExposure, opts); err != nil {
+					b.Fatalf("plan: %v", err)
+				}
+			}
+		})
+	}
+}
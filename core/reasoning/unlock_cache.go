@@ -0,0 +1,66 @@
+package reasoning
+
+import "container/list"
+
+// DefaultUnlockCacheSize bounds the unlock-count memo kept by a single planning call.
+const DefaultUnlockCacheSize = 4096
+
+// unlockCache memoizes unlock counts by graph-hash/availability key, evicting the least recently
+// used entry once maxEntries is reached. It is a pure memo: evictions only cost recomputation.
+type unlockCache struct {
+	maxEntries int
+	order      *list.List
+	entries    map[string]*list.Element
+	// hits and misses count get outcomes for planning metrics.
+	hits, misses int
+}
+
+type unlockCacheEntry struct {
+	key   string
+	value float64
+}
+
+// newUnlockCache creates a cache holding at most maxEntries keys; non-positive values mean unbounded.
+func newUnlockCache(maxEntries int) *unlockCache {
+	return &unlockCache{maxEntries: maxEntries, order: list.New(), entries: map[string]*list.Element{}}
+}
+
+func (c *unlockCache) get(key string) (float64, bool) {
+	el, ok := c.entries[key]
+	if !ok {
+		c.misses++
+		return 0, false
+	}
+	c.hits++
+	c.order.MoveToFront(el)
+	return el.Value.(*unlockCacheEntry).value, true
+}
+
+func (c *unlockCache) put(key string, value float64) {
+	if el, ok := c.entries[key]; ok {
+		el.Value.(*unlockCacheEntry).value = value
+		c.order.MoveToFront(el)
+		return
+	}
+	c.entries[key] = c.order.PushFront(&unlockCacheEntry{key: key, value: value})
+	if c.maxEntries > 0 && c.order.Len() > c.maxEntries {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*unlockCacheEntry).key)
+	}
+}
+
+// SetUnlockCacheSize caps the unlock-count memo used by path expansion and campaign planning.
+// Non-positive values leave the memo unbounded.
+func (e *Engine) SetUnlockCacheSize(maxEntries int) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.unlockCacheSize = maxEntries
+}
+
+// newPlanningUnlockCache creates the unlock memo for one planning call using the configured cap.
+func (e *Engine) newPlanningUnlockCache() *unlockCache {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return newUnlockCache(e.unlockCacheSize)
+}
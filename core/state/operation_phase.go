@@ -27,6 +27,13 @@ var phaseOrder = []OperationPhase{
 	PhaseExfil,
 }
 
+// Phases returns every lifecycle phase in progression order.
+func Phases() []OperationPhase {
+	out := make([]OperationPhase, len(phaseOrder))
+	copy(out, phaseOrder)
+	return out
+}
+
 // Validate checks whether a phase is a known lifecycle value.
 func (p OperationPhase) Validate() error {
 	for _, candidate := range phaseOrder {
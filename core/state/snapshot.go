@@ -0,0 +1,112 @@
+package state
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// -----------------------------------------------------------------------------
+// Campaign Snapshots — CHECKPOINT / RESTORE
+//
+// A snapshot captures every execution fact of a campaign so a multi-day
+// engagement can survive process restarts.
+//
+// GUARANTEES:
+//
+// 1. LOSSLESS
+//    Status, timestamps, halt reason, limits, execution count and the
+//    adaptation memory all round-trip.
+//
+// 2. FAIL-CLOSED
+//    A halted campaign restores halted. A running campaign restores
+//    running and therefore refuses Start(); it is never reset.
+//
+// 3. VERSIONED
+//    Snapshots from a newer format are rejected rather than guessed at.
+//
+// The clock is not persisted; restored campaigns use the wall clock.
+// -----------------------------------------------------------------------------
+
+// SnapshotVersion is the campaign snapshot format version written by Snapshot.
+const SnapshotVersion = 1
+
+// campaignSnapshot is the serialized form of a Campaign.
+type campaignSnapshot struct {
+	Version           int                `json:"version"`
+	CampaignID        string             `json:"campaign_id"`
+	Status            Status             `json:"status"`
+	StartedAt         time.Time          `json:"started_at"`
+	FinishedAt        time.Time          `json:"finished_at"`
+	HaltReason        string             `json:"halt_reason,omitempty"`
+	MaxDuration       time.Duration      `json:"max_duration"`
+	Executions        uint64             `json:"executions"`
+	PreviousActions   []string           `json:"previous_actions"`
+	ExposureKnowledge map[string]float64 `json:"exposure_knowledge"`
+	FailedAttempts    map[string]int     `json:"failed_attempts"`
+}
+
+// Snapshot serializes the campaign's complete execution state.
+func (c *Campaign) Snapshot() ([]byte, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return json.Marshal(campaignSnapshot{
+		Version:           SnapshotVersion,
+		CampaignID:        c.campaignID,
+		Status:            c.status,
+		StartedAt:         c.startedAt,
+		FinishedAt:        c.finishedAt,
+		HaltReason:        c.haltReason,
+		MaxDuration:       c.maxDuration,
+		Executions:        c.executions,
+		PreviousActions:   c.previousActions,
+		ExposureKnowledge: c.exposureKnowledge,
+		FailedAttempts:    c.failedAttempts,
+	})
+}
+
+// Restore rebuilds a campaign from a Snapshot.
+//
+// The restored campaign keeps its lifecycle status exactly:
+// halted stays halted, and running cannot be started again.
+func Restore(data []byte) (*Campaign, error) {
+	var snap campaignSnapshot
+	if err := json.Unmarshal(data, &snap); err != nil {
+		return nil, fmt.Errorf("campaign snapshot: %w", err)
+	}
+
+	if snap.Version < 1 || snap.Version > SnapshotVersion {
+		return nil, fmt.Errorf("campaign snapshot: unsupported version %d", snap.Version)
+	}
+
+	if snap.Status < StatusInitialized || snap.Status > StatusCompleted {
+		return nil, fmt.Errorf("campaign snapshot: unknown status %d", snap.Status)
+	}
+
+	if snap.MaxDuration < 0 {
+		return nil, errors.New("campaign snapshot: max duration must not be negative")
+	}
+
+	c, err := New(snap.CampaignID)
+	if err != nil {
+		return nil, fmt.Errorf("campaign snapshot: %w", err)
+	}
+
+	c.status = snap.Status
+	c.startedAt = snap.StartedAt
+	c.finishedAt = snap.FinishedAt
+	c.haltReason = snap.HaltReason
+	c.maxDuration = snap.MaxDuration
+	c.executions = snap.Executions
+	c.previousActions = append(c.previousActions, snap.PreviousActions...)
+	for k, v := range snap.ExposureKnowledge {
+		c.exposureKnowledge[k] = v
+	}
+	for k, v := range snap.FailedAttempts {
+		c.failedAttempts[k] = v
+	}
+
+	return c, nil
+}
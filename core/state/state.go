@@ -94,6 +94,16 @@ type Campaign struct {
 	// finishedAt records when execution ended.
 	finishedAt time.Time
 
+	// haltReason records why the campaign was halted.
+	haltReason string
+
+	// maxDuration bounds how long the campaign may run.
+	// Zero disables the limit.
+	maxDuration time.Duration
+
+	// now is the injectable clock used for all lifecycle timestamps.
+	now func() time.Time
+
 	// executions counts how many techniques were attempted.
 	executions uint64
 
@@ -125,9 +135,35 @@ func New(campaignID string) (*Campaign, error) {
 		previousActions:   make([]string, 0),
 		exposureKnowledge: make(map[string]float64),
 		failedAttempts:    make(map[string]int),
+		now:               func() time.Time { return time.Now().UTC() },
 	}, nil
 }
 
+// SetClock replaces the clock used for lifecycle timestamps
+// and the max-duration check.
+//
+// Intended for deterministic testing; nil restores the wall clock.
+func (c *Campaign) SetClock(now func() time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if now == nil {
+		now = func() time.Time { return time.Now().UTC() }
+	}
+	c.now = now
+}
+
+// SetMaxDuration installs a dead-man's switch.
+//
+// Once the campaign has run longer than d, the next
+// RecordExecution halts it with reason "max duration exceeded",
+// regardless of exposure. Zero disables the limit.
+func (c *Campaign) SetMaxDuration(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.maxDuration = d
+}
+
 // Start transitions the campaign into the running state.
 //
 // This MUST be called immediately before the first execution.
@@ -143,7 +179,7 @@ func (c *Campaign) Start() error {
 	}
 
 	c.status = StatusRunning
-	c.startedAt = time.Now().UTC()
+	c.startedAt = c.now()
 
 	return nil
 }
@@ -163,6 +199,11 @@ func (c *Campaign) RecordExecution() error {
 		)
 	}
 
+	if c.maxDuration > 0 && c.now().Sub(c.startedAt) > c.maxDuration {
+		c.haltLocked("max duration exceeded")
+		return errors.New("campaign halted: max duration exceeded")
+	}
+
 	c.executions++
 	return nil
 }
@@ -182,12 +223,26 @@ func (c *Campaign) Halt(reason string) error {
 		return nil // idempotent
 	}
 
-	c.status = StatusHalted
-	c.finishedAt = time.Now().UTC()
+	c.haltLocked(reason)
 
 	return nil
 }
 
+// haltLocked transitions to halted; the caller MUST hold mu.
+func (c *Campaign) haltLocked(reason string) {
+	c.status = StatusHalted
+	c.haltReason = reason
+	c.finishedAt = c.now()
+}
+
+// HaltReason returns why the campaign was halted.
+// Empty unless the campaign is halted.
+func (c *Campaign) HaltReason() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.haltReason
+}
+
 // Complete marks the campaign as successfully finished.
 //
 // This MUST be called only after all execution is done.
@@ -203,7 +258,7 @@ func (c *Campaign) Complete() error {
 	}
 
 	c.status = StatusCompleted
-	c.finishedAt = time.Now().UTC()
+	c.finishedAt = c.now()
 
 	return nil
 }
@@ -0,0 +1,107 @@
+package tests
+
+import (
+	"testing"
+	"time"
+
+	"vantage/core/state"
+)
+
+func TestCampaignHaltsAfterMaxDuration(t *testing.T) {
+	campaign, err := state.New("dead-mans-switch")
+	if err != nil {
+		t.Fatalf("state new: %v", err)
+	}
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	campaign.SetClock(func() time.Time { return now })
+	campaign.SetMaxDuration(5 * time.Minute)
+
+	if err := campaign.Start(); err != nil {
+		t.Fatalf("start: %v", err)
+	}
+	now = now.Add(4 * time.Minute)
+	if err := campaign.RecordExecution(); err != nil {
+		t.Fatalf("expected execution within max duration to be recorded: %v", err)
+	}
+
+	now = now.Add(2 * time.Minute)
+	if err := campaign.RecordExecution(); err == nil {
+		t.Fatalf("expected execution after max duration to be refused")
+	}
+	if campaign.Status() != state.StatusHalted {
+		t.Fatalf("expected halted campaign, got %s", campaign.Status())
+	}
+	if campaign.HaltReason() != "max duration exceeded" {
+		t.Fatalf("unexpected halt reason %q", campaign.HaltReason())
+	}
+	if campaign.Executions() != 1 {
+		t.Fatalf("expected refused execution to be uncounted, got %d", campaign.Executions())
+	}
+	if !campaign.FinishedAt().Equal(now) {
+		t.Fatalf("expected finish time from injected clock, got %s", campaign.FinishedAt())
+	}
+}
+
+func TestCampaignSnapshotRestoresExecutionStateFailClosed(t *testing.T) {
+	campaign, err := state.New("checkpoint")
+	if err != nil {
+		t.Fatalf("state new: %v", err)
+	}
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	campaign.SetClock(func() time.Time { return now })
+	campaign.SetMaxDuration(time.Hour)
+	if err := campaign.Start(); err != nil {
+		t.Fatalf("start: %v", err)
+	}
+	if err := campaign.RecordExecution(); err != nil {
+		t.Fatalf("record execution: %v", err)
+	}
+	campaign.RecordActionMemory("AC-01", true, true)
+	campaign.RecordActionMemory("AC-02", false, false)
+
+	raw, err := campaign.Snapshot()
+	if err != nil {
+		t.Fatalf("snapshot: %v", err)
+	}
+	restored, err := state.Restore(raw)
+	if err != nil {
+		t.Fatalf("restore: %v", err)
+	}
+	if restored.CampaignID() != "checkpoint" || restored.Status() != state.StatusRunning || restored.Executions() != 1 || !restored.StartedAt().Equal(now) {
+		t.Fatalf("expected running campaign with one execution, got %s/%s/%d/%s", restored.CampaignID(), restored.Status(), restored.Executions(), restored.StartedAt())
+	}
+	if actions := restored.PreviousActions(); len(actions) != 2 || actions[0] != "AC-01" || actions[1] != "AC-02" {
+		t.Fatalf("expected previous actions to round-trip, got %v", actions)
+	}
+	if restored.FailedAttempts("AC-02") != 1 || restored.ExposureKnowledge()["AC-01"] != 0.1 {
+		t.Fatalf("expected adaptation memory to round-trip, got failed=%d knowledge=%v", restored.FailedAttempts("AC-02"), restored.ExposureKnowledge())
+	}
+	if err := restored.Start(); err == nil {
+		t.Fatalf("expected a restored running campaign to refuse Start")
+	}
+
+	if err := campaign.Halt("operator stop"); err != nil {
+		t.Fatalf("halt: %v", err)
+	}
+	raw, err = campaign.Snapshot()
+	if err != nil {
+		t.Fatalf("snapshot: %v", err)
+	}
+	halted, err := state.Restore(raw)
+	if err != nil {
+		t.Fatalf("restore halted: %v", err)
+	}
+	if halted.Status() != state.StatusHalted || halted.HaltReason() != "operator stop" || !halted.FinishedAt().Equal(now) {
+		t.Fatalf("expected halted campaign to stay halted, got %s (%q)", halted.Status(), halted.HaltReason())
+	}
+	if err := halted.Start(); err == nil {
+		t.Fatalf("expected a restored halted campaign to refuse Start")
+	}
+	if err := halted.RecordExecution(); err == nil {
+		t.Fatalf("expected a restored halted campaign to refuse executions")
+	}
+
+	if _, err := state.Restore([]byte(`{"version":99,"campaign_id":"x"}`)); err == nil {
+		t.Fatalf("expected an unsupported snapshot version to be rejected")
+	}
+}
@@ -6,7 +6,11 @@ import (
 	"vantage/techniques/model"
 )
 
-type evalFn func(*model.Graph) bool
+// evalProfile pairs a relevance check with its declarative graph requirement.
+type evalProfile struct {
+	fn  func(*model.Graph) bool
+	req model.GraphRequirement
+}
 
 type profileTechnique struct {
 	id      string
@@ -14,40 +18,65 @@ type profileTechnique struct {
 	summary string
 	risk    float64
 	impact  float64
-	eval    evalFn
+	eval    evalProfile
 	classID string
+	// noise overrides the risk-derived noise profile when set.
+	noise model.NoiseProfile
 }
 
 func (t profileTechnique) ID() string                       { return t.id }
 func (t profileTechnique) Name() string                     { return t.name }
 func (t profileTechnique) ActionClassID() string            { return t.classID }
-func (t profileTechnique) Evaluate(graph *model.Graph) bool { return t.eval(graph) }
+func (t profileTechnique) Evaluate(graph *model.Graph) bool { return t.eval.fn(graph) }
 func (t profileTechnique) Execute(_ context.Context, _ *model.Graph) (model.Evidence, error) {
 	return model.Evidence{TechniqueID: t.ID(), Summary: t.summary, Success: true}, nil
 }
-func (t profileTechnique) RiskModifier() float64   { return t.risk }
-func (t profileTechnique) ImpactModifier() float64 { return t.impact }
+func (t profileTechnique) RiskModifier() float64                { return t.risk }
+func (t profileTechnique) ImpactModifier() float64              { return t.impact }
+func (t profileTechnique) Requirements() model.GraphRequirement { return t.eval.req }
+func (t profileTechnique) NoiseProfile() model.NoiseProfile {
+	if t.noise != "" {
+		return t.noise
+	}
+	return model.DefaultNoiseProfile(t.risk)
+}
 
 var (
-	evalMinimal    = func(g *model.Graph) bool { return g != nil && g.EvidenceNodes == 0 }
-	evalObserved   = func(g *model.Graph) bool { return g != nil && g.EvidenceNodes >= 1 }
-	evalPivot      = func(g *model.Graph) bool { return g != nil && g.EvidenceNodes >= 1 && g.HypothesisNodes >= 1 }
-	evalGraphPivot = func(g *model.Graph) bool {
-		return g != nil && g.TechniqueNodes >= 1 && (g.HasSupportsEdge || g.HasEnablesEdge)
+	evalMinimal = evalProfile{
+		fn:  func(g *model.Graph) bool { return g != nil && g.EvidenceNodes == 0 },
+		req: model.GraphRequirement{Profile: model.RequirementMinimal, RequiresNoEvidence: true},
+	}
+	evalObserved = evalProfile{
+		fn:  func(g *model.Graph) bool { return g != nil && g.EvidenceNodes >= 1 },
+		req: model.GraphRequirement{Profile: model.RequirementObserved, MinEvidenceNodes: 1},
+	}
+	evalPivot = evalProfile{
+		fn:  func(g *model.Graph) bool { return g != nil && g.EvidenceNodes >= 1 && g.HypothesisNodes >= 1 },
+		req: model.GraphRequirement{Profile: model.RequirementPivot, MinEvidenceNodes: 1, MinHypothesisNodes: 1},
+	}
+	evalGraphPivot = evalProfile{
+		fn: func(g *model.Graph) bool {
+			return g != nil && g.TechniqueNodes >= 1 && (g.HasSupportsEdge || g.HasEnablesEdge)
+		},
+		req: model.GraphRequirement{Profile: model.RequirementGraphPivot, MinTechniqueNodes: 1, RequiresPivotEdge: true},
 	}
-	evalRare = func(g *model.Graph) bool {
-		return g != nil && g.EvidenceNodes >= 2 && g.HypothesisNodes >= 2 && g.TechniqueNodes >= 1 && g.HasSupportsEdge && g.HasEnablesEdge
+	evalRare = evalProfile{
+		fn: func(g *model.Graph) bool {
+			return g != nil && g.EvidenceNodes >= 2 && g.HypothesisNodes >= 2 && g.TechniqueNodes >= 1 && g.HasSupportsEdge && g.HasEnablesEdge
+		},
+		req: model.GraphRequirement{Profile: model.RequirementRare, MinEvidenceNodes: 2, MinHypothesisNodes: 2, MinTechniqueNodes: 1, RequiresSupportsEdge: true, RequiresEnablesEdge: true},
 	}
 )
 
 // SurfaceProbe favors passive or low-touch checks to reduce operational risk while building baselines.
 // Risk profile: low confidence drift and low detection risk due to minimal interaction.
+// Noise profile: low-rate, since probing touches the target where passive collection does not.
 // Confidence rationale: high when little graph state exists because observations are easy to validate.
 // Expected behavior: seeds follow-on discovery classes with foundational evidence.
 type SurfaceProbe struct{}
 
 func (t SurfaceProbe) impl() profileTechnique {
-	return profileTechnique{id: "AC02SurfaceProbe", name: "SurfaceProbe", classID: "AC-02", summary: "probe target surface for reachable hosts and endpoints", risk: 0.18, impact: 0.28, eval: evalObserved}
+	return profileTechnique{id: "AC02SurfaceProbe", name: "SurfaceProbe", classID: "AC-02", summary: "probe target surface for reachable hosts and endpoints", risk: 0.18, impact: 0.28, eval: evalObserved, noise: model.NoiseLowRate}
 }
 func (t SurfaceProbe) ID() string                   { return t.impl().ID() }
 func (t SurfaceProbe) Name() string                 { return t.impl().Name() }
@@ -56,8 +85,10 @@ func (t SurfaceProbe) Evaluate(g *model.Graph) bool { return t.impl().Evaluate(g
 func (t SurfaceProbe) Execute(ctx context.Context, g *model.Graph) (model.Evidence, error) {
 	return t.impl().Execute(ctx, g)
 }
-func (t SurfaceProbe) RiskModifier() float64   { return t.impl().RiskModifier() }
-func (t SurfaceProbe) ImpactModifier() float64 { return t.impl().ImpactModifier() }
+func (t SurfaceProbe) RiskModifier() float64                { return t.impl().RiskModifier() }
+func (t SurfaceProbe) ImpactModifier() float64              { return t.impl().ImpactModifier() }
+func (t SurfaceProbe) NoiseProfile() model.NoiseProfile     { return t.impl().NoiseProfile() }
+func (t SurfaceProbe) Requirements() model.GraphRequirement { return t.impl().Requirements() }
 
 // AssetCensusSweep captures high-confidence low-impact context to enrich precision targeting later.
 // Risk profile: low risk and low footprint, suitable for steady-state reconnaissance.
@@ -75,8 +106,10 @@ func (t AssetCensusSweep) Evaluate(g *model.Graph) bool { return t.impl().Evalua
 func (t AssetCensusSweep) Execute(ctx context.Context, g *model.Graph) (model.Evidence, error) {
 	return t.impl().Execute(ctx, g)
 }
-func (t AssetCensusSweep) RiskModifier() float64   { return t.impl().RiskModifier() }
-func (t AssetCensusSweep) ImpactModifier() float64 { return t.impl().ImpactModifier() }
+func (t AssetCensusSweep) RiskModifier() float64                { return t.impl().RiskModifier() }
+func (t AssetCensusSweep) ImpactModifier() float64              { return t.impl().ImpactModifier() }
+func (t AssetCensusSweep) NoiseProfile() model.NoiseProfile     { return t.impl().NoiseProfile() }
+func (t AssetCensusSweep) Requirements() model.GraphRequirement { return t.impl().Requirements() }
 
 // InternetEdgeSampler is pivot-heavy and links multiple graph hints to expose chained opportunities.
 // Risk profile: medium risk due to directional probing that can trigger controls.
@@ -94,8 +127,10 @@ func (t InternetEdgeSampler) Evaluate(g *model.Graph) bool { return t.impl().Eva
 func (t InternetEdgeSampler) Execute(ctx context.Context, g *model.Graph) (model.Evidence, error) {
 	return t.impl().Execute(ctx, g)
 }
-func (t InternetEdgeSampler) RiskModifier() float64   { return t.impl().RiskModifier() }
-func (t InternetEdgeSampler) ImpactModifier() float64 { return t.impl().ImpactModifier() }
+func (t InternetEdgeSampler) RiskModifier() float64                { return t.impl().RiskModifier() }
+func (t InternetEdgeSampler) ImpactModifier() float64              { return t.impl().ImpactModifier() }
+func (t InternetEdgeSampler) NoiseProfile() model.NoiseProfile     { return t.impl().NoiseProfile() }
+func (t InternetEdgeSampler) Requirements() model.GraphRequirement { return t.impl().Requirements() }
 
 // AdjacentSubnetPivot is a second pivot behavior focused on graph-link validation before escalation.
 // Risk profile: medium-to-high because it exercises cross-node relationships.
@@ -113,8 +148,10 @@ func (t AdjacentSubnetPivot) Evaluate(g *model.Graph) bool { return t.impl().Eva
 func (t AdjacentSubnetPivot) Execute(ctx context.Context, g *model.Graph) (model.Evidence, error) {
 	return t.impl().Execute(ctx, g)
 }
-func (t AdjacentSubnetPivot) RiskModifier() float64   { return t.impl().RiskModifier() }
-func (t AdjacentSubnetPivot) ImpactModifier() float64 { return t.impl().ImpactModifier() }
+func (t AdjacentSubnetPivot) RiskModifier() float64                { return t.impl().RiskModifier() }
+func (t AdjacentSubnetPivot) ImpactModifier() float64              { return t.impl().ImpactModifier() }
+func (t AdjacentSubnetPivot) NoiseProfile() model.NoiseProfile     { return t.impl().NoiseProfile() }
+func (t AdjacentSubnetPivot) Requirements() model.GraphRequirement { return t.impl().Requirements() }
 
 // ShadowAssetPivot models low-confidence high-impact behavior intended for rare but decisive opportunities.
 // Risk profile: high operational and detection risk with potentially outsized downstream impact.
@@ -132,8 +169,10 @@ func (t ShadowAssetPivot) Evaluate(g *model.Graph) bool { return t.impl().Evalua
 func (t ShadowAssetPivot) Execute(ctx context.Context, g *model.Graph) (model.Evidence, error) {
 	return t.impl().Execute(ctx, g)
 }
-func (t ShadowAssetPivot) RiskModifier() float64   { return t.impl().RiskModifier() }
-func (t ShadowAssetPivot) ImpactModifier() float64 { return t.impl().ImpactModifier() }
+func (t ShadowAssetPivot) RiskModifier() float64                { return t.impl().RiskModifier() }
+func (t ShadowAssetPivot) ImpactModifier() float64              { return t.impl().ImpactModifier() }
+func (t ShadowAssetPivot) NoiseProfile() model.NoiseProfile     { return t.impl().NoiseProfile() }
+func (t ShadowAssetPivot) Requirements() model.GraphRequirement { return t.impl().Requirements() }
 
 // All returns the diversified technique set for AC-02.
 func All() []model.Technique {
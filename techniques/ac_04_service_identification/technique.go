@@ -6,7 +6,11 @@ import (
 	"vantage/techniques/model"
 )
 
-type evalFn func(*model.Graph) bool
+// evalProfile pairs a relevance check with its declarative graph requirement.
+type evalProfile struct {
+	fn  func(*model.Graph) bool
+	req model.GraphRequirement
+}
 
 type profileTechnique struct {
 	id      string
@@ -14,29 +18,53 @@ type profileTechnique struct {
 	summary string
 	risk    float64
 	impact  float64
-	eval    evalFn
+	eval    evalProfile
 	classID string
+	// noise overrides the risk-derived noise profile when set.
+	noise model.NoiseProfile
 }
 
 func (t profileTechnique) ID() string                       { return t.id }
 func (t profileTechnique) Name() string                     { return t.name }
 func (t profileTechnique) ActionClassID() string            { return t.classID }
-func (t profileTechnique) Evaluate(graph *model.Graph) bool { return t.eval(graph) }
+func (t profileTechnique) Evaluate(graph *model.Graph) bool { return t.eval.fn(graph) }
 func (t profileTechnique) Execute(_ context.Context, _ *model.Graph) (model.Evidence, error) {
 	return model.Evidence{TechniqueID: t.ID(), Summary: t.summary, Success: true}, nil
 }
-func (t profileTechnique) RiskModifier() float64   { return t.risk }
-func (t profileTechnique) ImpactModifier() float64 { return t.impact }
+func (t profileTechnique) RiskModifier() float64                { return t.risk }
+func (t profileTechnique) ImpactModifier() float64              { return t.impact }
+func (t profileTechnique) Requirements() model.GraphRequirement { return t.eval.req }
+func (t profileTechnique) NoiseProfile() model.NoiseProfile {
+	if t.noise != "" {
+		return t.noise
+	}
+	return model.DefaultNoiseProfile(t.risk)
+}
 
 var (
-	evalMinimal    = func(g *model.Graph) bool { return g != nil && g.EvidenceNodes == 0 }
-	evalObserved   = func(g *model.Graph) bool { return g != nil && g.EvidenceNodes >= 1 }
-	evalPivot      = func(g *model.Graph) bool { return g != nil && g.EvidenceNodes >= 1 && g.HypothesisNodes >= 1 }
-	evalGraphPivot = func(g *model.Graph) bool {
-		return g != nil && g.TechniqueNodes >= 1 && (g.HasSupportsEdge || g.HasEnablesEdge)
+	evalMinimal = evalProfile{
+		fn:  func(g *model.Graph) bool { return g != nil && g.EvidenceNodes == 0 },
+		req: model.GraphRequirement{Profile: model.RequirementMinimal, RequiresNoEvidence: true},
+	}
+	evalObserved = evalProfile{
+		fn:  func(g *model.Graph) bool { return g != nil && g.EvidenceNodes >= 1 },
+		req: model.GraphRequirement{Profile: model.RequirementObserved, MinEvidenceNodes: 1},
+	}
+	evalPivot = evalProfile{
+		fn:  func(g *model.Graph) bool { return g != nil && g.EvidenceNodes >= 1 && g.HypothesisNodes >= 1 },
+		req: model.GraphRequirement{Profile: model.RequirementPivot, MinEvidenceNodes: 1, MinHypothesisNodes: 1},
+	}
+	evalGraphPivot = evalProfile{
+		fn: func(g *model.Graph) bool {
+			return g != nil && g.TechniqueNodes >= 1 && (g.HasSupportsEdge || g.HasEnablesEdge)
+		},
+		req: model.GraphRequirement{Profile: model.RequirementGraphPivot, MinTechniqueNodes: 1, RequiresPivotEdge: true},
 	}
-	evalRare = func(g *model.Graph) bool {
-		return g != nil && g.EvidenceNodes >= 2 && g.HypothesisNodes >= 2 && g.TechniqueNodes >= 1 && g.HasSupportsEdge && g.HasEnablesEdge
+	evalRare = evalProfile{
+		fn: func(g *model.Graph) bool {
+			return g != nil && g.EvidenceNodes >= 2 && g.HypothesisNodes >= 2 && g.TechniqueNodes >= 1 && g.HasSupportsEdge && g.HasEnablesEdge
+		},
+		req: model.GraphRequirement{Profile: model.RequirementRare, MinEvidenceNodes: 2, MinHypothesisNodes: 2, MinTechniqueNodes: 1, RequiresSupportsEdge: true, RequiresEnablesEdge: true},
 	}
 )
 
@@ -56,8 +84,10 @@ func (t ServiceIdentifier) Evaluate(g *model.Graph) bool { return t.impl().Evalu
 func (t ServiceIdentifier) Execute(ctx context.Context, g *model.Graph) (model.Evidence, error) {
 	return t.impl().Execute(ctx, g)
 }
-func (t ServiceIdentifier) RiskModifier() float64   { return t.impl().RiskModifier() }
-func (t ServiceIdentifier) ImpactModifier() float64 { return t.impl().ImpactModifier() }
+func (t ServiceIdentifier) RiskModifier() float64                { return t.impl().RiskModifier() }
+func (t ServiceIdentifier) ImpactModifier() float64              { return t.impl().ImpactModifier() }
+func (t ServiceIdentifier) NoiseProfile() model.NoiseProfile     { return t.impl().NoiseProfile() }
+func (t ServiceIdentifier) Requirements() model.GraphRequirement { return t.impl().Requirements() }
 
 // BannerRoleMapper captures high-confidence low-impact context to enrich precision targeting later.
 // Risk profile: low risk and low footprint, suitable for steady-state reconnaissance.
@@ -75,8 +105,10 @@ func (t BannerRoleMapper) Evaluate(g *model.Graph) bool { return t.impl().Evalua
 func (t BannerRoleMapper) Execute(ctx context.Context, g *model.Graph) (model.Evidence, error) {
 	return t.impl().Execute(ctx, g)
 }
-func (t BannerRoleMapper) RiskModifier() float64   { return t.impl().RiskModifier() }
-func (t BannerRoleMapper) ImpactModifier() float64 { return t.impl().ImpactModifier() }
+func (t BannerRoleMapper) RiskModifier() float64                { return t.impl().RiskModifier() }
+func (t BannerRoleMapper) ImpactModifier() float64              { return t.impl().ImpactModifier() }
+func (t BannerRoleMapper) NoiseProfile() model.NoiseProfile     { return t.impl().NoiseProfile() }
+func (t BannerRoleMapper) Requirements() model.GraphRequirement { return t.impl().Requirements() }
 
 // PortRoleTriager is pivot-heavy and links multiple graph hints to expose chained opportunities.
 // Risk profile: medium risk due to directional probing that can trigger controls.
@@ -94,8 +126,10 @@ func (t PortRoleTriager) Evaluate(g *model.Graph) bool { return t.impl().Evaluat
 func (t PortRoleTriager) Execute(ctx context.Context, g *model.Graph) (model.Evidence, error) {
 	return t.impl().Execute(ctx, g)
 }
-func (t PortRoleTriager) RiskModifier() float64   { return t.impl().RiskModifier() }
-func (t PortRoleTriager) ImpactModifier() float64 { return t.impl().ImpactModifier() }
+func (t PortRoleTriager) RiskModifier() float64                { return t.impl().RiskModifier() }
+func (t PortRoleTriager) ImpactModifier() float64              { return t.impl().ImpactModifier() }
+func (t PortRoleTriager) NoiseProfile() model.NoiseProfile     { return t.impl().NoiseProfile() }
+func (t PortRoleTriager) Requirements() model.GraphRequirement { return t.impl().Requirements() }
 
 // ServiceDependencyPivot is a second pivot behavior focused on graph-link validation before escalation.
 // Risk profile: medium-to-high because it exercises cross-node relationships.
@@ -113,8 +147,10 @@ func (t ServiceDependencyPivot) Evaluate(g *model.Graph) bool { return t.impl().
 func (t ServiceDependencyPivot) Execute(ctx context.Context, g *model.Graph) (model.Evidence, error) {
 	return t.impl().Execute(ctx, g)
 }
-func (t ServiceDependencyPivot) RiskModifier() float64   { return t.impl().RiskModifier() }
-func (t ServiceDependencyPivot) ImpactModifier() float64 { return t.impl().ImpactModifier() }
+func (t ServiceDependencyPivot) RiskModifier() float64                { return t.impl().RiskModifier() }
+func (t ServiceDependencyPivot) ImpactModifier() float64              { return t.impl().ImpactModifier() }
+func (t ServiceDependencyPivot) NoiseProfile() model.NoiseProfile     { return t.impl().NoiseProfile() }
+func (t ServiceDependencyPivot) Requirements() model.GraphRequirement { return t.impl().Requirements() }
 
 // CrossTierBindingPivot models low-confidence high-impact behavior intended for rare but decisive opportunities.
 // Risk profile: high operational and detection risk with potentially outsized downstream impact.
@@ -132,8 +168,10 @@ func (t CrossTierBindingPivot) Evaluate(g *model.Graph) bool { return t.impl().E
 func (t CrossTierBindingPivot) Execute(ctx context.Context, g *model.Graph) (model.Evidence, error) {
 	return t.impl().Execute(ctx, g)
 }
-func (t CrossTierBindingPivot) RiskModifier() float64   { return t.impl().RiskModifier() }
-func (t CrossTierBindingPivot) ImpactModifier() float64 { return t.impl().ImpactModifier() }
+func (t CrossTierBindingPivot) RiskModifier() float64                { return t.impl().RiskModifier() }
+func (t CrossTierBindingPivot) ImpactModifier() float64              { return t.impl().ImpactModifier() }
+func (t CrossTierBindingPivot) NoiseProfile() model.NoiseProfile     { return t.impl().NoiseProfile() }
+func (t CrossTierBindingPivot) Requirements() model.GraphRequirement { return t.impl().Requirements() }
 
 // All returns the diversified technique set for AC-04.
 func All() []model.Technique {
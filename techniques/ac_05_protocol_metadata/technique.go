@@ -6,7 +6,11 @@ import (
 	"vantage/techniques/model"
 )
 
-type evalFn func(*model.Graph) bool
+// evalProfile pairs a relevance check with its declarative graph requirement.
+type evalProfile struct {
+	fn  func(*model.Graph) bool
+	req model.GraphRequirement
+}
 
 type profileTechnique struct {
 	id      string
@@ -14,29 +18,53 @@ type profileTechnique struct {
 	summary string
 	risk    float64
 	impact  float64
-	eval    evalFn
+	eval    evalProfile
 	classID string
+	// noise overrides the risk-derived noise profile when set.
+	noise model.NoiseProfile
 }
 
 func (t profileTechnique) ID() string                       { return t.id }
 func (t profileTechnique) Name() string                     { return t.name }
 func (t profileTechnique) ActionClassID() string            { return t.classID }
-func (t profileTechnique) Evaluate(graph *model.Graph) bool { return t.eval(graph) }
+func (t profileTechnique) Evaluate(graph *model.Graph) bool { return t.eval.fn(graph) }
 func (t profileTechnique) Execute(_ context.Context, _ *model.Graph) (model.Evidence, error) {
 	return model.Evidence{TechniqueID: t.ID(), Summary: t.summary, Success: true}, nil
 }
-func (t profileTechnique) RiskModifier() float64   { return t.risk }
-func (t profileTechnique) ImpactModifier() float64 { return t.impact }
+func (t profileTechnique) RiskModifier() float64                { return t.risk }
+func (t profileTechnique) ImpactModifier() float64              { return t.impact }
+func (t profileTechnique) Requirements() model.GraphRequirement { return t.eval.req }
+func (t profileTechnique) NoiseProfile() model.NoiseProfile {
+	if t.noise != "" {
+		return t.noise
+	}
+	return model.DefaultNoiseProfile(t.risk)
+}
 
 var (
-	evalMinimal    = func(g *model.Graph) bool { return g != nil && g.EvidenceNodes == 0 }
-	evalObserved   = func(g *model.Graph) bool { return g != nil && g.EvidenceNodes >= 1 }
-	evalPivot      = func(g *model.Graph) bool { return g != nil && g.EvidenceNodes >= 1 && g.HypothesisNodes >= 1 }
-	evalGraphPivot = func(g *model.Graph) bool {
-		return g != nil && g.TechniqueNodes >= 1 && (g.HasSupportsEdge || g.HasEnablesEdge)
+	evalMinimal = evalProfile{
+		fn:  func(g *model.Graph) bool { return g != nil && g.EvidenceNodes == 0 },
+		req: model.GraphRequirement{Profile: model.RequirementMinimal, RequiresNoEvidence: true},
+	}
+	evalObserved = evalProfile{
+		fn:  func(g *model.Graph) bool { return g != nil && g.EvidenceNodes >= 1 },
+		req: model.GraphRequirement{Profile: model.RequirementObserved, MinEvidenceNodes: 1},
+	}
+	evalPivot = evalProfile{
+		fn:  func(g *model.Graph) bool { return g != nil && g.EvidenceNodes >= 1 && g.HypothesisNodes >= 1 },
+		req: model.GraphRequirement{Profile: model.RequirementPivot, MinEvidenceNodes: 1, MinHypothesisNodes: 1},
 	}
-	evalRare = func(g *model.Graph) bool {
-		return g != nil && g.EvidenceNodes >= 2 && g.HypothesisNodes >= 2 && g.TechniqueNodes >= 1 && g.HasSupportsEdge && g.HasEnablesEdge
+	evalGraphPivot = evalProfile{
+		fn: func(g *model.Graph) bool {
+			return g != nil && g.TechniqueNodes >= 1 && (g.HasSupportsEdge || g.HasEnablesEdge)
+		},
+		req: model.GraphRequirement{Profile: model.RequirementGraphPivot, MinTechniqueNodes: 1, RequiresPivotEdge: true},
+	}
+	evalRare = evalProfile{
+		fn: func(g *model.Graph) bool {
+			return g != nil && g.EvidenceNodes >= 2 && g.HypothesisNodes >= 2 && g.TechniqueNodes >= 1 && g.HasSupportsEdge && g.HasEnablesEdge
+		},
+		req: model.GraphRequirement{Profile: model.RequirementRare, MinEvidenceNodes: 2, MinHypothesisNodes: 2, MinTechniqueNodes: 1, RequiresSupportsEdge: true, RequiresEnablesEdge: true},
 	}
 )
 
@@ -56,8 +84,12 @@ func (t ProtocolMetadataInspector) Evaluate(g *model.Graph) bool { return t.impl
 func (t ProtocolMetadataInspector) Execute(ctx context.Context, g *model.Graph) (model.Evidence, error) {
 	return t.impl().Execute(ctx, g)
 }
-func (t ProtocolMetadataInspector) RiskModifier() float64   { return t.impl().RiskModifier() }
-func (t ProtocolMetadataInspector) ImpactModifier() float64 { return t.impl().ImpactModifier() }
+func (t ProtocolMetadataInspector) RiskModifier() float64            { return t.impl().RiskModifier() }
+func (t ProtocolMetadataInspector) ImpactModifier() float64          { return t.impl().ImpactModifier() }
+func (t ProtocolMetadataInspector) NoiseProfile() model.NoiseProfile { return t.impl().NoiseProfile() }
+func (t ProtocolMetadataInspector) Requirements() model.GraphRequirement {
+	return t.impl().Requirements()
+}
 
 // HandshakeParameterAudit captures high-confidence low-impact context to enrich precision targeting later.
 // Risk profile: low risk and low footprint, suitable for steady-state reconnaissance.
@@ -75,8 +107,12 @@ func (t HandshakeParameterAudit) Evaluate(g *model.Graph) bool { return t.impl()
 func (t HandshakeParameterAudit) Execute(ctx context.Context, g *model.Graph) (model.Evidence, error) {
 	return t.impl().Execute(ctx, g)
 }
-func (t HandshakeParameterAudit) RiskModifier() float64   { return t.impl().RiskModifier() }
-func (t HandshakeParameterAudit) ImpactModifier() float64 { return t.impl().ImpactModifier() }
+func (t HandshakeParameterAudit) RiskModifier() float64            { return t.impl().RiskModifier() }
+func (t HandshakeParameterAudit) ImpactModifier() float64          { return t.impl().ImpactModifier() }
+func (t HandshakeParameterAudit) NoiseProfile() model.NoiseProfile { return t.impl().NoiseProfile() }
+func (t HandshakeParameterAudit) Requirements() model.GraphRequirement {
+	return t.impl().Requirements()
+}
 
 // CipherPreferenceSampler is pivot-heavy and links multiple graph hints to expose chained opportunities.
 // Risk profile: medium risk due to directional probing that can trigger controls.
@@ -94,8 +130,12 @@ func (t CipherPreferenceSampler) Evaluate(g *model.Graph) bool { return t.impl()
 func (t CipherPreferenceSampler) Execute(ctx context.Context, g *model.Graph) (model.Evidence, error) {
 	return t.impl().Execute(ctx, g)
 }
-func (t CipherPreferenceSampler) RiskModifier() float64   { return t.impl().RiskModifier() }
-func (t CipherPreferenceSampler) ImpactModifier() float64 { return t.impl().ImpactModifier() }
+func (t CipherPreferenceSampler) RiskModifier() float64            { return t.impl().RiskModifier() }
+func (t CipherPreferenceSampler) ImpactModifier() float64          { return t.impl().ImpactModifier() }
+func (t CipherPreferenceSampler) NoiseProfile() model.NoiseProfile { return t.impl().NoiseProfile() }
+func (t CipherPreferenceSampler) Requirements() model.GraphRequirement {
+	return t.impl().Requirements()
+}
 
 // ProtocolDowngradePivot is a second pivot behavior focused on graph-link validation before escalation.
 // Risk profile: medium-to-high because it exercises cross-node relationships.
@@ -113,8 +153,10 @@ func (t ProtocolDowngradePivot) Evaluate(g *model.Graph) bool { return t.impl().
 func (t ProtocolDowngradePivot) Execute(ctx context.Context, g *model.Graph) (model.Evidence, error) {
 	return t.impl().Execute(ctx, g)
 }
-func (t ProtocolDowngradePivot) RiskModifier() float64   { return t.impl().RiskModifier() }
-func (t ProtocolDowngradePivot) ImpactModifier() float64 { return t.impl().ImpactModifier() }
+func (t ProtocolDowngradePivot) RiskModifier() float64                { return t.impl().RiskModifier() }
+func (t ProtocolDowngradePivot) ImpactModifier() float64              { return t.impl().ImpactModifier() }
+func (t ProtocolDowngradePivot) NoiseProfile() model.NoiseProfile     { return t.impl().NoiseProfile() }
+func (t ProtocolDowngradePivot) Requirements() model.GraphRequirement { return t.impl().Requirements() }
 
 // MetadataLeakPivot models low-confidence high-impact behavior intended for rare but decisive opportunities.
 // Risk profile: high operational and detection risk with potentially outsized downstream impact.
@@ -132,8 +174,10 @@ func (t MetadataLeakPivot) Evaluate(g *model.Graph) bool { return t.impl().Evalu
 func (t MetadataLeakPivot) Execute(ctx context.Context, g *model.Graph) (model.Evidence, error) {
 	return t.impl().Execute(ctx, g)
 }
-func (t MetadataLeakPivot) RiskModifier() float64   { return t.impl().RiskModifier() }
-func (t MetadataLeakPivot) ImpactModifier() float64 { return t.impl().ImpactModifier() }
+func (t MetadataLeakPivot) RiskModifier() float64                { return t.impl().RiskModifier() }
+func (t MetadataLeakPivot) ImpactModifier() float64              { return t.impl().ImpactModifier() }
+func (t MetadataLeakPivot) NoiseProfile() model.NoiseProfile     { return t.impl().NoiseProfile() }
+func (t MetadataLeakPivot) Requirements() model.GraphRequirement { return t.impl().Requirements() }
 
 // All returns the diversified technique set for AC-05.
 func All() []model.Technique {
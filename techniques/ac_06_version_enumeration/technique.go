@@ -6,7 +6,11 @@ import (
 	"vantage/techniques/model"
 )
 
-type evalFn func(*model.Graph) bool
+// evalProfile pairs a relevance check with its declarative graph requirement.
+type evalProfile struct {
+	fn  func(*model.Graph) bool
+	req model.GraphRequirement
+}
 
 type profileTechnique struct {
 	id      string
@@ -14,29 +18,53 @@ type profileTechnique struct {
 	summary string
 	risk    float64
 	impact  float64
-	eval    evalFn
+	eval    evalProfile
 	classID string
+	// noise overrides the risk-derived noise profile when set.
+	noise model.NoiseProfile
 }
 
 func (t profileTechnique) ID() string                       { return t.id }
 func (t profileTechnique) Name() string                     { return t.name }
 func (t profileTechnique) ActionClassID() string            { return t.classID }
-func (t profileTechnique) Evaluate(graph *model.Graph) bool { return t.eval(graph) }
+func (t profileTechnique) Evaluate(graph *model.Graph) bool { return t.eval.fn(graph) }
 func (t profileTechnique) Execute(_ context.Context, _ *model.Graph) (model.Evidence, error) {
 	return model.Evidence{TechniqueID: t.ID(), Summary: t.summary, Success: true}, nil
 }
-func (t profileTechnique) RiskModifier() float64   { return t.risk }
-func (t profileTechnique) ImpactModifier() float64 { return t.impact }
+func (t profileTechnique) RiskModifier() float64                { return t.risk }
+func (t profileTechnique) ImpactModifier() float64              { return t.impact }
+func (t profileTechnique) Requirements() model.GraphRequirement { return t.eval.req }
+func (t profileTechnique) NoiseProfile() model.NoiseProfile {
+	if t.noise != "" {
+		return t.noise
+	}
+	return model.DefaultNoiseProfile(t.risk)
+}
 
 var (
-	evalMinimal    = func(g *model.Graph) bool { return g != nil && g.EvidenceNodes == 0 }
-	evalObserved   = func(g *model.Graph) bool { return g != nil && g.EvidenceNodes >= 1 }
-	evalPivot      = func(g *model.Graph) bool { return g != nil && g.EvidenceNodes >= 1 && g.HypothesisNodes >= 1 }
-	evalGraphPivot = func(g *model.Graph) bool {
-		return g != nil && g.TechniqueNodes >= 1 && (g.HasSupportsEdge || g.HasEnablesEdge)
+	evalMinimal = evalProfile{
+		fn:  func(g *model.Graph) bool { return g != nil && g.EvidenceNodes == 0 },
+		req: model.GraphRequirement{Profile: model.RequirementMinimal, RequiresNoEvidence: true},
+	}
+	evalObserved = evalProfile{
+		fn:  func(g *model.Graph) bool { return g != nil && g.EvidenceNodes >= 1 },
+		req: model.GraphRequirement{Profile: model.RequirementObserved, MinEvidenceNodes: 1},
 	}
-	evalRare = func(g *model.Graph) bool {
-		return g != nil && g.EvidenceNodes >= 2 && g.HypothesisNodes >= 2 && g.TechniqueNodes >= 1 && g.HasSupportsEdge && g.HasEnablesEdge
+	evalPivot = evalProfile{
+		fn:  func(g *model.Graph) bool { return g != nil && g.EvidenceNodes >= 1 && g.HypothesisNodes >= 1 },
+		req: model.GraphRequirement{Profile: model.RequirementPivot, MinEvidenceNodes: 1, MinHypothesisNodes: 1},
+	}
+	evalGraphPivot = evalProfile{
+		fn: func(g *model.Graph) bool {
+			return g != nil && g.TechniqueNodes >= 1 && (g.HasSupportsEdge || g.HasEnablesEdge)
+		},
+		req: model.GraphRequirement{Profile: model.RequirementGraphPivot, MinTechniqueNodes: 1, RequiresPivotEdge: true},
+	}
+	evalRare = evalProfile{
+		fn: func(g *model.Graph) bool {
+			return g != nil && g.EvidenceNodes >= 2 && g.HypothesisNodes >= 2 && g.TechniqueNodes >= 1 && g.HasSupportsEdge && g.HasEnablesEdge
+		},
+		req: model.GraphRequirement{Profile: model.RequirementRare, MinEvidenceNodes: 2, MinHypothesisNodes: 2, MinTechniqueNodes: 1, RequiresSupportsEdge: true, RequiresEnablesEdge: true},
 	}
 )
 
@@ -56,8 +84,10 @@ func (t VersionEnumerator) Evaluate(g *model.Graph) bool { return t.impl().Evalu
 func (t VersionEnumerator) Execute(ctx context.Context, g *model.Graph) (model.Evidence, error) {
 	return t.impl().Execute(ctx, g)
 }
-func (t VersionEnumerator) RiskModifier() float64   { return t.impl().RiskModifier() }
-func (t VersionEnumerator) ImpactModifier() float64 { return t.impl().ImpactModifier() }
+func (t VersionEnumerator) RiskModifier() float64                { return t.impl().RiskModifier() }
+func (t VersionEnumerator) ImpactModifier() float64              { return t.impl().ImpactModifier() }
+func (t VersionEnumerator) NoiseProfile() model.NoiseProfile     { return t.impl().NoiseProfile() }
+func (t VersionEnumerator) Requirements() model.GraphRequirement { return t.impl().Requirements() }
 
 // PatchCadenceSnapshot captures high-confidence low-impact context to enrich precision targeting later.
 // Risk profile: low risk and low footprint, suitable for steady-state reconnaissance.
@@ -75,8 +105,10 @@ func (t PatchCadenceSnapshot) Evaluate(g *model.Graph) bool { return t.impl().Ev
 func (t PatchCadenceSnapshot) Execute(ctx context.Context, g *model.Graph) (model.Evidence, error) {
 	return t.impl().Execute(ctx, g)
 }
-func (t PatchCadenceSnapshot) RiskModifier() float64   { return t.impl().RiskModifier() }
-func (t PatchCadenceSnapshot) ImpactModifier() float64 { return t.impl().ImpactModifier() }
+func (t PatchCadenceSnapshot) RiskModifier() float64                { return t.impl().RiskModifier() }
+func (t PatchCadenceSnapshot) ImpactModifier() float64              { return t.impl().ImpactModifier() }
+func (t PatchCadenceSnapshot) NoiseProfile() model.NoiseProfile     { return t.impl().NoiseProfile() }
+func (t PatchCadenceSnapshot) Requirements() model.GraphRequirement { return t.impl().Requirements() }
 
 // BuildFingerprintSampler is pivot-heavy and links multiple graph hints to expose chained opportunities.
 // Risk profile: medium risk due to directional probing that can trigger controls.
@@ -94,8 +126,12 @@ func (t BuildFingerprintSampler) Evaluate(g *model.Graph) bool { return t.impl()
 func (t BuildFingerprintSampler) Execute(ctx context.Context, g *model.Graph) (model.Evidence, error) {
 	return t.impl().Execute(ctx, g)
 }
-func (t BuildFingerprintSampler) RiskModifier() float64   { return t.impl().RiskModifier() }
-func (t BuildFingerprintSampler) ImpactModifier() float64 { return t.impl().ImpactModifier() }
+func (t BuildFingerprintSampler) RiskModifier() float64            { return t.impl().RiskModifier() }
+func (t BuildFingerprintSampler) ImpactModifier() float64          { return t.impl().ImpactModifier() }
+func (t BuildFingerprintSampler) NoiseProfile() model.NoiseProfile { return t.impl().NoiseProfile() }
+func (t BuildFingerprintSampler) Requirements() model.GraphRequirement {
+	return t.impl().Requirements()
+}
 
 // ChangelogPivot is a second pivot behavior focused on graph-link validation before escalation.
 // Risk profile: medium-to-high because it exercises cross-node relationships.
@@ -113,8 +149,10 @@ func (t ChangelogPivot) Evaluate(g *model.Graph) bool { return t.impl().Evaluate
 func (t ChangelogPivot) Execute(ctx context.Context, g *model.Graph) (model.Evidence, error) {
 	return t.impl().Execute(ctx, g)
 }
-func (t ChangelogPivot) RiskModifier() float64   { return t.impl().RiskModifier() }
-func (t ChangelogPivot) ImpactModifier() float64 { return t.impl().ImpactModifier() }
+func (t ChangelogPivot) RiskModifier() float64                { return t.impl().RiskModifier() }
+func (t ChangelogPivot) ImpactModifier() float64              { return t.impl().ImpactModifier() }
+func (t ChangelogPivot) NoiseProfile() model.NoiseProfile     { return t.impl().NoiseProfile() }
+func (t ChangelogPivot) Requirements() model.GraphRequirement { return t.impl().Requirements() }
 
 // DependencyLineagePivot models low-confidence high-impact behavior intended for rare but decisive opportunities.
 // Risk profile: high operational and detection risk with potentially outsized downstream impact.
@@ -132,8 +170,10 @@ func (t DependencyLineagePivot) Evaluate(g *model.Graph) bool { return t.impl().
 func (t DependencyLineagePivot) Execute(ctx context.Context, g *model.Graph) (model.Evidence, error) {
 	return t.impl().Execute(ctx, g)
 }
-func (t DependencyLineagePivot) RiskModifier() float64   { return t.impl().RiskModifier() }
-func (t DependencyLineagePivot) ImpactModifier() float64 { return t.impl().ImpactModifier() }
+func (t DependencyLineagePivot) RiskModifier() float64                { return t.impl().RiskModifier() }
+func (t DependencyLineagePivot) ImpactModifier() float64              { return t.impl().ImpactModifier() }
+func (t DependencyLineagePivot) NoiseProfile() model.NoiseProfile     { return t.impl().NoiseProfile() }
+func (t DependencyLineagePivot) Requirements() model.GraphRequirement { return t.impl().Requirements() }
 
 // All returns the diversified technique set for AC-06.
 func All() []model.Technique {
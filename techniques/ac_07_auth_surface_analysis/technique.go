@@ -6,7 +6,11 @@ import (
 	"vantage/techniques/model"
 )
 
-type evalFn func(*model.Graph) bool
+// evalProfile pairs a relevance check with its declarative graph requirement.
+type evalProfile struct {
+	fn  func(*model.Graph) bool
+	req model.GraphRequirement
+}
 
 type profileTechnique struct {
 	id      string
@@ -14,29 +18,53 @@ type profileTechnique struct {
 	summary string
 	risk    float64
 	impact  float64
-	eval    evalFn
+	eval    evalProfile
 	classID string
+	// noise overrides the risk-derived noise profile when set.
+	noise model.NoiseProfile
 }
 
 func (t profileTechnique) ID() string                       { return t.id }
 func (t profileTechnique) Name() string                     { return t.name }
 func (t profileTechnique) ActionClassID() string            { return t.classID }
-func (t profileTechnique) Evaluate(graph *model.Graph) bool { return t.eval(graph) }
+func (t profileTechnique) Evaluate(graph *model.Graph) bool { return t.eval.fn(graph) }
 func (t profileTechnique) Execute(_ context.Context, _ *model.Graph) (model.Evidence, error) {
 	return model.Evidence{TechniqueID: t.ID(), Summary: t.summary, Success: true}, nil
 }
-func (t profileTechnique) RiskModifier() float64   { return t.risk }
-func (t profileTechnique) ImpactModifier() float64 { return t.impact }
+func (t profileTechnique) RiskModifier() float64                { return t.risk }
+func (t profileTechnique) ImpactModifier() float64              { return t.impact }
+func (t profileTechnique) Requirements() model.GraphRequirement { return t.eval.req }
+func (t profileTechnique) NoiseProfile() model.NoiseProfile {
+	if t.noise != "" {
+		return t.noise
+	}
+	return model.DefaultNoiseProfile(t.risk)
+}
 
 var (
-	evalMinimal    = func(g *model.Graph) bool { return g != nil && g.EvidenceNodes == 0 }
-	evalObserved   = func(g *model.Graph) bool { return g != nil && g.EvidenceNodes >= 1 }
-	evalPivot      = func(g *model.Graph) bool { return g != nil && g.EvidenceNodes >= 1 && g.HypothesisNodes >= 1 }
-	evalGraphPivot = func(g *model.Graph) bool {
-		return g != nil && g.TechniqueNodes >= 1 && (g.HasSupportsEdge || g.HasEnablesEdge)
+	evalMinimal = evalProfile{
+		fn:  func(g *model.Graph) bool { return g != nil && g.EvidenceNodes == 0 },
+		req: model.GraphRequirement{Profile: model.RequirementMinimal, RequiresNoEvidence: true},
+	}
+	evalObserved = evalProfile{
+		fn:  func(g *model.Graph) bool { return g != nil && g.EvidenceNodes >= 1 },
+		req: model.GraphRequirement{Profile: model.RequirementObserved, MinEvidenceNodes: 1},
 	}
-	evalRare = func(g *model.Graph) bool {
-		return g != nil && g.EvidenceNodes >= 2 && g.HypothesisNodes >= 2 && g.TechniqueNodes >= 1 && g.HasSupportsEdge && g.HasEnablesEdge
+	evalPivot = evalProfile{
+		fn:  func(g *model.Graph) bool { return g != nil && g.EvidenceNodes >= 1 && g.HypothesisNodes >= 1 },
+		req: model.GraphRequirement{Profile: model.RequirementPivot, MinEvidenceNodes: 1, MinHypothesisNodes: 1},
+	}
+	evalGraphPivot = evalProfile{
+		fn: func(g *model.Graph) bool {
+			return g != nil && g.TechniqueNodes >= 1 && (g.HasSupportsEdge || g.HasEnablesEdge)
+		},
+		req: model.GraphRequirement{Profile: model.RequirementGraphPivot, MinTechniqueNodes: 1, RequiresPivotEdge: true},
+	}
+	evalRare = evalProfile{
+		fn: func(g *model.Graph) bool {
+			return g != nil && g.EvidenceNodes >= 2 && g.HypothesisNodes >= 2 && g.TechniqueNodes >= 1 && g.HasSupportsEdge && g.HasEnablesEdge
+		},
+		req: model.GraphRequirement{Profile: model.RequirementRare, MinEvidenceNodes: 2, MinHypothesisNodes: 2, MinTechniqueNodes: 1, RequiresSupportsEdge: true, RequiresEnablesEdge: true},
 	}
 )
 
@@ -56,8 +84,10 @@ func (t AuthSurfaceAnalyzer) Evaluate(g *model.Graph) bool { return t.impl().Eva
 func (t AuthSurfaceAnalyzer) Execute(ctx context.Context, g *model.Graph) (model.Evidence, error) {
 	return t.impl().Execute(ctx, g)
 }
-func (t AuthSurfaceAnalyzer) RiskModifier() float64   { return t.impl().RiskModifier() }
-func (t AuthSurfaceAnalyzer) ImpactModifier() float64 { return t.impl().ImpactModifier() }
+func (t AuthSurfaceAnalyzer) RiskModifier() float64                { return t.impl().RiskModifier() }
+func (t AuthSurfaceAnalyzer) ImpactModifier() float64              { return t.impl().ImpactModifier() }
+func (t AuthSurfaceAnalyzer) NoiseProfile() model.NoiseProfile     { return t.impl().NoiseProfile() }
+func (t AuthSurfaceAnalyzer) Requirements() model.GraphRequirement { return t.impl().Requirements() }
 
 // LoginFlowClassifier captures high-confidence low-impact context to enrich precision targeting later.
 // Risk profile: low risk and low footprint, suitable for steady-state reconnaissance.
@@ -75,8 +105,10 @@ func (t LoginFlowClassifier) Evaluate(g *model.Graph) bool { return t.impl().Eva
 func (t LoginFlowClassifier) Execute(ctx context.Context, g *model.Graph) (model.Evidence, error) {
 	return t.impl().Execute(ctx, g)
 }
-func (t LoginFlowClassifier) RiskModifier() float64   { return t.impl().RiskModifier() }
-func (t LoginFlowClassifier) ImpactModifier() float64 { return t.impl().ImpactModifier() }
+func (t LoginFlowClassifier) RiskModifier() float64                { return t.impl().RiskModifier() }
+func (t LoginFlowClassifier) ImpactModifier() float64              { return t.impl().ImpactModifier() }
+func (t LoginFlowClassifier) NoiseProfile() model.NoiseProfile     { return t.impl().NoiseProfile() }
+func (t LoginFlowClassifier) Requirements() model.GraphRequirement { return t.impl().Requirements() }
 
 // MFAChannelInventory is pivot-heavy and links multiple graph hints to expose chained opportunities.
 // Risk profile: medium risk due to directional probing that can trigger controls.
@@ -94,8 +126,10 @@ func (t MFAChannelInventory) Evaluate(g *model.Graph) bool { return t.impl().Eva
 func (t MFAChannelInventory) Execute(ctx context.Context, g *model.Graph) (model.Evidence, error) {
 	return t.impl().Execute(ctx, g)
 }
-func (t MFAChannelInventory) RiskModifier() float64   { return t.impl().RiskModifier() }
-func (t MFAChannelInventory) ImpactModifier() float64 { return t.impl().ImpactModifier() }
+func (t MFAChannelInventory) RiskModifier() float64                { return t.impl().RiskModifier() }
+func (t MFAChannelInventory) ImpactModifier() float64              { return t.impl().ImpactModifier() }
+func (t MFAChannelInventory) NoiseProfile() model.NoiseProfile     { return t.impl().NoiseProfile() }
+func (t MFAChannelInventory) Requirements() model.GraphRequirement { return t.impl().Requirements() }
 
 // SessionBoundaryPivot is a second pivot behavior focused on graph-link validation before escalation.
 // Risk profile: medium-to-high because it exercises cross-node relationships.
@@ -113,8 +147,10 @@ func (t SessionBoundaryPivot) Evaluate(g *model.Graph) bool { return t.impl().Ev
 func (t SessionBoundaryPivot) Execute(ctx context.Context, g *model.Graph) (model.Evidence, error) {
 	return t.impl().Execute(ctx, g)
 }
-func (t SessionBoundaryPivot) RiskModifier() float64   { return t.impl().RiskModifier() }
-func (t SessionBoundaryPivot) ImpactModifier() float64 { return t.impl().ImpactModifier() }
+func (t SessionBoundaryPivot) RiskModifier() float64                { return t.impl().RiskModifier() }
+func (t SessionBoundaryPivot) ImpactModifier() float64              { return t.impl().ImpactModifier() }
+func (t SessionBoundaryPivot) NoiseProfile() model.NoiseProfile     { return t.impl().NoiseProfile() }
+func (t SessionBoundaryPivot) Requirements() model.GraphRequirement { return t.impl().Requirements() }
 
 // IdentityFederationPivot models low-confidence high-impact behavior intended for rare but decisive opportunities.
 // Risk profile: high operational and detection risk with potentially outsized downstream impact.
@@ -132,8 +168,12 @@ func (t IdentityFederationPivot) Evaluate(g *model.Graph) bool { return t.impl()
 func (t IdentityFederationPivot) Execute(ctx context.Context, g *model.Graph) (model.Evidence, error) {
 	return t.impl().Execute(ctx, g)
 }
-func (t IdentityFederationPivot) RiskModifier() float64   { return t.impl().RiskModifier() }
-func (t IdentityFederationPivot) ImpactModifier() float64 { return t.impl().ImpactModifier() }
+func (t IdentityFederationPivot) RiskModifier() float64            { return t.impl().RiskModifier() }
+func (t IdentityFederationPivot) ImpactModifier() float64          { return t.impl().ImpactModifier() }
+func (t IdentityFederationPivot) NoiseProfile() model.NoiseProfile { return t.impl().NoiseProfile() }
+func (t IdentityFederationPivot) Requirements() model.GraphRequirement {
+	return t.impl().Requirements()
+}
 
 // All returns the diversified technique set for AC-07.
 func All() []model.Technique {
@@ -6,7 +6,11 @@ import (
 	"vantage/techniques/model"
 )
 
-type evalFn func(*model.Graph) bool
+// evalProfile pairs a relevance check with its declarative graph requirement.
+type evalProfile struct {
+	fn  func(*model.Graph) bool
+	req model.GraphRequirement
+}
 
 type profileTechnique struct {
 	id      string
@@ -14,29 +18,53 @@ type profileTechnique struct {
 	summary string
 	risk    float64
 	impact  float64
-	eval    evalFn
+	eval    evalProfile
 	classID string
+	// noise overrides the risk-derived noise profile when set.
+	noise model.NoiseProfile
 }
 
 func (t profileTechnique) ID() string                       { return t.id }
 func (t profileTechnique) Name() string                     { return t.name }
 func (t profileTechnique) ActionClassID() string            { return t.classID }
-func (t profileTechnique) Evaluate(graph *model.Graph) bool { return t.eval(graph) }
+func (t profileTechnique) Evaluate(graph *model.Graph) bool { return t.eval.fn(graph) }
 func (t profileTechnique) Execute(_ context.Context, _ *model.Graph) (model.Evidence, error) {
 	return model.Evidence{TechniqueID: t.ID(), Summary: t.summary, Success: true}, nil
 }
-func (t profileTechnique) RiskModifier() float64   { return t.risk }
-func (t profileTechnique) ImpactModifier() float64 { return t.impact }
+func (t profileTechnique) RiskModifier() float64                { return t.risk }
+func (t profileTechnique) ImpactModifier() float64              { return t.impact }
+func (t profileTechnique) Requirements() model.GraphRequirement { return t.eval.req }
+func (t profileTechnique) NoiseProfile() model.NoiseProfile {
+	if t.noise != "" {
+		return t.noise
+	}
+	return model.DefaultNoiseProfile(t.risk)
+}
 
 var (
-	evalMinimal    = func(g *model.Graph) bool { return g != nil && g.EvidenceNodes == 0 }
-	evalObserved   = func(g *model.Graph) bool { return g != nil && g.EvidenceNodes >= 1 }
-	evalPivot      = func(g *model.Graph) bool { return g != nil && g.EvidenceNodes >= 1 && g.HypothesisNodes >= 1 }
-	evalGraphPivot = func(g *model.Graph) bool {
-		return g != nil && g.TechniqueNodes >= 1 && (g.HasSupportsEdge || g.HasEnablesEdge)
+	evalMinimal = evalProfile{
+		fn:  func(g *model.Graph) bool { return g != nil && g.EvidenceNodes == 0 },
+		req: model.GraphRequirement{Profile: model.RequirementMinimal, RequiresNoEvidence: true},
+	}
+	evalObserved = evalProfile{
+		fn:  func(g *model.Graph) bool { return g != nil && g.EvidenceNodes >= 1 },
+		req: model.GraphRequirement{Profile: model.RequirementObserved, MinEvidenceNodes: 1},
 	}
-	evalRare = func(g *model.Graph) bool {
-		return g != nil && g.EvidenceNodes >= 2 && g.HypothesisNodes >= 2 && g.TechniqueNodes >= 1 && g.HasSupportsEdge && g.HasEnablesEdge
+	evalPivot = evalProfile{
+		fn:  func(g *model.Graph) bool { return g != nil && g.EvidenceNodes >= 1 && g.HypothesisNodes >= 1 },
+		req: model.GraphRequirement{Profile: model.RequirementPivot, MinEvidenceNodes: 1, MinHypothesisNodes: 1},
+	}
+	evalGraphPivot = evalProfile{
+		fn: func(g *model.Graph) bool {
+			return g != nil && g.TechniqueNodes >= 1 && (g.HasSupportsEdge || g.HasEnablesEdge)
+		},
+		req: model.GraphRequirement{Profile: model.RequirementGraphPivot, MinTechniqueNodes: 1, RequiresPivotEdge: true},
+	}
+	evalRare = evalProfile{
+		fn: func(g *model.Graph) bool {
+			return g != nil && g.EvidenceNodes >= 2 && g.HypothesisNodes >= 2 && g.TechniqueNodes >= 1 && g.HasSupportsEdge && g.HasEnablesEdge
+		},
+		req: model.GraphRequirement{Profile: model.RequirementRare, MinEvidenceNodes: 2, MinHypothesisNodes: 2, MinTechniqueNodes: 1, RequiresSupportsEdge: true, RequiresEnablesEdge: true},
 	}
 )
 
@@ -56,8 +84,10 @@ func (t CredentialValidator) Evaluate(g *model.Graph) bool { return t.impl().Eva
 func (t CredentialValidator) Execute(ctx context.Context, g *model.Graph) (model.Evidence, error) {
 	return t.impl().Execute(ctx, g)
 }
-func (t CredentialValidator) RiskModifier() float64   { return t.impl().RiskModifier() }
-func (t CredentialValidator) ImpactModifier() float64 { return t.impl().ImpactModifier() }
+func (t CredentialValidator) RiskModifier() float64                { return t.impl().RiskModifier() }
+func (t CredentialValidator) ImpactModifier() float64              { return t.impl().ImpactModifier() }
+func (t CredentialValidator) NoiseProfile() model.NoiseProfile     { return t.impl().NoiseProfile() }
+func (t CredentialValidator) Requirements() model.GraphRequirement { return t.impl().Requirements() }
 
 // CredentialFormatLint captures high-confidence low-impact context to enrich precision targeting later.
 // Risk profile: low risk and low footprint, suitable for steady-state reconnaissance.
@@ -75,8 +105,10 @@ func (t CredentialFormatLint) Evaluate(g *model.Graph) bool { return t.impl().Ev
 func (t CredentialFormatLint) Execute(ctx context.Context, g *model.Graph) (model.Evidence, error) {
 	return t.impl().Execute(ctx, g)
 }
-func (t CredentialFormatLint) RiskModifier() float64   { return t.impl().RiskModifier() }
-func (t CredentialFormatLint) ImpactModifier() float64 { return t.impl().ImpactModifier() }
+func (t CredentialFormatLint) RiskModifier() float64                { return t.impl().RiskModifier() }
+func (t CredentialFormatLint) ImpactModifier() float64              { return t.impl().ImpactModifier() }
+func (t CredentialFormatLint) NoiseProfile() model.NoiseProfile     { return t.impl().NoiseProfile() }
+func (t CredentialFormatLint) Requirements() model.GraphRequirement { return t.impl().Requirements() }
 
 // LowRateCredentialCheck is pivot-heavy and links multiple graph hints to expose chained opportunities.
 // Risk profile: medium risk due to directional probing that can trigger controls.
@@ -85,7 +117,7 @@ func (t CredentialFormatLint) ImpactModifier() float64 { return t.impl().ImpactM
 type LowRateCredentialCheck struct{}
 
 func (t LowRateCredentialCheck) impl() profileTechnique {
-	return profileTechnique{id: "AC08LowRateCredentialCheck", name: "LowRateCredentialCheck", classID: "AC-08", summary: "connect mid-stage observations into pivotable pathways", risk: 0.52, impact: 0.58, eval: evalPivot}
+	return profileTechnique{id: "AC08LowRateCredentialCheck", name: "LowRateCredentialCheck", classID: "AC-08", summary: "connect mid-stage observations into pivotable pathways", risk: 0.52, impact: 0.58, eval: evalPivot, noise: model.NoiseLowRate}
 }
 func (t LowRateCredentialCheck) ID() string                   { return t.impl().ID() }
 func (t LowRateCredentialCheck) Name() string                 { return t.impl().Name() }
@@ -94,8 +126,10 @@ func (t LowRateCredentialCheck) Evaluate(g *model.Graph) bool { return t.impl().
 func (t LowRateCredentialCheck) Execute(ctx context.Context, g *model.Graph) (model.Evidence, error) {
 	return t.impl().Execute(ctx, g)
 }
-func (t LowRateCredentialCheck) RiskModifier() float64   { return t.impl().RiskModifier() }
-func (t LowRateCredentialCheck) ImpactModifier() float64 { return t.impl().ImpactModifier() }
+func (t LowRateCredentialCheck) RiskModifier() float64                { return t.impl().RiskModifier() }
+func (t LowRateCredentialCheck) ImpactModifier() float64              { return t.impl().ImpactModifier() }
+func (t LowRateCredentialCheck) NoiseProfile() model.NoiseProfile     { return t.impl().NoiseProfile() }
+func (t LowRateCredentialCheck) Requirements() model.GraphRequirement { return t.impl().Requirements() }
 
 // PasswordReusePivot is a second pivot behavior focused on graph-link validation before escalation.
 // Risk profile: medium-to-high because it exercises cross-node relationships.
@@ -113,8 +147,10 @@ func (t PasswordReusePivot) Evaluate(g *model.Graph) bool { return t.impl().Eval
 func (t PasswordReusePivot) Execute(ctx context.Context, g *model.Graph) (model.Evidence, error) {
 	return t.impl().Execute(ctx, g)
 }
-func (t PasswordReusePivot) RiskModifier() float64   { return t.impl().RiskModifier() }
-func (t PasswordReusePivot) ImpactModifier() float64 { return t.impl().ImpactModifier() }
+func (t PasswordReusePivot) RiskModifier() float64                { return t.impl().RiskModifier() }
+func (t PasswordReusePivot) ImpactModifier() float64              { return t.impl().ImpactModifier() }
+func (t PasswordReusePivot) NoiseProfile() model.NoiseProfile     { return t.impl().NoiseProfile() }
+func (t PasswordReusePivot) Requirements() model.GraphRequirement { return t.impl().Requirements() }
 
 // FederatedCredentialPivot models low-confidence high-impact behavior intended for rare but decisive opportunities.
 // Risk profile: high operational and detection risk with potentially outsized downstream impact.
@@ -132,8 +168,12 @@ func (t FederatedCredentialPivot) Evaluate(g *model.Graph) bool { return t.impl(
 func (t FederatedCredentialPivot) Execute(ctx context.Context, g *model.Graph) (model.Evidence, error) {
 	return t.impl().Execute(ctx, g)
 }
-func (t FederatedCredentialPivot) RiskModifier() float64   { return t.impl().RiskModifier() }
-func (t FederatedCredentialPivot) ImpactModifier() float64 { return t.impl().ImpactModifier() }
+func (t FederatedCredentialPivot) RiskModifier() float64            { return t.impl().RiskModifier() }
+func (t FederatedCredentialPivot) ImpactModifier() float64          { return t.impl().ImpactModifier() }
+func (t FederatedCredentialPivot) NoiseProfile() model.NoiseProfile { return t.impl().NoiseProfile() }
+func (t FederatedCredentialPivot) Requirements() model.GraphRequirement {
+	return t.impl().Requirements()
+}
 
 // All returns the diversified technique set for AC-08.
 func All() []model.Technique {
@@ -6,7 +6,11 @@ import (
 	"vantage/techniques/model"
 )
 
-type evalFn func(*model.Graph) bool
+// evalProfile pairs a relevance check with its declarative graph requirement.
+type evalProfile struct {
+	fn  func(*model.Graph) bool
+	req model.GraphRequirement
+}
 
 type profileTechnique struct {
 	id      string
@@ -14,29 +18,53 @@ type profileTechnique struct {
 	summary string
 	risk    float64
 	impact  float64
-	eval    evalFn
+	eval    evalProfile
 	classID string
+	// noise overrides the risk-derived noise profile when set.
+	noise model.NoiseProfile
 }
 
 func (t profileTechnique) ID() string                       { return t.id }
 func (t profileTechnique) Name() string                     { return t.name }
 func (t profileTechnique) ActionClassID() string            { return t.classID }
-func (t profileTechnique) Evaluate(graph *model.Graph) bool { return t.eval(graph) }
+func (t profileTechnique) Evaluate(graph *model.Graph) bool { return t.eval.fn(graph) }
 func (t profileTechnique) Execute(_ context.Context, _ *model.Graph) (model.Evidence, error) {
 	return model.Evidence{TechniqueID: t.ID(), Summary: t.summary, Success: true}, nil
 }
-func (t profileTechnique) RiskModifier() float64   { return t.risk }
-func (t profileTechnique) ImpactModifier() float64 { return t.impact }
+func (t profileTechnique) RiskModifier() float64                { return t.risk }
+func (t profileTechnique) ImpactModifier() float64              { return t.impact }
+func (t profileTechnique) Requirements() model.GraphRequirement { return t.eval.req }
+func (t profileTechnique) NoiseProfile() model.NoiseProfile {
+	if t.noise != "" {
+		return t.noise
+	}
+	return model.DefaultNoiseProfile(t.risk)
+}
 
 var (
-	evalMinimal    = func(g *model.Graph) bool { return g != nil && g.EvidenceNodes == 0 }
-	evalObserved   = func(g *model.Graph) bool { return g != nil && g.EvidenceNodes >= 1 }
-	evalPivot      = func(g *model.Graph) bool { return g != nil && g.EvidenceNodes >= 1 && g.HypothesisNodes >= 1 }
-	evalGraphPivot = func(g *model.Graph) bool {
-		return g != nil && g.TechniqueNodes >= 1 && (g.HasSupportsEdge || g.HasEnablesEdge)
+	evalMinimal = evalProfile{
+		fn:  func(g *model.Graph) bool { return g != nil && g.EvidenceNodes == 0 },
+		req: model.GraphRequirement{Profile: model.RequirementMinimal, RequiresNoEvidence: true},
+	}
+	evalObserved = evalProfile{
+		fn:  func(g *model.Graph) bool { return g != nil && g.EvidenceNodes >= 1 },
+		req: model.GraphRequirement{Profile: model.RequirementObserved, MinEvidenceNodes: 1},
 	}
-	evalRare = func(g *model.Graph) bool {
-		return g != nil && g.EvidenceNodes >= 2 && g.HypothesisNodes >= 2 && g.TechniqueNodes >= 1 && g.HasSupportsEdge && g.HasEnablesEdge
+	evalPivot = evalProfile{
+		fn:  func(g *model.Graph) bool { return g != nil && g.EvidenceNodes >= 1 && g.HypothesisNodes >= 1 },
+		req: model.GraphRequirement{Profile: model.RequirementPivot, MinEvidenceNodes: 1, MinHypothesisNodes: 1},
+	}
+	evalGraphPivot = evalProfile{
+		fn: func(g *model.Graph) bool {
+			return g != nil && g.TechniqueNodes >= 1 && (g.HasSupportsEdge || g.HasEnablesEdge)
+		},
+		req: model.GraphRequirement{Profile: model.RequirementGraphPivot, MinTechniqueNodes: 1, RequiresPivotEdge: true},
+	}
+	evalRare = evalProfile{
+		fn: func(g *model.Graph) bool {
+			return g != nil && g.EvidenceNodes >= 2 && g.HypothesisNodes >= 2 && g.TechniqueNodes >= 1 && g.HasSupportsEdge && g.HasEnablesEdge
+		},
+		req: model.GraphRequirement{Profile: model.RequirementRare, MinEvidenceNodes: 2, MinHypothesisNodes: 2, MinTechniqueNodes: 1, RequiresSupportsEdge: true, RequiresEnablesEdge: true},
 	}
 )
 
@@ -56,8 +84,10 @@ func (t AccessEstablisher) Evaluate(g *model.Graph) bool { return t.impl().Evalu
 func (t AccessEstablisher) Execute(ctx context.Context, g *model.Graph) (model.Evidence, error) {
 	return t.impl().Execute(ctx, g)
 }
-func (t AccessEstablisher) RiskModifier() float64   { return t.impl().RiskModifier() }
-func (t AccessEstablisher) ImpactModifier() float64 { return t.impl().ImpactModifier() }
+func (t AccessEstablisher) RiskModifier() float64                { return t.impl().RiskModifier() }
+func (t AccessEstablisher) ImpactModifier() float64              { return t.impl().ImpactModifier() }
+func (t AccessEstablisher) NoiseProfile() model.NoiseProfile     { return t.impl().NoiseProfile() }
+func (t AccessEstablisher) Requirements() model.GraphRequirement { return t.impl().Requirements() }
 
 // LeastPrivilegeSessionBootstrap captures high-confidence low-impact context to enrich precision targeting later.
 // Risk profile: low risk and low footprint, suitable for steady-state reconnaissance.
@@ -77,6 +107,12 @@ func (t LeastPrivilegeSessionBootstrap) Execute(ctx context.Context, g *model.Gr
 }
 func (t LeastPrivilegeSessionBootstrap) RiskModifier() float64   { return t.impl().RiskModifier() }
 func (t LeastPrivilegeSessionBootstrap) ImpactModifier() float64 { return t.impl().ImpactModifier() }
+func (t LeastPrivilegeSessionBootstrap) NoiseProfile() model.NoiseProfile {
+	return t.impl().NoiseProfile()
+}
+func (t LeastPrivilegeSessionBootstrap) Requirements() model.GraphRequirement {
+	return t.impl().Requirements()
+}
 
 // EphemeralAccessTrial is pivot-heavy and links multiple graph hints to expose chained opportunities.
 // Risk profile: medium risk due to directional probing that can trigger controls.
@@ -94,8 +130,10 @@ func (t EphemeralAccessTrial) Evaluate(g *model.Graph) bool { return t.impl().Ev
 func (t EphemeralAccessTrial) Execute(ctx context.Context, g *model.Graph) (model.Evidence, error) {
 	return t.impl().Execute(ctx, g)
 }
-func (t EphemeralAccessTrial) RiskModifier() float64   { return t.impl().RiskModifier() }
-func (t EphemeralAccessTrial) ImpactModifier() float64 { return t.impl().ImpactModifier() }
+func (t EphemeralAccessTrial) RiskModifier() float64                { return t.impl().RiskModifier() }
+func (t EphemeralAccessTrial) ImpactModifier() float64              { return t.impl().ImpactModifier() }
+func (t EphemeralAccessTrial) NoiseProfile() model.NoiseProfile     { return t.impl().NoiseProfile() }
+func (t EphemeralAccessTrial) Requirements() model.GraphRequirement { return t.impl().Requirements() }
 
 // SessionReusePivot is a second pivot behavior focused on graph-link validation before escalation.
 // Risk profile: medium-to-high because it exercises cross-node relationships.
@@ -113,8 +151,10 @@ func (t SessionReusePivot) Evaluate(g *model.Graph) bool { return t.impl().Evalu
 func (t SessionReusePivot) Execute(ctx context.Context, g *model.Graph) (model.Evidence, error) {
 	return t.impl().Execute(ctx, g)
 }
-func (t SessionReusePivot) RiskModifier() float64   { return t.impl().RiskModifier() }
-func (t SessionReusePivot) ImpactModifier() float64 { return t.impl().ImpactModifier() }
+func (t SessionReusePivot) RiskModifier() float64                { return t.impl().RiskModifier() }
+func (t SessionReusePivot) ImpactModifier() float64              { return t.impl().ImpactModifier() }
+func (t SessionReusePivot) NoiseProfile() model.NoiseProfile     { return t.impl().NoiseProfile() }
+func (t SessionReusePivot) Requirements() model.GraphRequirement { return t.impl().Requirements() }
 
 // TrustPathPivot models low-confidence high-impact behavior intended for rare but decisive opportunities.
 // Risk profile: high operational and detection risk with potentially outsized downstream impact.
@@ -132,8 +172,10 @@ func (t TrustPathPivot) Evaluate(g *model.Graph) bool { return t.impl().Evaluate
 func (t TrustPathPivot) Execute(ctx context.Context, g *model.Graph) (model.Evidence, error) {
 	return t.impl().Execute(ctx, g)
 }
-func (t TrustPathPivot) RiskModifier() float64   { return t.impl().RiskModifier() }
-func (t TrustPathPivot) ImpactModifier() float64 { return t.impl().ImpactModifier() }
+func (t TrustPathPivot) RiskModifier() float64                { return t.impl().RiskModifier() }
+func (t TrustPathPivot) ImpactModifier() float64              { return t.impl().ImpactModifier() }
+func (t TrustPathPivot) NoiseProfile() model.NoiseProfile     { return t.impl().NoiseProfile() }
+func (t TrustPathPivot) Requirements() model.GraphRequirement { return t.impl().Requirements() }
 
 // All returns the diversified technique set for AC-09.
 func All() []model.Technique {
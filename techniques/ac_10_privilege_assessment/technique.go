@@ -6,7 +6,11 @@ import (
 	"vantage/techniques/model"
 )
 
-type evalFn func(*model.Graph) bool
+// evalProfile pairs a relevance check with its declarative graph requirement.
+type evalProfile struct {
+	fn  func(*model.Graph) bool
+	req model.GraphRequirement
+}
 
 type profileTechnique struct {
 	id      string
@@ -14,29 +18,53 @@ type profileTechnique struct {
 	summary string
 	risk    float64
 	impact  float64
-	eval    evalFn
+	eval    evalProfile
 	classID string
+	// noise overrides the risk-derived noise profile when set.
+	noise model.NoiseProfile
 }
 
 func (t profileTechnique) ID() string                       { return t.id }
 func (t profileTechnique) Name() string                     { return t.name }
 func (t profileTechnique) ActionClassID() string            { return t.classID }
-func (t profileTechnique) Evaluate(graph *model.Graph) bool { return t.eval(graph) }
+func (t profileTechnique) Evaluate(graph *model.Graph) bool { return t.eval.fn(graph) }
 func (t profileTechnique) Execute(_ context.Context, _ *model.Graph) (model.Evidence, error) {
 	return model.Evidence{TechniqueID: t.ID(), Summary: t.summary, Success: true}, nil
 }
-func (t profileTechnique) RiskModifier() float64   { return t.risk }
-func (t profileTechnique) ImpactModifier() float64 { return t.impact }
+func (t profileTechnique) RiskModifier() float64                { return t.risk }
+func (t profileTechnique) ImpactModifier() float64              { return t.impact }
+func (t profileTechnique) Requirements() model.GraphRequirement { return t.eval.req }
+func (t profileTechnique) NoiseProfile() model.NoiseProfile {
+	if t.noise != "" {
+		return t.noise
+	}
+	return model.DefaultNoiseProfile(t.risk)
+}
 
 var (
-	evalMinimal    = func(g *model.Graph) bool { return g != nil && g.EvidenceNodes == 0 }
-	evalObserved   = func(g *model.Graph) bool { return g != nil && g.EvidenceNodes >= 1 }
-	evalPivot      = func(g *model.Graph) bool { return g != nil && g.EvidenceNodes >= 1 && g.HypothesisNodes >= 1 }
-	evalGraphPivot = func(g *model.Graph) bool {
-		return g != nil && g.TechniqueNodes >= 1 && (g.HasSupportsEdge || g.HasEnablesEdge)
+	evalMinimal = evalProfile{
+		fn:  func(g *model.Graph) bool { return g != nil && g.EvidenceNodes == 0 },
+		req: model.GraphRequirement{Profile: model.RequirementMinimal, RequiresNoEvidence: true},
+	}
+	evalObserved = evalProfile{
+		fn:  func(g *model.Graph) bool { return g != nil && g.EvidenceNodes >= 1 },
+		req: model.GraphRequirement{Profile: model.RequirementObserved, MinEvidenceNodes: 1},
 	}
-	evalRare = func(g *model.Graph) bool {
-		return g != nil && g.EvidenceNodes >= 2 && g.HypothesisNodes >= 2 && g.TechniqueNodes >= 1 && g.HasSupportsEdge && g.HasEnablesEdge
+	evalPivot = evalProfile{
+		fn:  func(g *model.Graph) bool { return g != nil && g.EvidenceNodes >= 1 && g.HypothesisNodes >= 1 },
+		req: model.GraphRequirement{Profile: model.RequirementPivot, MinEvidenceNodes: 1, MinHypothesisNodes: 1},
+	}
+	evalGraphPivot = evalProfile{
+		fn: func(g *model.Graph) bool {
+			return g != nil && g.TechniqueNodes >= 1 && (g.HasSupportsEdge || g.HasEnablesEdge)
+		},
+		req: model.GraphRequirement{Profile: model.RequirementGraphPivot, MinTechniqueNodes: 1, RequiresPivotEdge: true},
+	}
+	evalRare = evalProfile{
+		fn: func(g *model.Graph) bool {
+			return g != nil && g.EvidenceNodes >= 2 && g.HypothesisNodes >= 2 && g.TechniqueNodes >= 1 && g.HasSupportsEdge && g.HasEnablesEdge
+		},
+		req: model.GraphRequirement{Profile: model.RequirementRare, MinEvidenceNodes: 2, MinHypothesisNodes: 2, MinTechniqueNodes: 1, RequiresSupportsEdge: true, RequiresEnablesEdge: true},
 	}
 )
 
@@ -56,8 +84,10 @@ func (t PrivilegeAssessor) Evaluate(g *model.Graph) bool { return t.impl().Evalu
 func (t PrivilegeAssessor) Execute(ctx context.Context, g *model.Graph) (model.Evidence, error) {
 	return t.impl().Execute(ctx, g)
 }
-func (t PrivilegeAssessor) RiskModifier() float64   { return t.impl().RiskModifier() }
-func (t PrivilegeAssessor) ImpactModifier() float64 { return t.impl().ImpactModifier() }
+func (t PrivilegeAssessor) RiskModifier() float64                { return t.impl().RiskModifier() }
+func (t PrivilegeAssessor) ImpactModifier() float64              { return t.impl().ImpactModifier() }
+func (t PrivilegeAssessor) NoiseProfile() model.NoiseProfile     { return t.impl().NoiseProfile() }
+func (t PrivilegeAssessor) Requirements() model.GraphRequirement { return t.impl().Requirements() }
 
 // RoleDriftSurvey captures high-confidence low-impact context to enrich precision targeting later.
 // Risk profile: low risk and low footprint, suitable for steady-state reconnaissance.
@@ -75,8 +105,10 @@ func (t RoleDriftSurvey) Evaluate(g *model.Graph) bool { return t.impl().Evaluat
 func (t RoleDriftSurvey) Execute(ctx context.Context, g *model.Graph) (model.Evidence, error) {
 	return t.impl().Execute(ctx, g)
 }
-func (t RoleDriftSurvey) RiskModifier() float64   { return t.impl().RiskModifier() }
-func (t RoleDriftSurvey) ImpactModifier() float64 { return t.impl().ImpactModifier() }
+func (t RoleDriftSurvey) RiskModifier() float64                { return t.impl().RiskModifier() }
+func (t RoleDriftSurvey) ImpactModifier() float64              { return t.impl().ImpactModifier() }
+func (t RoleDriftSurvey) NoiseProfile() model.NoiseProfile     { return t.impl().NoiseProfile() }
+func (t RoleDriftSurvey) Requirements() model.GraphRequirement { return t.impl().Requirements() }
 
 // EntitlementConsistencyCheck is pivot-heavy and links multiple graph hints to expose chained opportunities.
 // Risk profile: medium risk due to directional probing that can trigger controls.
@@ -96,6 +128,12 @@ func (t EntitlementConsistencyCheck) Execute(ctx context.Context, g *model.Graph
 }
 func (t EntitlementConsistencyCheck) RiskModifier() float64   { return t.impl().RiskModifier() }
 func (t EntitlementConsistencyCheck) ImpactModifier() float64 { return t.impl().ImpactModifier() }
+func (t EntitlementConsistencyCheck) NoiseProfile() model.NoiseProfile {
+	return t.impl().NoiseProfile()
+}
+func (t EntitlementConsistencyCheck) Requirements() model.GraphRequirement {
+	return t.impl().Requirements()
+}
 
 // PrivilegeChainPivot is a second pivot behavior focused on graph-link validation before escalation.
 // Risk profile: medium-to-high because it exercises cross-node relationships.
@@ -113,8 +151,10 @@ func (t PrivilegeChainPivot) Evaluate(g *model.Graph) bool { return t.impl().Eva
 func (t PrivilegeChainPivot) Execute(ctx context.Context, g *model.Graph) (model.Evidence, error) {
 	return t.impl().Execute(ctx, g)
 }
-func (t PrivilegeChainPivot) RiskModifier() float64   { return t.impl().RiskModifier() }
-func (t PrivilegeChainPivot) ImpactModifier() float64 { return t.impl().ImpactModifier() }
+func (t PrivilegeChainPivot) RiskModifier() float64                { return t.impl().RiskModifier() }
+func (t PrivilegeChainPivot) ImpactModifier() float64              { return t.impl().ImpactModifier() }
+func (t PrivilegeChainPivot) NoiseProfile() model.NoiseProfile     { return t.impl().NoiseProfile() }
+func (t PrivilegeChainPivot) Requirements() model.GraphRequirement { return t.impl().Requirements() }
 
 // ControlPlanePivot models low-confidence high-impact behavior intended for rare but decisive opportunities.
 // Risk profile: high operational and detection risk with potentially outsized downstream impact.
@@ -132,8 +172,10 @@ func (t ControlPlanePivot) Evaluate(g *model.Graph) bool { return t.impl().Evalu
 func (t ControlPlanePivot) Execute(ctx context.Context, g *model.Graph) (model.Evidence, error) {
 	return t.impl().Execute(ctx, g)
 }
-func (t ControlPlanePivot) RiskModifier() float64   { return t.impl().RiskModifier() }
-func (t ControlPlanePivot) ImpactModifier() float64 { return t.impl().ImpactModifier() }
+func (t ControlPlanePivot) RiskModifier() float64                { return t.impl().RiskModifier() }
+func (t ControlPlanePivot) ImpactModifier() float64              { return t.impl().ImpactModifier() }
+func (t ControlPlanePivot) NoiseProfile() model.NoiseProfile     { return t.impl().NoiseProfile() }
+func (t ControlPlanePivot) Requirements() model.GraphRequirement { return t.impl().Requirements() }
 
 // All returns the diversified technique set for AC-10.
 func All() []model.Technique {
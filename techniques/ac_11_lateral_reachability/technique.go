@@ -6,7 +6,11 @@ import (
 	"vantage/techniques/model"
 )
 
-type evalFn func(*model.Graph) bool
+// evalProfile pairs a relevance check with its declarative graph requirement.
+type evalProfile struct {
+	fn  func(*model.Graph) bool
+	req model.GraphRequirement
+}
 
 type profileTechnique struct {
 	id      string
@@ -14,29 +18,53 @@ type profileTechnique struct {
 	summary string
 	risk    float64
 	impact  float64
-	eval    evalFn
+	eval    evalProfile
 	classID string
+	// noise overrides the risk-derived noise profile when set.
+	noise model.NoiseProfile
 }
 
 func (t profileTechnique) ID() string                       { return t.id }
 func (t profileTechnique) Name() string                     { return t.name }
 func (t profileTechnique) ActionClassID() string            { return t.classID }
-func (t profileTechnique) Evaluate(graph *model.Graph) bool { return t.eval(graph) }
+func (t profileTechnique) Evaluate(graph *model.Graph) bool { return t.eval.fn(graph) }
 func (t profileTechnique) Execute(_ context.Context, _ *model.Graph) (model.Evidence, error) {
 	return model.Evidence{TechniqueID: t.ID(), Summary: t.summary, Success: true}, nil
 }
-func (t profileTechnique) RiskModifier() float64   { return t.risk }
-func (t profileTechnique) ImpactModifier() float64 { return t.impact }
+func (t profileTechnique) RiskModifier() float64                { return t.risk }
+func (t profileTechnique) ImpactModifier() float64              { return t.impact }
+func (t profileTechnique) Requirements() model.GraphRequirement { return t.eval.req }
+func (t profileTechnique) NoiseProfile() model.NoiseProfile {
+	if t.noise != "" {
+		return t.noise
+	}
+	return model.DefaultNoiseProfile(t.risk)
+}
 
 var (
-	evalMinimal    = func(g *model.Graph) bool { return g != nil && g.EvidenceNodes == 0 }
-	evalObserved   = func(g *model.Graph) bool { return g != nil && g.EvidenceNodes >= 1 }
-	evalPivot      = func(g *model.Graph) bool { return g != nil && g.EvidenceNodes >= 1 && g.HypothesisNodes >= 1 }
-	evalGraphPivot = func(g *model.Graph) bool {
-		return g != nil && g.TechniqueNodes >= 1 && (g.HasSupportsEdge || g.HasEnablesEdge)
+	evalMinimal = evalProfile{
+		fn:  func(g *model.Graph) bool { return g != nil && g.EvidenceNodes == 0 },
+		req: model.GraphRequirement{Profile: model.RequirementMinimal, RequiresNoEvidence: true},
+	}
+	evalObserved = evalProfile{
+		fn:  func(g *model.Graph) bool { return g != nil && g.EvidenceNodes >= 1 },
+		req: model.GraphRequirement{Profile: model.RequirementObserved, MinEvidenceNodes: 1},
 	}
-	evalRare = func(g *model.Graph) bool {
-		return g != nil && g.EvidenceNodes >= 2 && g.HypothesisNodes >= 2 && g.TechniqueNodes >= 1 && g.HasSupportsEdge && g.HasEnablesEdge
+	evalPivot = evalProfile{
+		fn:  func(g *model.Graph) bool { return g != nil && g.EvidenceNodes >= 1 && g.HypothesisNodes >= 1 },
+		req: model.GraphRequirement{Profile: model.RequirementPivot, MinEvidenceNodes: 1, MinHypothesisNodes: 1},
+	}
+	evalGraphPivot = evalProfile{
+		fn: func(g *model.Graph) bool {
+			return g != nil && g.TechniqueNodes >= 1 && (g.HasSupportsEdge || g.HasEnablesEdge)
+		},
+		req: model.GraphRequirement{Profile: model.RequirementGraphPivot, MinTechniqueNodes: 1, RequiresPivotEdge: true},
+	}
+	evalRare = evalProfile{
+		fn: func(g *model.Graph) bool {
+			return g != nil && g.EvidenceNodes >= 2 && g.HypothesisNodes >= 2 && g.TechniqueNodes >= 1 && g.HasSupportsEdge && g.HasEnablesEdge
+		},
+		req: model.GraphRequirement{Profile: model.RequirementRare, MinEvidenceNodes: 2, MinHypothesisNodes: 2, MinTechniqueNodes: 1, RequiresSupportsEdge: true, RequiresEnablesEdge: true},
 	}
 )
 
@@ -58,6 +86,12 @@ func (t LateralReachabilityAnalyzer) Execute(ctx context.Context, g *model.Graph
 }
 func (t LateralReachabilityAnalyzer) RiskModifier() float64   { return t.impl().RiskModifier() }
 func (t LateralReachabilityAnalyzer) ImpactModifier() float64 { return t.impl().ImpactModifier() }
+func (t LateralReachabilityAnalyzer) NoiseProfile() model.NoiseProfile {
+	return t.impl().NoiseProfile()
+}
+func (t LateralReachabilityAnalyzer) Requirements() model.GraphRequirement {
+	return t.impl().Requirements()
+}
 
 // NeighborHostCensus captures high-confidence low-impact context to enrich precision targeting later.
 // Risk profile: low risk and low footprint, suitable for steady-state reconnaissance.
@@ -75,8 +109,10 @@ func (t NeighborHostCensus) Evaluate(g *model.Graph) bool { return t.impl().Eval
 func (t NeighborHostCensus) Execute(ctx context.Context, g *model.Graph) (model.Evidence, error) {
 	return t.impl().Execute(ctx, g)
 }
-func (t NeighborHostCensus) RiskModifier() float64   { return t.impl().RiskModifier() }
-func (t NeighborHostCensus) ImpactModifier() float64 { return t.impl().ImpactModifier() }
+func (t NeighborHostCensus) RiskModifier() float64                { return t.impl().RiskModifier() }
+func (t NeighborHostCensus) ImpactModifier() float64              { return t.impl().ImpactModifier() }
+func (t NeighborHostCensus) NoiseProfile() model.NoiseProfile     { return t.impl().NoiseProfile() }
+func (t NeighborHostCensus) Requirements() model.GraphRequirement { return t.impl().Requirements() }
 
 // SegmentRouteValidation is pivot-heavy and links multiple graph hints to expose chained opportunities.
 // Risk profile: medium risk due to directional probing that can trigger controls.
@@ -94,8 +130,10 @@ func (t SegmentRouteValidation) Evaluate(g *model.Graph) bool { return t.impl().
 func (t SegmentRouteValidation) Execute(ctx context.Context, g *model.Graph) (model.Evidence, error) {
 	return t.impl().Execute(ctx, g)
 }
-func (t SegmentRouteValidation) RiskModifier() float64   { return t.impl().RiskModifier() }
-func (t SegmentRouteValidation) ImpactModifier() float64 { return t.impl().ImpactModifier() }
+func (t SegmentRouteValidation) RiskModifier() float64                { return t.impl().RiskModifier() }
+func (t SegmentRouteValidation) ImpactModifier() float64              { return t.impl().ImpactModifier() }
+func (t SegmentRouteValidation) NoiseProfile() model.NoiseProfile     { return t.impl().NoiseProfile() }
+func (t SegmentRouteValidation) Requirements() model.GraphRequirement { return t.impl().Requirements() }
 
 // CredentialRelayPivot is a second pivot behavior focused on graph-link validation before escalation.
 // Risk profile: medium-to-high because it exercises cross-node relationships.
@@ -113,8 +151,10 @@ func (t CredentialRelayPivot) Evaluate(g *model.Graph) bool { return t.impl().Ev
 func (t CredentialRelayPivot) Execute(ctx context.Context, g *model.Graph) (model.Evidence, error) {
 	return t.impl().Execute(ctx, g)
 }
-func (t CredentialRelayPivot) RiskModifier() float64   { return t.impl().RiskModifier() }
-func (t CredentialRelayPivot) ImpactModifier() float64 { return t.impl().ImpactModifier() }
+func (t CredentialRelayPivot) RiskModifier() float64                { return t.impl().RiskModifier() }
+func (t CredentialRelayPivot) ImpactModifier() float64              { return t.impl().ImpactModifier() }
+func (t CredentialRelayPivot) NoiseProfile() model.NoiseProfile     { return t.impl().NoiseProfile() }
+func (t CredentialRelayPivot) Requirements() model.GraphRequirement { return t.impl().Requirements() }
 
 // SharedServicePivot models low-confidence high-impact behavior intended for rare but decisive opportunities.
 // Risk profile: high operational and detection risk with potentially outsized downstream impact.
@@ -132,8 +172,10 @@ func (t SharedServicePivot) Evaluate(g *model.Graph) bool { return t.impl().Eval
 func (t SharedServicePivot) Execute(ctx context.Context, g *model.Graph) (model.Evidence, error) {
 	return t.impl().Execute(ctx, g)
 }
-func (t SharedServicePivot) RiskModifier() float64   { return t.impl().RiskModifier() }
-func (t SharedServicePivot) ImpactModifier() float64 { return t.impl().ImpactModifier() }
+func (t SharedServicePivot) RiskModifier() float64                { return t.impl().RiskModifier() }
+func (t SharedServicePivot) ImpactModifier() float64              { return t.impl().ImpactModifier() }
+func (t SharedServicePivot) NoiseProfile() model.NoiseProfile     { return t.impl().NoiseProfile() }
+func (t SharedServicePivot) Requirements() model.GraphRequirement { return t.impl().Requirements() }
 
 // All returns the diversified technique set for AC-11.
 func All() []model.Technique {
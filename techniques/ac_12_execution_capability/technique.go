@@ -6,7 +6,11 @@ import (
 	"vantage/techniques/model"
 )
 
-type evalFn func(*model.Graph) bool
+// evalProfile pairs a relevance check with its declarative graph requirement.
+type evalProfile struct {
+	fn  func(*model.Graph) bool
+	req model.GraphRequirement
+}
 
 type profileTechnique struct {
 	id      string
@@ -14,29 +18,53 @@ type profileTechnique struct {
 	summary string
 	risk    float64
 	impact  float64
-	eval    evalFn
+	eval    evalProfile
 	classID string
+	// noise overrides the risk-derived noise profile when set.
+	noise model.NoiseProfile
 }
 
 func (t profileTechnique) ID() string                       { return t.id }
 func (t profileTechnique) Name() string                     { return t.name }
 func (t profileTechnique) ActionClassID() string            { return t.classID }
-func (t profileTechnique) Evaluate(graph *model.Graph) bool { return t.eval(graph) }
+func (t profileTechnique) Evaluate(graph *model.Graph) bool { return t.eval.fn(graph) }
 func (t profileTechnique) Execute(_ context.Context, _ *model.Graph) (model.Evidence, error) {
 	return model.Evidence{TechniqueID: t.ID(), Summary: t.summary, Success: true}, nil
 }
-func (t profileTechnique) RiskModifier() float64   { return t.risk }
-func (t profileTechnique) ImpactModifier() float64 { return t.impact }
+func (t profileTechnique) RiskModifier() float64                { return t.risk }
+func (t profileTechnique) ImpactModifier() float64              { return t.impact }
+func (t profileTechnique) Requirements() model.GraphRequirement { return t.eval.req }
+func (t profileTechnique) NoiseProfile() model.NoiseProfile {
+	if t.noise != "" {
+		return t.noise
+	}
+	return model.DefaultNoiseProfile(t.risk)
+}
 
 var (
-	evalMinimal    = func(g *model.Graph) bool { return g != nil && g.EvidenceNodes == 0 }
-	evalObserved   = func(g *model.Graph) bool { return g != nil && g.EvidenceNodes >= 1 }
-	evalPivot      = func(g *model.Graph) bool { return g != nil && g.EvidenceNodes >= 1 && g.HypothesisNodes >= 1 }
-	evalGraphPivot = func(g *model.Graph) bool {
-		return g != nil && g.TechniqueNodes >= 1 && (g.HasSupportsEdge || g.HasEnablesEdge)
+	evalMinimal = evalProfile{
+		fn:  func(g *model.Graph) bool { return g != nil && g.EvidenceNodes == 0 },
+		req: model.GraphRequirement{Profile: model.RequirementMinimal, RequiresNoEvidence: true},
+	}
+	evalObserved = evalProfile{
+		fn:  func(g *model.Graph) bool { return g != nil && g.EvidenceNodes >= 1 },
+		req: model.GraphRequirement{Profile: model.RequirementObserved, MinEvidenceNodes: 1},
 	}
-	evalRare = func(g *model.Graph) bool {
-		return g != nil && g.EvidenceNodes >= 2 && g.HypothesisNodes >= 2 && g.TechniqueNodes >= 1 && g.HasSupportsEdge && g.HasEnablesEdge
+	evalPivot = evalProfile{
+		fn:  func(g *model.Graph) bool { return g != nil && g.EvidenceNodes >= 1 && g.HypothesisNodes >= 1 },
+		req: model.GraphRequirement{Profile: model.RequirementPivot, MinEvidenceNodes: 1, MinHypothesisNodes: 1},
+	}
+	evalGraphPivot = evalProfile{
+		fn: func(g *model.Graph) bool {
+			return g != nil && g.TechniqueNodes >= 1 && (g.HasSupportsEdge || g.HasEnablesEdge)
+		},
+		req: model.GraphRequirement{Profile: model.RequirementGraphPivot, MinTechniqueNodes: 1, RequiresPivotEdge: true},
+	}
+	evalRare = evalProfile{
+		fn: func(g *model.Graph) bool {
+			return g != nil && g.EvidenceNodes >= 2 && g.HypothesisNodes >= 2 && g.TechniqueNodes >= 1 && g.HasSupportsEdge && g.HasEnablesEdge
+		},
+		req: model.GraphRequirement{Profile: model.RequirementRare, MinEvidenceNodes: 2, MinHypothesisNodes: 2, MinTechniqueNodes: 1, RequiresSupportsEdge: true, RequiresEnablesEdge: true},
 	}
 )
 
@@ -58,6 +86,12 @@ func (t ExecutionCapabilityValidator) Execute(ctx context.Context, g *model.Grap
 }
 func (t ExecutionCapabilityValidator) RiskModifier() float64   { return t.impl().RiskModifier() }
 func (t ExecutionCapabilityValidator) ImpactModifier() float64 { return t.impl().ImpactModifier() }
+func (t ExecutionCapabilityValidator) NoiseProfile() model.NoiseProfile {
+	return t.impl().NoiseProfile()
+}
+func (t ExecutionCapabilityValidator) Requirements() model.GraphRequirement {
+	return t.impl().Requirements()
+}
 
 // BenignCommandCanary captures high-confidence low-impact context to enrich precision targeting later.
 // Risk profile: low risk and low footprint, suitable for steady-state reconnaissance.
@@ -75,8 +109,10 @@ func (t BenignCommandCanary) Evaluate(g *model.Graph) bool { return t.impl().Eva
 func (t BenignCommandCanary) Execute(ctx context.Context, g *model.Graph) (model.Evidence, error) {
 	return t.impl().Execute(ctx, g)
 }
-func (t BenignCommandCanary) RiskModifier() float64   { return t.impl().RiskModifier() }
-func (t BenignCommandCanary) ImpactModifier() float64 { return t.impl().ImpactModifier() }
+func (t BenignCommandCanary) RiskModifier() float64                { return t.impl().RiskModifier() }
+func (t BenignCommandCanary) ImpactModifier() float64              { return t.impl().ImpactModifier() }
+func (t BenignCommandCanary) NoiseProfile() model.NoiseProfile     { return t.impl().NoiseProfile() }
+func (t BenignCommandCanary) Requirements() model.GraphRequirement { return t.impl().Requirements() }
 
 // RuntimeConstraintProbe is pivot-heavy and links multiple graph hints to expose chained opportunities.
 // Risk profile: medium risk due to directional probing that can trigger controls.
@@ -94,8 +130,10 @@ func (t RuntimeConstraintProbe) Evaluate(g *model.Graph) bool { return t.impl().
 func (t RuntimeConstraintProbe) Execute(ctx context.Context, g *model.Graph) (model.Evidence, error) {
 	return t.impl().Execute(ctx, g)
 }
-func (t RuntimeConstraintProbe) RiskModifier() float64   { return t.impl().RiskModifier() }
-func (t RuntimeConstraintProbe) ImpactModifier() float64 { return t.impl().ImpactModifier() }
+func (t RuntimeConstraintProbe) RiskModifier() float64                { return t.impl().RiskModifier() }
+func (t RuntimeConstraintProbe) ImpactModifier() float64              { return t.impl().ImpactModifier() }
+func (t RuntimeConstraintProbe) NoiseProfile() model.NoiseProfile     { return t.impl().NoiseProfile() }
+func (t RuntimeConstraintProbe) Requirements() model.GraphRequirement { return t.impl().Requirements() }
 
 // ToolTransferPivot is a second pivot behavior focused on graph-link validation before escalation.
 // Risk profile: medium-to-high because it exercises cross-node relationships.
@@ -113,8 +151,10 @@ func (t ToolTransferPivot) Evaluate(g *model.Graph) bool { return t.impl().Evalu
 func (t ToolTransferPivot) Execute(ctx context.Context, g *model.Graph) (model.Evidence, error) {
 	return t.impl().Execute(ctx, g)
 }
-func (t ToolTransferPivot) RiskModifier() float64   { return t.impl().RiskModifier() }
-func (t ToolTransferPivot) ImpactModifier() float64 { return t.impl().ImpactModifier() }
+func (t ToolTransferPivot) RiskModifier() float64                { return t.impl().RiskModifier() }
+func (t ToolTransferPivot) ImpactModifier() float64              { return t.impl().ImpactModifier() }
+func (t ToolTransferPivot) NoiseProfile() model.NoiseProfile     { return t.impl().NoiseProfile() }
+func (t ToolTransferPivot) Requirements() model.GraphRequirement { return t.impl().Requirements() }
 
 // SchedulerPivot models low-confidence high-impact behavior intended for rare but decisive opportunities.
 // Risk profile: high operational and detection risk with potentially outsized downstream impact.
@@ -132,8 +172,10 @@ func (t SchedulerPivot) Evaluate(g *model.Graph) bool { return t.impl().Evaluate
 func (t SchedulerPivot) Execute(ctx context.Context, g *model.Graph) (model.Evidence, error) {
 	return t.impl().Execute(ctx, g)
 }
-func (t SchedulerPivot) RiskModifier() float64   { return t.impl().RiskModifier() }
-func (t SchedulerPivot) ImpactModifier() float64 { return t.impl().ImpactModifier() }
+func (t SchedulerPivot) RiskModifier() float64                { return t.impl().RiskModifier() }
+func (t SchedulerPivot) ImpactModifier() float64              { return t.impl().ImpactModifier() }
+func (t SchedulerPivot) NoiseProfile() model.NoiseProfile     { return t.impl().NoiseProfile() }
+func (t SchedulerPivot) Requirements() model.GraphRequirement { return t.impl().Requirements() }
 
 // All returns the diversified technique set for AC-12.
 func All() []model.Technique {
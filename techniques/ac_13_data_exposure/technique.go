@@ -6,7 +6,11 @@ import (
 	"vantage/techniques/model"
 )
 
-type evalFn func(*model.Graph) bool
+// evalProfile pairs a relevance check with its declarative graph requirement.
+type evalProfile struct {
+	fn  func(*model.Graph) bool
+	req model.GraphRequirement
+}
 
 type profileTechnique struct {
 	id      string
@@ -14,29 +18,53 @@ type profileTechnique struct {
 	summary string
 	risk    float64
 	impact  float64
-	eval    evalFn
+	eval    evalProfile
 	classID string
+	// noise overrides the risk-derived noise profile when set.
+	noise model.NoiseProfile
 }
 
 func (t profileTechnique) ID() string                       { return t.id }
 func (t profileTechnique) Name() string                     { return t.name }
 func (t profileTechnique) ActionClassID() string            { return t.classID }
-func (t profileTechnique) Evaluate(graph *model.Graph) bool { return t.eval(graph) }
+func (t profileTechnique) Evaluate(graph *model.Graph) bool { return t.eval.fn(graph) }
 func (t profileTechnique) Execute(_ context.Context, _ *model.Graph) (model.Evidence, error) {
 	return model.Evidence{TechniqueID: t.ID(), Summary: t.summary, Success: true}, nil
 }
-func (t profileTechnique) RiskModifier() float64   { return t.risk }
-func (t profileTechnique) ImpactModifier() float64 { return t.impact }
+func (t profileTechnique) RiskModifier() float64                { return t.risk }
+func (t profileTechnique) ImpactModifier() float64              { return t.impact }
+func (t profileTechnique) Requirements() model.GraphRequirement { return t.eval.req }
+func (t profileTechnique) NoiseProfile() model.NoiseProfile {
+	if t.noise != "" {
+		return t.noise
+	}
+	return model.DefaultNoiseProfile(t.risk)
+}
 
 var (
-	evalMinimal    = func(g *model.Graph) bool { return g != nil && g.EvidenceNodes == 0 }
-	evalObserved   = func(g *model.Graph) bool { return g != nil && g.EvidenceNodes >= 1 }
-	evalPivot      = func(g *model.Graph) bool { return g != nil && g.EvidenceNodes >= 1 && g.HypothesisNodes >= 1 }
-	evalGraphPivot = func(g *model.Graph) bool {
-		return g != nil && g.TechniqueNodes >= 1 && (g.HasSupportsEdge || g.HasEnablesEdge)
+	evalMinimal = evalProfile{
+		fn:  func(g *model.Graph) bool { return g != nil && g.EvidenceNodes == 0 },
+		req: model.GraphRequirement{Profile: model.RequirementMinimal, RequiresNoEvidence: true},
+	}
+	evalObserved = evalProfile{
+		fn:  func(g *model.Graph) bool { return g != nil && g.EvidenceNodes >= 1 },
+		req: model.GraphRequirement{Profile: model.RequirementObserved, MinEvidenceNodes: 1},
+	}
+	evalPivot = evalProfile{
+		fn:  func(g *model.Graph) bool { return g != nil && g.EvidenceNodes >= 1 && g.HypothesisNodes >= 1 },
+		req: model.GraphRequirement{Profile: model.RequirementPivot, MinEvidenceNodes: 1, MinHypothesisNodes: 1},
+	}
+	evalGraphPivot = evalProfile{
+		fn: func(g *model.Graph) bool {
+			return g != nil && g.TechniqueNodes >= 1 && (g.HasSupportsEdge || g.HasEnablesEdge)
+		},
+		req: model.GraphRequirement{Profile: model.RequirementGraphPivot, MinTechniqueNodes: 1, RequiresPivotEdge: true},
 	}
-	evalRare = func(g *model.Graph) bool {
-		return g != nil && g.EvidenceNodes >= 2 && g.HypothesisNodes >= 2 && g.TechniqueNodes >= 1 && g.HasSupportsEdge && g.HasEnablesEdge
+	evalRare = evalProfile{
+		fn: func(g *model.Graph) bool {
+			return g != nil && g.EvidenceNodes >= 2 && g.HypothesisNodes >= 2 && g.TechniqueNodes >= 1 && g.HasSupportsEdge && g.HasEnablesEdge
+		},
+		req: model.GraphRequirement{Profile: model.RequirementRare, MinEvidenceNodes: 2, MinHypothesisNodes: 2, MinTechniqueNodes: 1, RequiresSupportsEdge: true, RequiresEnablesEdge: true},
 	}
 )
 
@@ -56,8 +84,10 @@ func (t DataExposureVerifier) Evaluate(g *model.Graph) bool { return t.impl().Ev
 func (t DataExposureVerifier) Execute(ctx context.Context, g *model.Graph) (model.Evidence, error) {
 	return t.impl().Execute(ctx, g)
 }
-func (t DataExposureVerifier) RiskModifier() float64   { return t.impl().RiskModifier() }
-func (t DataExposureVerifier) ImpactModifier() float64 { return t.impl().ImpactModifier() }
+func (t DataExposureVerifier) RiskModifier() float64                { return t.impl().RiskModifier() }
+func (t DataExposureVerifier) ImpactModifier() float64              { return t.impl().ImpactModifier() }
+func (t DataExposureVerifier) NoiseProfile() model.NoiseProfile     { return t.impl().NoiseProfile() }
+func (t DataExposureVerifier) Requirements() model.GraphRequirement { return t.impl().Requirements() }
 
 // PublicDataSampling captures high-confidence low-impact context to enrich precision targeting later.
 // Risk profile: low risk and low footprint, suitable for steady-state reconnaissance.
@@ -75,8 +105,10 @@ func (t PublicDataSampling) Evaluate(g *model.Graph) bool { return t.impl().Eval
 func (t PublicDataSampling) Execute(ctx context.Context, g *model.Graph) (model.Evidence, error) {
 	return t.impl().Execute(ctx, g)
 }
-func (t PublicDataSampling) RiskModifier() float64   { return t.impl().RiskModifier() }
-func (t PublicDataSampling) ImpactModifier() float64 { return t.impl().ImpactModifier() }
+func (t PublicDataSampling) RiskModifier() float64                { return t.impl().RiskModifier() }
+func (t PublicDataSampling) ImpactModifier() float64              { return t.impl().ImpactModifier() }
+func (t PublicDataSampling) NoiseProfile() model.NoiseProfile     { return t.impl().NoiseProfile() }
+func (t PublicDataSampling) Requirements() model.GraphRequirement { return t.impl().Requirements() }
 
 // SchemaVisibilityCheck is pivot-heavy and links multiple graph hints to expose chained opportunities.
 // Risk profile: medium risk due to directional probing that can trigger controls.
@@ -94,8 +126,10 @@ func (t SchemaVisibilityCheck) Evaluate(g *model.Graph) bool { return t.impl().E
 func (t SchemaVisibilityCheck) Execute(ctx context.Context, g *model.Graph) (model.Evidence, error) {
 	return t.impl().Execute(ctx, g)
 }
-func (t SchemaVisibilityCheck) RiskModifier() float64   { return t.impl().RiskModifier() }
-func (t SchemaVisibilityCheck) ImpactModifier() float64 { return t.impl().ImpactModifier() }
+func (t SchemaVisibilityCheck) RiskModifier() float64                { return t.impl().RiskModifier() }
+func (t SchemaVisibilityCheck) ImpactModifier() float64              { return t.impl().ImpactModifier() }
+func (t SchemaVisibilityCheck) NoiseProfile() model.NoiseProfile     { return t.impl().NoiseProfile() }
+func (t SchemaVisibilityCheck) Requirements() model.GraphRequirement { return t.impl().Requirements() }
 
 // DataLinkagePivot is a second pivot behavior focused on graph-link validation before escalation.
 // Risk profile: medium-to-high because it exercises cross-node relationships.
@@ -113,8 +147,10 @@ func (t DataLinkagePivot) Evaluate(g *model.Graph) bool { return t.impl().Evalua
 func (t DataLinkagePivot) Execute(ctx context.Context, g *model.Graph) (model.Evidence, error) {
 	return t.impl().Execute(ctx, g)
 }
-func (t DataLinkagePivot) RiskModifier() float64   { return t.impl().RiskModifier() }
-func (t DataLinkagePivot) ImpactModifier() float64 { return t.impl().ImpactModifier() }
+func (t DataLinkagePivot) RiskModifier() float64                { return t.impl().RiskModifier() }
+func (t DataLinkagePivot) ImpactModifier() float64              { return t.impl().ImpactModifier() }
+func (t DataLinkagePivot) NoiseProfile() model.NoiseProfile     { return t.impl().NoiseProfile() }
+func (t DataLinkagePivot) Requirements() model.GraphRequirement { return t.impl().Requirements() }
 
 // BackupChannelPivot models low-confidence high-impact behavior intended for rare but decisive opportunities.
 // Risk profile: high operational and detection risk with potentially outsized downstream impact.
@@ -132,8 +168,10 @@ func (t BackupChannelPivot) Evaluate(g *model.Graph) bool { return t.impl().Eval
 func (t BackupChannelPivot) Execute(ctx context.Context, g *model.Graph) (model.Evidence, error) {
 	return t.impl().Execute(ctx, g)
 }
-func (t BackupChannelPivot) RiskModifier() float64   { return t.impl().RiskModifier() }
-func (t BackupChannelPivot) ImpactModifier() float64 { return t.impl().ImpactModifier() }
+func (t BackupChannelPivot) RiskModifier() float64                { return t.impl().RiskModifier() }
+func (t BackupChannelPivot) ImpactModifier() float64              { return t.impl().ImpactModifier() }
+func (t BackupChannelPivot) NoiseProfile() model.NoiseProfile     { return t.impl().NoiseProfile() }
+func (t BackupChannelPivot) Requirements() model.GraphRequirement { return t.impl().Requirements() }
 
 // All returns the diversified technique set for AC-13.
 func All() []model.Technique {
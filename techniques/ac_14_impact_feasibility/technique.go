@@ -6,7 +6,11 @@ import (
 	"vantage/techniques/model"
 )
 
-type evalFn func(*model.Graph) bool
+// evalProfile pairs a relevance check with its declarative graph requirement.
+type evalProfile struct {
+	fn  func(*model.Graph) bool
+	req model.GraphRequirement
+}
 
 type profileTechnique struct {
 	id      string
@@ -14,29 +18,53 @@ type profileTechnique struct {
 	summary string
 	risk    float64
 	impact  float64
-	eval    evalFn
+	eval    evalProfile
 	classID string
+	// noise overrides the risk-derived noise profile when set.
+	noise model.NoiseProfile
 }
 
 func (t profileTechnique) ID() string                       { return t.id }
 func (t profileTechnique) Name() string                     { return t.name }
 func (t profileTechnique) ActionClassID() string            { return t.classID }
-func (t profileTechnique) Evaluate(graph *model.Graph) bool { return t.eval(graph) }
+func (t profileTechnique) Evaluate(graph *model.Graph) bool { return t.eval.fn(graph) }
 func (t profileTechnique) Execute(_ context.Context, _ *model.Graph) (model.Evidence, error) {
 	return model.Evidence{TechniqueID: t.ID(), Summary: t.summary, Success: true}, nil
 }
-func (t profileTechnique) RiskModifier() float64   { return t.risk }
-func (t profileTechnique) ImpactModifier() float64 { return t.impact }
+func (t profileTechnique) RiskModifier() float64                { return t.risk }
+func (t profileTechnique) ImpactModifier() float64              { return t.impact }
+func (t profileTechnique) Requirements() model.GraphRequirement { return t.eval.req }
+func (t profileTechnique) NoiseProfile() model.NoiseProfile {
+	if t.noise != "" {
+		return t.noise
+	}
+	return model.DefaultNoiseProfile(t.risk)
+}
 
 var (
-	evalMinimal    = func(g *model.Graph) bool { return g != nil && g.EvidenceNodes == 0 }
-	evalObserved   = func(g *model.Graph) bool { return g != nil && g.EvidenceNodes >= 1 }
-	evalPivot      = func(g *model.Graph) bool { return g != nil && g.EvidenceNodes >= 1 && g.HypothesisNodes >= 1 }
-	evalGraphPivot = func(g *model.Graph) bool {
-		return g != nil && g.TechniqueNodes >= 1 && (g.HasSupportsEdge || g.HasEnablesEdge)
+	evalMinimal = evalProfile{
+		fn:  func(g *model.Graph) bool { return g != nil && g.EvidenceNodes == 0 },
+		req: model.GraphRequirement{Profile: model.RequirementMinimal, RequiresNoEvidence: true},
+	}
+	evalObserved = evalProfile{
+		fn:  func(g *model.Graph) bool { return g != nil && g.EvidenceNodes >= 1 },
+		req: model.GraphRequirement{Profile: model.RequirementObserved, MinEvidenceNodes: 1},
 	}
-	evalRare = func(g *model.Graph) bool {
-		return g != nil && g.EvidenceNodes >= 2 && g.HypothesisNodes >= 2 && g.TechniqueNodes >= 1 && g.HasSupportsEdge && g.HasEnablesEdge
+	evalPivot = evalProfile{
+		fn:  func(g *model.Graph) bool { return g != nil && g.EvidenceNodes >= 1 && g.HypothesisNodes >= 1 },
+		req: model.GraphRequirement{Profile: model.RequirementPivot, MinEvidenceNodes: 1, MinHypothesisNodes: 1},
+	}
+	evalGraphPivot = evalProfile{
+		fn: func(g *model.Graph) bool {
+			return g != nil && g.TechniqueNodes >= 1 && (g.HasSupportsEdge || g.HasEnablesEdge)
+		},
+		req: model.GraphRequirement{Profile: model.RequirementGraphPivot, MinTechniqueNodes: 1, RequiresPivotEdge: true},
+	}
+	evalRare = evalProfile{
+		fn: func(g *model.Graph) bool {
+			return g != nil && g.EvidenceNodes >= 2 && g.HypothesisNodes >= 2 && g.TechniqueNodes >= 1 && g.HasSupportsEdge && g.HasEnablesEdge
+		},
+		req: model.GraphRequirement{Profile: model.RequirementRare, MinEvidenceNodes: 2, MinHypothesisNodes: 2, MinTechniqueNodes: 1, RequiresSupportsEdge: true, RequiresEnablesEdge: true},
 	}
 )
 
@@ -56,8 +84,12 @@ func (t ImpactFeasibilityAssessor) Evaluate(g *model.Graph) bool { return t.impl
 func (t ImpactFeasibilityAssessor) Execute(ctx context.Context, g *model.Graph) (model.Evidence, error) {
 	return t.impl().Execute(ctx, g)
 }
-func (t ImpactFeasibilityAssessor) RiskModifier() float64   { return t.impl().RiskModifier() }
-func (t ImpactFeasibilityAssessor) ImpactModifier() float64 { return t.impl().ImpactModifier() }
+func (t ImpactFeasibilityAssessor) RiskModifier() float64            { return t.impl().RiskModifier() }
+func (t ImpactFeasibilityAssessor) ImpactModifier() float64          { return t.impl().ImpactModifier() }
+func (t ImpactFeasibilityAssessor) NoiseProfile() model.NoiseProfile { return t.impl().NoiseProfile() }
+func (t ImpactFeasibilityAssessor) Requirements() model.GraphRequirement {
+	return t.impl().Requirements()
+}
 
 // ProcessFragilityReview captures high-confidence low-impact context to enrich precision targeting later.
 // Risk profile: low risk and low footprint, suitable for steady-state reconnaissance.
@@ -75,8 +107,10 @@ func (t ProcessFragilityReview) Evaluate(g *model.Graph) bool { return t.impl().
 func (t ProcessFragilityReview) Execute(ctx context.Context, g *model.Graph) (model.Evidence, error) {
 	return t.impl().Execute(ctx, g)
 }
-func (t ProcessFragilityReview) RiskModifier() float64   { return t.impl().RiskModifier() }
-func (t ProcessFragilityReview) ImpactModifier() float64 { return t.impl().ImpactModifier() }
+func (t ProcessFragilityReview) RiskModifier() float64                { return t.impl().RiskModifier() }
+func (t ProcessFragilityReview) ImpactModifier() float64              { return t.impl().ImpactModifier() }
+func (t ProcessFragilityReview) NoiseProfile() model.NoiseProfile     { return t.impl().NoiseProfile() }
+func (t ProcessFragilityReview) Requirements() model.GraphRequirement { return t.impl().Requirements() }
 
 // RecoveryWindowEstimate is pivot-heavy and links multiple graph hints to expose chained opportunities.
 // Risk profile: medium risk due to directional probing that can trigger controls.
@@ -94,8 +128,10 @@ func (t RecoveryWindowEstimate) Evaluate(g *model.Graph) bool { return t.impl().
 func (t RecoveryWindowEstimate) Execute(ctx context.Context, g *model.Graph) (model.Evidence, error) {
 	return t.impl().Execute(ctx, g)
 }
-func (t RecoveryWindowEstimate) RiskModifier() float64   { return t.impl().RiskModifier() }
-func (t RecoveryWindowEstimate) ImpactModifier() float64 { return t.impl().ImpactModifier() }
+func (t RecoveryWindowEstimate) RiskModifier() float64                { return t.impl().RiskModifier() }
+func (t RecoveryWindowEstimate) ImpactModifier() float64              { return t.impl().ImpactModifier() }
+func (t RecoveryWindowEstimate) NoiseProfile() model.NoiseProfile     { return t.impl().NoiseProfile() }
+func (t RecoveryWindowEstimate) Requirements() model.GraphRequirement { return t.impl().Requirements() }
 
 // BusinessWorkflowPivot is a second pivot behavior focused on graph-link validation before escalation.
 // Risk profile: medium-to-high because it exercises cross-node relationships.
@@ -113,8 +149,10 @@ func (t BusinessWorkflowPivot) Evaluate(g *model.Graph) bool { return t.impl().E
 func (t BusinessWorkflowPivot) Execute(ctx context.Context, g *model.Graph) (model.Evidence, error) {
 	return t.impl().Execute(ctx, g)
 }
-func (t BusinessWorkflowPivot) RiskModifier() float64   { return t.impl().RiskModifier() }
-func (t BusinessWorkflowPivot) ImpactModifier() float64 { return t.impl().ImpactModifier() }
+func (t BusinessWorkflowPivot) RiskModifier() float64                { return t.impl().RiskModifier() }
+func (t BusinessWorkflowPivot) ImpactModifier() float64              { return t.impl().ImpactModifier() }
+func (t BusinessWorkflowPivot) NoiseProfile() model.NoiseProfile     { return t.impl().NoiseProfile() }
+func (t BusinessWorkflowPivot) Requirements() model.GraphRequirement { return t.impl().Requirements() }
 
 // DependencyCascadePivot models low-confidence high-impact behavior intended for rare but decisive opportunities.
 // Risk profile: high operational and detection risk with potentially outsized downstream impact.
@@ -132,8 +170,10 @@ func (t DependencyCascadePivot) Evaluate(g *model.Graph) bool { return t.impl().
 func (t DependencyCascadePivot) Execute(ctx context.Context, g *model.Graph) (model.Evidence, error) {
 	return t.impl().Execute(ctx, g)
 }
-func (t DependencyCascadePivot) RiskModifier() float64   { return t.impl().RiskModifier() }
-func (t DependencyCascadePivot) ImpactModifier() float64 { return t.impl().ImpactModifier() }
+func (t DependencyCascadePivot) RiskModifier() float64                { return t.impl().RiskModifier() }
+func (t DependencyCascadePivot) ImpactModifier() float64              { return t.impl().ImpactModifier() }
+func (t DependencyCascadePivot) NoiseProfile() model.NoiseProfile     { return t.impl().NoiseProfile() }
+func (t DependencyCascadePivot) Requirements() model.GraphRequirement { return t.impl().Requirements() }
 
 // All returns the diversified technique set for AC-14.
 func All() []model.Technique {
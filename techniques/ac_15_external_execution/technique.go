@@ -6,7 +6,11 @@ import (
 	"vantage/techniques/model"
 )
 
-type evalFn func(*model.Graph) bool
+// evalProfile pairs a relevance check with its declarative graph requirement.
+type evalProfile struct {
+	fn  func(*model.Graph) bool
+	req model.GraphRequirement
+}
 
 type profileTechnique struct {
 	id      string
@@ -14,29 +18,53 @@ type profileTechnique struct {
 	summary string
 	risk    float64
 	impact  float64
-	eval    evalFn
+	eval    evalProfile
 	classID string
+	// noise overrides the risk-derived noise profile when set.
+	noise model.NoiseProfile
 }
 
 func (t profileTechnique) ID() string                       { return t.id }
 func (t profileTechnique) Name() string                     { return t.name }
 func (t profileTechnique) ActionClassID() string            { return t.classID }
-func (t profileTechnique) Evaluate(graph *model.Graph) bool { return t.eval(graph) }
+func (t profileTechnique) Evaluate(graph *model.Graph) bool { return t.eval.fn(graph) }
 func (t profileTechnique) Execute(_ context.Context, _ *model.Graph) (model.Evidence, error) {
 	return model.Evidence{TechniqueID: t.ID(), Summary: t.summary, Success: true}, nil
 }
-func (t profileTechnique) RiskModifier() float64   { return t.risk }
-func (t profileTechnique) ImpactModifier() float64 { return t.impact }
+func (t profileTechnique) RiskModifier() float64                { return t.risk }
+func (t profileTechnique) ImpactModifier() float64              { return t.impact }
+func (t profileTechnique) Requirements() model.GraphRequirement { return t.eval.req }
+func (t profileTechnique) NoiseProfile() model.NoiseProfile {
+	if t.noise != "" {
+		return t.noise
+	}
+	return model.DefaultNoiseProfile(t.risk)
+}
 
 var (
-	evalMinimal    = func(g *model.Graph) bool { return g != nil && g.EvidenceNodes == 0 }
-	evalObserved   = func(g *model.Graph) bool { return g != nil && g.EvidenceNodes >= 1 }
-	evalPivot      = func(g *model.Graph) bool { return g != nil && g.EvidenceNodes >= 1 && g.HypothesisNodes >= 1 }
-	evalGraphPivot = func(g *model.Graph) bool {
-		return g != nil && g.TechniqueNodes >= 1 && (g.HasSupportsEdge || g.HasEnablesEdge)
+	evalMinimal = evalProfile{
+		fn:  func(g *model.Graph) bool { return g != nil && g.EvidenceNodes == 0 },
+		req: model.GraphRequirement{Profile: model.RequirementMinimal, RequiresNoEvidence: true},
+	}
+	evalObserved = evalProfile{
+		fn:  func(g *model.Graph) bool { return g != nil && g.EvidenceNodes >= 1 },
+		req: model.GraphRequirement{Profile: model.RequirementObserved, MinEvidenceNodes: 1},
+	}
+	evalPivot = evalProfile{
+		fn:  func(g *model.Graph) bool { return g != nil && g.EvidenceNodes >= 1 && g.HypothesisNodes >= 1 },
+		req: model.GraphRequirement{Profile: model.RequirementPivot, MinEvidenceNodes: 1, MinHypothesisNodes: 1},
 	}
-	evalRare = func(g *model.Graph) bool {
-		return g != nil && g.EvidenceNodes >= 2 && g.HypothesisNodes >= 2 && g.TechniqueNodes >= 1 && g.HasSupportsEdge && g.HasEnablesEdge
+	evalGraphPivot = evalProfile{
+		fn: func(g *model.Graph) bool {
+			return g != nil && g.TechniqueNodes >= 1 && (g.HasSupportsEdge || g.HasEnablesEdge)
+		},
+		req: model.GraphRequirement{Profile: model.RequirementGraphPivot, MinTechniqueNodes: 1, RequiresPivotEdge: true},
+	}
+	evalRare = evalProfile{
+		fn: func(g *model.Graph) bool {
+			return g != nil && g.EvidenceNodes >= 2 && g.HypothesisNodes >= 2 && g.TechniqueNodes >= 1 && g.HasSupportsEdge && g.HasEnablesEdge
+		},
+		req: model.GraphRequirement{Profile: model.RequirementRare, MinEvidenceNodes: 2, MinHypothesisNodes: 2, MinTechniqueNodes: 1, RequiresSupportsEdge: true, RequiresEnablesEdge: true},
 	}
 )
 
@@ -58,6 +86,12 @@ func (t ExternalExecutionCoordinator) Execute(ctx context.Context, g *model.Grap
 }
 func (t ExternalExecutionCoordinator) RiskModifier() float64   { return t.impl().RiskModifier() }
 func (t ExternalExecutionCoordinator) ImpactModifier() float64 { return t.impl().ImpactModifier() }
+func (t ExternalExecutionCoordinator) NoiseProfile() model.NoiseProfile {
+	return t.impl().NoiseProfile()
+}
+func (t ExternalExecutionCoordinator) Requirements() model.GraphRequirement {
+	return t.impl().Requirements()
+}
 
 // VendorExecutionReadiness captures high-confidence low-impact context to enrich precision targeting later.
 // Risk profile: low risk and low footprint, suitable for steady-state reconnaissance.
@@ -75,8 +109,12 @@ func (t VendorExecutionReadiness) Evaluate(g *model.Graph) bool { return t.impl(
 func (t VendorExecutionReadiness) Execute(ctx context.Context, g *model.Graph) (model.Evidence, error) {
 	return t.impl().Execute(ctx, g)
 }
-func (t VendorExecutionReadiness) RiskModifier() float64   { return t.impl().RiskModifier() }
-func (t VendorExecutionReadiness) ImpactModifier() float64 { return t.impl().ImpactModifier() }
+func (t VendorExecutionReadiness) RiskModifier() float64            { return t.impl().RiskModifier() }
+func (t VendorExecutionReadiness) ImpactModifier() float64          { return t.impl().ImpactModifier() }
+func (t VendorExecutionReadiness) NoiseProfile() model.NoiseProfile { return t.impl().NoiseProfile() }
+func (t VendorExecutionReadiness) Requirements() model.GraphRequirement {
+	return t.impl().Requirements()
+}
 
 // OutsourceTaskValidation is pivot-heavy and links multiple graph hints to expose chained opportunities.
 // Risk profile: medium risk due to directional probing that can trigger controls.
@@ -94,8 +132,12 @@ func (t OutsourceTaskValidation) Evaluate(g *model.Graph) bool { return t.impl()
 func (t OutsourceTaskValidation) Execute(ctx context.Context, g *model.Graph) (model.Evidence, error) {
 	return t.impl().Execute(ctx, g)
 }
-func (t OutsourceTaskValidation) RiskModifier() float64   { return t.impl().RiskModifier() }
-func (t OutsourceTaskValidation) ImpactModifier() float64 { return t.impl().ImpactModifier() }
+func (t OutsourceTaskValidation) RiskModifier() float64            { return t.impl().RiskModifier() }
+func (t OutsourceTaskValidation) ImpactModifier() float64          { return t.impl().ImpactModifier() }
+func (t OutsourceTaskValidation) NoiseProfile() model.NoiseProfile { return t.impl().NoiseProfile() }
+func (t OutsourceTaskValidation) Requirements() model.GraphRequirement {
+	return t.impl().Requirements()
+}
 
 // SupplyChainPivot is a second pivot behavior focused on graph-link validation before escalation.
 // Risk profile: medium-to-high because it exercises cross-node relationships.
@@ -113,8 +155,10 @@ func (t SupplyChainPivot) Evaluate(g *model.Graph) bool { return t.impl().Evalua
 func (t SupplyChainPivot) Execute(ctx context.Context, g *model.Graph) (model.Evidence, error) {
 	return t.impl().Execute(ctx, g)
 }
-func (t SupplyChainPivot) RiskModifier() float64   { return t.impl().RiskModifier() }
-func (t SupplyChainPivot) ImpactModifier() float64 { return t.impl().ImpactModifier() }
+func (t SupplyChainPivot) RiskModifier() float64                { return t.impl().RiskModifier() }
+func (t SupplyChainPivot) ImpactModifier() float64              { return t.impl().ImpactModifier() }
+func (t SupplyChainPivot) NoiseProfile() model.NoiseProfile     { return t.impl().NoiseProfile() }
+func (t SupplyChainPivot) Requirements() model.GraphRequirement { return t.impl().Requirements() }
 
 // RemoteOpsPivot models low-confidence high-impact behavior intended for rare but decisive opportunities.
 // Risk profile: high operational and detection risk with potentially outsized downstream impact.
@@ -132,8 +176,10 @@ func (t RemoteOpsPivot) Evaluate(g *model.Graph) bool { return t.impl().Evaluate
 func (t RemoteOpsPivot) Execute(ctx context.Context, g *model.Graph) (model.Evidence, error) {
 	return t.impl().Execute(ctx, g)
 }
-func (t RemoteOpsPivot) RiskModifier() float64   { return t.impl().RiskModifier() }
-func (t RemoteOpsPivot) ImpactModifier() float64 { return t.impl().ImpactModifier() }
+func (t RemoteOpsPivot) RiskModifier() float64                { return t.impl().RiskModifier() }
+func (t RemoteOpsPivot) ImpactModifier() float64              { return t.impl().ImpactModifier() }
+func (t RemoteOpsPivot) NoiseProfile() model.NoiseProfile     { return t.impl().NoiseProfile() }
+func (t RemoteOpsPivot) Requirements() model.GraphRequirement { return t.impl().Requirements() }
 
 // All returns the diversified technique set for AC-15.
 func All() []model.Technique {
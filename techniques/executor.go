@@ -0,0 +1,47 @@
+package techniques
+
+import (
+	"context"
+	"errors"
+
+	"vantage/techniques/model"
+)
+
+// OutcomeModel estimates the probability, in [0,1], that executing a technique against graph succeeds.
+type OutcomeModel interface {
+	SuccessProbability(t Technique, graph *Graph) float64
+}
+
+// OutcomeFunc adapts a function to OutcomeModel.
+type OutcomeFunc func(t Technique, graph *Graph) float64
+
+// SuccessProbability calls f.
+func (f OutcomeFunc) SuccessProbability(t Technique, graph *Graph) float64 { return f(t, graph) }
+
+// Sampler draws uniform values in [0,1); *rand.Rand satisfies it.
+type Sampler interface {
+	Float64() float64
+}
+
+// Executor runs techniques and samples their outcome from an OutcomeModel. The zero value keeps the
+// historical always-success behavior.
+type Executor struct {
+	Outcomes OutcomeModel
+	RNG      Sampler
+}
+
+// Execute runs t and, when an outcome model is configured, marks the evidence failed unless a draw from
+// RNG falls below the modelled success probability. An outcome model without an RNG is an error rather
+// than silently ignored.
+func (x Executor) Execute(ctx context.Context, t Technique, graph *Graph) (model.Evidence, error) {
+	if x.Outcomes != nil && x.RNG == nil {
+		return model.Evidence{}, errors.New("executor has an outcome model but no RNG")
+	}
+	ev, err := t.Execute(ctx, graph)
+	if err != nil || x.Outcomes == nil {
+		return ev, err
+	}
+	p := x.Outcomes.SuccessProbability(t, graph)
+	ev.Success = ev.Success && x.RNG.Float64() < p
+	return ev, nil
+}
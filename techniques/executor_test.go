@@ -0,0 +1,44 @@
+package techniques
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+)
+
+func TestExecutorSamplesOutcomeModel(t *testing.T) {
+	tech := RegisterAll()["AC01PassiveDNSCollection"]
+	if tech == nil {
+		t.Fatalf("expected AC01PassiveDNSCollection to be registered")
+	}
+	graph := &Graph{}
+
+	ev, err := Executor{}.Execute(context.Background(), tech, graph)
+	if err != nil || !ev.Success {
+		t.Fatalf("expected default executor to succeed: success=%t err=%v", ev.Success, err)
+	}
+
+	never := Executor{Outcomes: OutcomeFunc(func(Technique, *Graph) float64 { return 0 }), RNG: rand.New(rand.NewSource(1))}
+	for i := 0; i < 20; i++ {
+		ev, err := never.Execute(context.Background(), tech, graph)
+		if err != nil {
+			t.Fatalf("execute: %v", err)
+		}
+		if ev.Success {
+			t.Fatalf("expected 0.0-probability technique to produce failed evidence")
+		}
+		if ev.TechniqueID != tech.ID() {
+			t.Fatalf("unexpected technique id %s", ev.TechniqueID)
+		}
+	}
+
+	always := Executor{Outcomes: OutcomeFunc(func(Technique, *Graph) float64 { return 1 }), RNG: rand.New(rand.NewSource(1))}
+	if ev, _ := always.Execute(context.Background(), tech, graph); !ev.Success {
+		t.Fatalf("expected 1.0-probability technique to succeed")
+	}
+
+	unseeded := Executor{Outcomes: OutcomeFunc(func(Technique, *Graph) float64 { return 0 })}
+	if _, err := unseeded.Execute(context.Background(), tech, graph); err == nil {
+		t.Fatalf("expected an outcome model without an RNG to be rejected")
+	}
+}
@@ -27,4 +27,71 @@ type Technique interface {
 	Execute(ctx context.Context, graph *Graph) (Evidence, error)
 	RiskModifier() float64
 	ImpactModifier() float64
+	Requirements() GraphRequirement
+	NoiseProfile() NoiseProfile
+}
+
+// NoiseProfile describes a technique's interaction pattern, which drives how detectable it is beyond its risk.
+type NoiseProfile string
+
+const (
+	// NoisePassive observes without interacting with the target.
+	NoisePassive NoiseProfile = "passive"
+	// NoiseLowRate interacts with the target at a paced, low rate.
+	NoiseLowRate NoiseProfile = "low_rate"
+	// NoiseBurst interacts with the target in concentrated bursts.
+	NoiseBurst NoiseProfile = "burst"
+)
+
+// DefaultNoiseProfile derives a noise profile from a risk modifier for techniques that do not declare one.
+func DefaultNoiseProfile(risk float64) NoiseProfile {
+	switch {
+	case risk < 0.3:
+		return NoisePassive
+	case risk < 0.7:
+		return NoiseLowRate
+	default:
+		return NoiseBurst
+	}
+}
+
+// RequirementProfile names the graph-state profile a technique evaluates against.
+type RequirementProfile string
+
+const (
+	RequirementMinimal    RequirementProfile = "minimal"
+	RequirementObserved   RequirementProfile = "observed"
+	RequirementPivot      RequirementProfile = "pivot"
+	RequirementGraphPivot RequirementProfile = "graph_pivot"
+	RequirementRare       RequirementProfile = "rare"
+)
+
+// GraphRequirement declares the graph conditions under which a technique evaluates as relevant.
+type GraphRequirement struct {
+	Profile              RequirementProfile
+	RequiresNoEvidence   bool
+	MinEvidenceNodes     int
+	MinHypothesisNodes   int
+	MinTechniqueNodes    int
+	RequiresSupportsEdge bool
+	RequiresEnablesEdge  bool
+	// RequiresPivotEdge is satisfied by either a supports or an enables edge.
+	RequiresPivotEdge bool
+}
+
+// Satisfied reports whether graph meets every declared condition.
+func (r GraphRequirement) Satisfied(graph *Graph) bool {
+	if graph == nil {
+		return false
+	}
+	if r.RequiresNoEvidence && graph.EvidenceNodes != 0 {
+		return false
+	}
+	if graph.EvidenceNodes < r.MinEvidenceNodes || graph.HypothesisNodes < r.MinHypothesisNodes || graph.TechniqueNodes < r.MinTechniqueNodes {
+		return false
+	}
+	if (r.RequiresSupportsEdge && !graph.HasSupportsEdge) || (r.RequiresEnablesEdge && !graph.HasEnablesEdge) {
+		return false
+	}
+	return !r.RequiresPivotEdge || graph.HasSupportsEdge || graph.HasEnablesEdge
 }
@@ -0,0 +1,76 @@
+package techniques
+
+import "sort"
+
+// Preference orders the admissible techniques of one action class.
+type Preference string
+
+const (
+	// PreferLowerRisk runs the technique with the smallest RiskModifier, breaking ties by higher impact.
+	PreferLowerRisk Preference = "lower_risk"
+	// PreferHigherImpact runs the technique with the largest ImpactModifier, breaking ties by lower risk.
+	PreferHigherImpact Preference = "higher_impact"
+)
+
+// PreferencePolicy decides which technique runs when an action class resolves to several admissible
+// techniques. The zero value prefers lower-risk techniques for every class.
+type PreferencePolicy struct {
+	// Default applies to classes without an entry in ByClass; empty means PreferLowerRisk.
+	Default Preference
+	// ByClass overrides the preference per action class ID.
+	ByClass map[string]Preference
+}
+
+// For returns the preference applied to actionClassID.
+func (p PreferencePolicy) For(actionClassID string) Preference {
+	if pref, ok := p.ByClass[actionClassID]; ok && pref != "" {
+		return pref
+	}
+	if p.Default != "" {
+		return p.Default
+	}
+	return PreferLowerRisk
+}
+
+// Order returns candidates sorted by the preference for actionClassID, most preferred first. Remaining
+// ties resolve by technique ID so the order is deterministic.
+func (p PreferencePolicy) Order(actionClassID string, candidates []Technique) []Technique {
+	out := append([]Technique(nil), candidates...)
+	pref := p.For(actionClassID)
+	sort.SliceStable(out, func(i, j int) bool {
+		ri, rj := out[i].RiskModifier(), out[j].RiskModifier()
+		ii, ij := out[i].ImpactModifier(), out[j].ImpactModifier()
+		if pref == PreferHigherImpact {
+			if ii != ij {
+				return ii > ij
+			}
+			if ri != rj {
+				return ri < rj
+			}
+		} else {
+			if ri != rj {
+				return ri < rj
+			}
+			if ii != ij {
+				return ii > ij
+			}
+		}
+		return out[i].ID() < out[j].ID()
+	})
+	return out
+}
+
+// Select returns the most preferred registered technique of actionClassID that evaluates as relevant
+// against graph, or false when none does.
+func (p PreferencePolicy) Select(actionClassID string, graph *Graph) (Technique, bool) {
+	admissible := make([]Technique, 0)
+	for _, t := range ByActionClass()[actionClassID] {
+		if t.Evaluate(graph) {
+			admissible = append(admissible, t)
+		}
+	}
+	if len(admissible) == 0 {
+		return nil, false
+	}
+	return p.Order(actionClassID, admissible)[0], true
+}
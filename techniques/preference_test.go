@@ -0,0 +1,40 @@
+package techniques
+
+import "testing"
+
+func TestPreferencePolicyDefaultsToLowestRiskVariant(t *testing.T) {
+	variants := ByActionClass()["AC-13"]
+	if len(variants) != 5 {
+		t.Fatalf("expected five AC-13 variants, got %d", len(variants))
+	}
+	lowest := variants[0]
+	for _, v := range variants[1:] {
+		if v.RiskModifier() < lowest.RiskModifier() {
+			lowest = v
+		}
+	}
+	ordered := PreferencePolicy{}.Order("AC-13", variants)
+	if ordered[0].ID() != lowest.ID() {
+		t.Fatalf("expected default preference to pick lowest-risk %s, got %s", lowest.ID(), ordered[0].ID())
+	}
+	for i := 1; i < len(ordered); i++ {
+		if ordered[i].RiskModifier() < ordered[i-1].RiskModifier() {
+			t.Fatalf("expected ascending risk, got %v before %v", ordered[i-1].ID(), ordered[i].ID())
+		}
+	}
+
+	policy := PreferencePolicy{ByClass: map[string]Preference{"AC-13": PreferHigherImpact}}
+	graph := &Graph{EvidenceNodes: 2, HypothesisNodes: 2, TechniqueNodes: 1, HasSupportsEdge: true, HasEnablesEdge: true}
+	selected, ok := policy.Select("AC-13", graph)
+	if !ok {
+		t.Fatalf("expected an admissible AC-13 technique")
+	}
+	for _, v := range variants {
+		if v.Evaluate(graph) && v.ImpactModifier() > selected.ImpactModifier() {
+			t.Fatalf("expected highest-impact admissible technique, %s beats %s", v.ID(), selected.ID())
+		}
+	}
+	if policy.For("AC-01") != PreferLowerRisk {
+		t.Fatalf("expected classes without an override to prefer lower risk")
+	}
+}
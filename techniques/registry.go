@@ -2,6 +2,7 @@ package techniques
 
 import (
 	"fmt"
+	"sort"
 
 	"vantage/techniques/ac_01_passive_observation"
 	"vantage/techniques/ac_02_active_surface_discovery"
@@ -48,3 +49,25 @@ func RegisterAll() map[string]Technique {
 	}
 	return registry
 }
+
+// List returns the registered techniques sorted by technique ID. Callers that register or rank techniques
+// in iteration order should use List rather than ranging over RegisterAll, so tie-breaking never depends
+// on map order and stays identical across calls and processes.
+func List() []Technique {
+	registry := RegisterAll()
+	out := make([]Technique, 0, len(registry))
+	for _, t := range registry {
+		out = append(out, t)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].ID() < out[j].ID() })
+	return out
+}
+
+// ByActionClass groups the registered techniques by action class ID, each group sorted by technique ID.
+func ByActionClass() map[string][]Technique {
+	out := make(map[string][]Technique)
+	for _, t := range List() {
+		out[t.ActionClassID()] = append(out[t.ActionClassID()], t)
+	}
+	return out
+}
@@ -5,6 +5,8 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+
+	"vantage/techniques/model"
 )
 
 func TestRegisterAllHasUniqueIDs(t *testing.T) {
@@ -53,3 +55,77 @@ func TestRegisterAllCollectivelyCoversActionClasses(t *testing.T) {
 		}
 	}
 }
+
+func TestRequirementMatrixReportsEvalProfiles(t *testing.T) {
+	rows := RequirementMatrix(func(actionClassID string) []string {
+		return []string{"evidence", actionClassID}
+	})
+	if len(rows) != len(RegisterAll()) {
+		t.Fatalf("expected one row per technique, got %d", len(rows))
+	}
+	want := map[string]model.RequirementProfile{
+		"AC01PassiveDNSCollection":    model.RequirementMinimal,
+		"AC01PassiveSourceCorrelator": model.RequirementObserved,
+		"AC01OrgExposureCatalog":      model.RequirementPivot,
+		"AC01TrustChainPivot":         model.RequirementGraphPivot,
+		"AC01ThirdPartySignalPivot":   model.RequirementRare,
+		"AC03ReachabilityValidator":   model.RequirementObserved,
+	}
+	samples := []*model.Graph{
+		{},
+		{EvidenceNodes: 1},
+		{EvidenceNodes: 1, HypothesisNodes: 1},
+		{TechniqueNodes: 1, HasSupportsEdge: true},
+		{EvidenceNodes: 2, HypothesisNodes: 2, TechniqueNodes: 1, HasSupportsEdge: true, HasEnablesEdge: true},
+	}
+	all := RegisterAll()
+	for i, row := range rows {
+		if i > 0 && rows[i-1].TechniqueID >= row.TechniqueID {
+			t.Fatalf("matrix not sorted at %s", row.TechniqueID)
+		}
+		if len(row.Produces) != 2 || row.Produces[1] != row.ActionClassID {
+			t.Fatalf("%s: expected produces resolved from %s, got %v", row.TechniqueID, row.ActionClassID, row.Produces)
+		}
+		if profile, ok := want[row.TechniqueID]; ok && row.Requirement.Profile != profile {
+			t.Fatalf("%s: expected profile %s, got %s", row.TechniqueID, profile, row.Requirement.Profile)
+		}
+		for _, g := range samples {
+			if row.Requirement.Satisfied(g) != all[row.TechniqueID].Evaluate(g) {
+				t.Fatalf("%s: requirement disagrees with Evaluate for %+v", row.TechniqueID, *g)
+			}
+		}
+	}
+}
+
+func TestListIsSortedAndStableAcrossCalls(t *testing.T) {
+	first, second := List(), List()
+	if len(first) != len(RegisterAll()) || len(first) != len(second) {
+		t.Fatalf("expected List to cover every registered technique, got %d and %d of %d", len(first), len(second), len(RegisterAll()))
+	}
+	for i := range first {
+		if first[i].ID() != second[i].ID() {
+			t.Fatalf("expected identical order across calls, position %d differs: %s vs %s", i, first[i].ID(), second[i].ID())
+		}
+		if i > 0 && first[i-1].ID() >= first[i].ID() {
+			t.Fatalf("expected List sorted by ID, %s precedes %s", first[i-1].ID(), first[i].ID())
+		}
+	}
+}
+
+func TestNoiseProfileDefaultsFromRiskTier(t *testing.T) {
+	all := RegisterAll()
+	for id, tech := range all {
+		if tech.NoiseProfile() == "" {
+			t.Fatalf("expected %s to report a noise profile", id)
+		}
+	}
+	if got := all["AC01PassiveDNSCollection"].NoiseProfile(); got != model.NoisePassive {
+		t.Fatalf("expected low-risk passive collection to default to passive, got %s", got)
+	}
+	if got := all["AC08FederatedCredentialPivot"].NoiseProfile(); got != model.NoiseBurst {
+		t.Fatalf("expected high-risk pivot to default to burst, got %s", got)
+	}
+	if got := all["AC02SurfaceProbe"].NoiseProfile(); got != model.NoiseLowRate {
+		t.Fatalf("expected the declared low-rate profile to override the risk tier, got %s", got)
+	}
+}
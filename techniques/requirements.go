@@ -0,0 +1,46 @@
+package techniques
+
+import (
+	"sort"
+
+	"vantage/techniques/model"
+)
+
+// RequirementRow is one technique's entry in the capability/requirement matrix.
+type RequirementRow struct {
+	TechniqueID   string
+	Name          string
+	ActionClassID string
+	Requirement   model.GraphRequirement
+	// Produces lists the graph node and edge types one execution adds, as declared by the bound action class.
+	Produces       []string
+	RiskModifier   float64
+	ImpactModifier float64
+}
+
+// ProductionLookup resolves the graph node and edge types an action class produces. Action classes are
+// loaded by the reasoning engine, so callers supply the lookup.
+type ProductionLookup func(actionClassID string) []string
+
+// RequirementMatrix returns the requirement profile of every registered technique, sorted by technique ID.
+// Produces is filled from produces when it is non-nil.
+func RequirementMatrix(produces ProductionLookup) []RequirementRow {
+	all := RegisterAll()
+	rows := make([]RequirementRow, 0, len(all))
+	for _, t := range all {
+		row := RequirementRow{
+			TechniqueID:    t.ID(),
+			Name:           t.Name(),
+			ActionClassID:  t.ActionClassID(),
+			Requirement:    t.Requirements(),
+			RiskModifier:   t.RiskModifier(),
+			ImpactModifier: t.ImpactModifier(),
+		}
+		if produces != nil {
+			row.Produces = produces(t.ActionClassID())
+		}
+		rows = append(rows, row)
+	}
+	sort.Slice(rows, func(i, j int) bool { return rows[i].TechniqueID < rows[j].TechniqueID })
+	return rows
+}